@@ -16,6 +16,7 @@ import (
 	"github.com/adimail/asset-manager/internal/config"
 	"github.com/adimail/asset-manager/internal/filesystem"
 	"github.com/adimail/asset-manager/internal/preprocessing"
+	"github.com/adimail/asset-manager/internal/rules"
 	"github.com/adimail/asset-manager/internal/tags"
 
 	_ "github.com/mattn/go-sqlite3"
@@ -23,12 +24,30 @@ import (
 
 func main() {
 	cfg := config.Load()
+	switch cfg.Mode {
+	case config.ModeAll, config.ModeAPI:
+	case config.ModeWorker:
+		if !cfg.Compression.Enabled {
+			log.Fatal("worker mode needs COMPRESSION_ENABLED=true")
+		}
+	default:
+		log.Fatalf("unknown APP_MODE %q: want all, api or worker", cfg.Mode)
+	}
+	if err := cfg.Compression.Validate(); err != nil {
+		log.Fatal(err)
+	}
+	if err := preprocessing.ValidateProfiles(cfg.Compression.Profiles); err != nil {
+		log.Fatal(err)
+	}
 
 	if err := os.MkdirAll(filepath.Dir(cfg.Database.Path), 0o755); err != nil {
 		log.Fatal(err)
 	}
 
-	client, err := ent.Open("sqlite3", "file:"+cfg.Database.Path+"?cache=shared&_fk=1")
+	// Compression workers write concurrently with API requests. WAL mode and a
+	// busy timeout make writers wait for each other instead of failing with
+	// "database table is locked".
+	client, err := ent.Open("sqlite3", "file:"+cfg.Database.Path+"?_fk=1&_journal_mode=WAL&_busy_timeout=5000")
 	if err != nil {
 		log.Fatalf("failed opening connection to sqlite: %v", err)
 	}
@@ -43,11 +62,28 @@ func main() {
 
 	preprocessor := preprocessing.NewService(client, cfg.Compression)
 
+	if cfg.Mode == config.ModeWorker {
+		// Workers claim jobs from the shared database, so any number of
+		// worker processes can run next to the API server.
+		log.Printf("Running in worker mode")
+		waitForSignal()
+		shutdownWorkers(preprocessor, cfg.Compression.ShutdownTimeout)
+		return
+	}
+
 	// Initialize Asset Service first as Tag Service depends on it for cascading deletes
 	assetService := assets.NewService(client, fs, validator, cfg.Storage.AssetsDir, preprocessor)
 	tagService := tags.NewService(client, assetService)
 
-	handler := api.NewServer(assetService, tagService)
+	ruleService := rules.NewService(client, assetService, cfg.Compression.Profiles)
+	assetService.OnUpload(ruleService)
+
+	janitor := assets.NewJanitor(assetService, cfg.Compression.RetainOriginalDays, cfg.Compression.JanitorInterval, cfg.Compression.JanitorDryRun)
+	janitorCtx, stopJanitor := context.WithCancel(context.Background())
+	defer stopJanitor()
+	janitor.Start(janitorCtx)
+
+	handler := api.NewServer(assetService, tagService, preprocessor, janitor, ruleService)
 
 	srv := &http.Server{
 		Addr:         ":" + cfg.Server.Port,
@@ -63,11 +99,28 @@ func main() {
 		}
 	}()
 
+	waitForSignal()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*15)
+	defer cancel()
+	srv.Shutdown(ctx)
+
+	// With the API down no new jobs arrive; give running ones a chance to
+	// finish before they are requeued.
+	shutdownWorkers(preprocessor, cfg.Compression.ShutdownTimeout)
+	stopJanitor()
+}
+
+func waitForSignal() {
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
 	<-c
+}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*15)
+func shutdownWorkers(preprocessor *preprocessing.Service, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
-	srv.Shutdown(ctx)
+	if err := preprocessor.Shutdown(ctx); err != nil {
+		log.Printf("Compression workers did not finish in time; running jobs were requeued")
+	}
 }
@@ -23,6 +23,8 @@ type Asset struct {
 	FileType string `json:"file_type,omitempty"`
 	// Extension holds the value of the "extension" field.
 	Extension string `json:"extension,omitempty"`
+	// MimeType holds the value of the "mime_type" field.
+	MimeType string `json:"mime_type,omitempty"`
 	// FileSizeBytes holds the value of the "file_size_bytes" field.
 	FileSizeBytes int64 `json:"file_size_bytes,omitempty"`
 	// StoragePath holds the value of the "storage_path" field.
@@ -31,10 +33,22 @@ type Asset struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// IsCompressed holds the value of the "is_compressed" field.
 	IsCompressed bool `json:"is_compressed,omitempty"`
+	// CompressedAt holds the value of the "compressed_at" field.
+	CompressedAt time.Time `json:"compressed_at,omitempty"`
 	// OriginalPath holds the value of the "original_path" field.
 	OriginalPath string `json:"original_path,omitempty"`
+	// OriginalKeptAt holds the value of the "original_kept_at" field.
+	OriginalKeptAt time.Time `json:"original_kept_at,omitempty"`
 	// CompressionRatio holds the value of the "compression_ratio" field.
 	CompressionRatio float64 `json:"compression_ratio,omitempty"`
+	// AlreadyOptimal holds the value of the "already_optimal" field.
+	AlreadyOptimal bool `json:"already_optimal,omitempty"`
+	// MetadataStripped holds the value of the "metadata_stripped" field.
+	MetadataStripped bool `json:"metadata_stripped,omitempty"`
+	// HasLocation holds the value of the "has_location" field.
+	HasLocation bool `json:"has_location,omitempty"`
+	// HlsPath holds the value of the "hls_path" field.
+	HlsPath string `json:"hls_path,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the AssetQuery when eager-loading is set.
 	Edges        AssetEdges `json:"edges"`
@@ -47,9 +61,11 @@ type AssetEdges struct {
 	Tags []*Tag `json:"tags,omitempty"`
 	// CompressionJobs holds the value of the compression_jobs edge.
 	CompressionJobs []*CompressionJob `json:"compression_jobs,omitempty"`
+	// Variants holds the value of the variants edge.
+	Variants []*Variant `json:"variants,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // TagsOrErr returns the Tags value or an error if the edge
@@ -70,20 +86,29 @@ func (e AssetEdges) CompressionJobsOrErr() ([]*CompressionJob, error) {
 	return nil, &NotLoadedError{edge: "compression_jobs"}
 }
 
+// VariantsOrErr returns the Variants value or an error if the edge
+// was not loaded in eager-loading.
+func (e AssetEdges) VariantsOrErr() ([]*Variant, error) {
+	if e.loadedTypes[2] {
+		return e.Variants, nil
+	}
+	return nil, &NotLoadedError{edge: "variants"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Asset) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case asset.FieldIsCompressed:
+		case asset.FieldIsCompressed, asset.FieldAlreadyOptimal, asset.FieldMetadataStripped, asset.FieldHasLocation:
 			values[i] = new(sql.NullBool)
 		case asset.FieldCompressionRatio:
 			values[i] = new(sql.NullFloat64)
 		case asset.FieldFileSizeBytes:
 			values[i] = new(sql.NullInt64)
-		case asset.FieldID, asset.FieldOriginalFilename, asset.FieldFileType, asset.FieldExtension, asset.FieldStoragePath, asset.FieldOriginalPath:
+		case asset.FieldID, asset.FieldOriginalFilename, asset.FieldFileType, asset.FieldExtension, asset.FieldMimeType, asset.FieldStoragePath, asset.FieldOriginalPath, asset.FieldHlsPath:
 			values[i] = new(sql.NullString)
-		case asset.FieldCreatedAt:
+		case asset.FieldCreatedAt, asset.FieldCompressedAt, asset.FieldOriginalKeptAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -124,6 +149,12 @@ func (_m *Asset) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Extension = value.String
 			}
+		case asset.FieldMimeType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mime_type", values[i])
+			} else if value.Valid {
+				_m.MimeType = value.String
+			}
 		case asset.FieldFileSizeBytes:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field file_size_bytes", values[i])
@@ -148,18 +179,54 @@ func (_m *Asset) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.IsCompressed = value.Bool
 			}
+		case asset.FieldCompressedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field compressed_at", values[i])
+			} else if value.Valid {
+				_m.CompressedAt = value.Time
+			}
 		case asset.FieldOriginalPath:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field original_path", values[i])
 			} else if value.Valid {
 				_m.OriginalPath = value.String
 			}
+		case asset.FieldOriginalKeptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field original_kept_at", values[i])
+			} else if value.Valid {
+				_m.OriginalKeptAt = value.Time
+			}
 		case asset.FieldCompressionRatio:
 			if value, ok := values[i].(*sql.NullFloat64); !ok {
 				return fmt.Errorf("unexpected type %T for field compression_ratio", values[i])
 			} else if value.Valid {
 				_m.CompressionRatio = value.Float64
 			}
+		case asset.FieldAlreadyOptimal:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field already_optimal", values[i])
+			} else if value.Valid {
+				_m.AlreadyOptimal = value.Bool
+			}
+		case asset.FieldMetadataStripped:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata_stripped", values[i])
+			} else if value.Valid {
+				_m.MetadataStripped = value.Bool
+			}
+		case asset.FieldHasLocation:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field has_location", values[i])
+			} else if value.Valid {
+				_m.HasLocation = value.Bool
+			}
+		case asset.FieldHlsPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hls_path", values[i])
+			} else if value.Valid {
+				_m.HlsPath = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -183,6 +250,11 @@ func (_m *Asset) QueryCompressionJobs() *CompressionJobQuery {
 	return NewAssetClient(_m.config).QueryCompressionJobs(_m)
 }
 
+// QueryVariants queries the "variants" edge of the Asset entity.
+func (_m *Asset) QueryVariants() *VariantQuery {
+	return NewAssetClient(_m.config).QueryVariants(_m)
+}
+
 // Update returns a builder for updating this Asset.
 // Note that you need to call Asset.Unwrap() before calling this method if this Asset
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -215,6 +287,9 @@ func (_m *Asset) String() string {
 	builder.WriteString("extension=")
 	builder.WriteString(_m.Extension)
 	builder.WriteString(", ")
+	builder.WriteString("mime_type=")
+	builder.WriteString(_m.MimeType)
+	builder.WriteString(", ")
 	builder.WriteString("file_size_bytes=")
 	builder.WriteString(fmt.Sprintf("%v", _m.FileSizeBytes))
 	builder.WriteString(", ")
@@ -227,11 +302,29 @@ func (_m *Asset) String() string {
 	builder.WriteString("is_compressed=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsCompressed))
 	builder.WriteString(", ")
+	builder.WriteString("compressed_at=")
+	builder.WriteString(_m.CompressedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("original_path=")
 	builder.WriteString(_m.OriginalPath)
 	builder.WriteString(", ")
+	builder.WriteString("original_kept_at=")
+	builder.WriteString(_m.OriginalKeptAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("compression_ratio=")
 	builder.WriteString(fmt.Sprintf("%v", _m.CompressionRatio))
+	builder.WriteString(", ")
+	builder.WriteString("already_optimal=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AlreadyOptimal))
+	builder.WriteString(", ")
+	builder.WriteString("metadata_stripped=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MetadataStripped))
+	builder.WriteString(", ")
+	builder.WriteString("has_location=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HasLocation))
+	builder.WriteString(", ")
+	builder.WriteString("hls_path=")
+	builder.WriteString(_m.HlsPath)
 	builder.WriteByte(')')
 	return builder.String()
 }
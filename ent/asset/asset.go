@@ -20,6 +20,8 @@ const (
 	FieldFileType = "file_type"
 	// FieldExtension holds the string denoting the extension field in the database.
 	FieldExtension = "extension"
+	// FieldMimeType holds the string denoting the mime_type field in the database.
+	FieldMimeType = "mime_type"
 	// FieldFileSizeBytes holds the string denoting the file_size_bytes field in the database.
 	FieldFileSizeBytes = "file_size_bytes"
 	// FieldStoragePath holds the string denoting the storage_path field in the database.
@@ -28,14 +30,28 @@ const (
 	FieldCreatedAt = "created_at"
 	// FieldIsCompressed holds the string denoting the is_compressed field in the database.
 	FieldIsCompressed = "is_compressed"
+	// FieldCompressedAt holds the string denoting the compressed_at field in the database.
+	FieldCompressedAt = "compressed_at"
 	// FieldOriginalPath holds the string denoting the original_path field in the database.
 	FieldOriginalPath = "original_path"
+	// FieldOriginalKeptAt holds the string denoting the original_kept_at field in the database.
+	FieldOriginalKeptAt = "original_kept_at"
 	// FieldCompressionRatio holds the string denoting the compression_ratio field in the database.
 	FieldCompressionRatio = "compression_ratio"
+	// FieldAlreadyOptimal holds the string denoting the already_optimal field in the database.
+	FieldAlreadyOptimal = "already_optimal"
+	// FieldMetadataStripped holds the string denoting the metadata_stripped field in the database.
+	FieldMetadataStripped = "metadata_stripped"
+	// FieldHasLocation holds the string denoting the has_location field in the database.
+	FieldHasLocation = "has_location"
+	// FieldHlsPath holds the string denoting the hls_path field in the database.
+	FieldHlsPath = "hls_path"
 	// EdgeTags holds the string denoting the tags edge name in mutations.
 	EdgeTags = "tags"
 	// EdgeCompressionJobs holds the string denoting the compression_jobs edge name in mutations.
 	EdgeCompressionJobs = "compression_jobs"
+	// EdgeVariants holds the string denoting the variants edge name in mutations.
+	EdgeVariants = "variants"
 	// Table holds the table name of the asset in the database.
 	Table = "assets"
 	// TagsTable is the table that holds the tags relation/edge. The primary key declared below.
@@ -50,6 +66,13 @@ const (
 	CompressionJobsInverseTable = "compression_jobs"
 	// CompressionJobsColumn is the table column denoting the compression_jobs relation/edge.
 	CompressionJobsColumn = "asset_compression_jobs"
+	// VariantsTable is the table that holds the variants relation/edge.
+	VariantsTable = "variants"
+	// VariantsInverseTable is the table name for the Variant entity.
+	// It exists in this package in order to avoid circular dependency with the "variant" package.
+	VariantsInverseTable = "variants"
+	// VariantsColumn is the table column denoting the variants relation/edge.
+	VariantsColumn = "asset_variants"
 )
 
 // Columns holds all SQL columns for asset fields.
@@ -58,12 +81,19 @@ var Columns = []string{
 	FieldOriginalFilename,
 	FieldFileType,
 	FieldExtension,
+	FieldMimeType,
 	FieldFileSizeBytes,
 	FieldStoragePath,
 	FieldCreatedAt,
 	FieldIsCompressed,
+	FieldCompressedAt,
 	FieldOriginalPath,
+	FieldOriginalKeptAt,
 	FieldCompressionRatio,
+	FieldAlreadyOptimal,
+	FieldMetadataStripped,
+	FieldHasLocation,
+	FieldHlsPath,
 }
 
 var (
@@ -87,6 +117,12 @@ var (
 	DefaultCreatedAt func() time.Time
 	// DefaultIsCompressed holds the default value on creation for the "is_compressed" field.
 	DefaultIsCompressed bool
+	// DefaultAlreadyOptimal holds the default value on creation for the "already_optimal" field.
+	DefaultAlreadyOptimal bool
+	// DefaultMetadataStripped holds the default value on creation for the "metadata_stripped" field.
+	DefaultMetadataStripped bool
+	// DefaultHasLocation holds the default value on creation for the "has_location" field.
+	DefaultHasLocation bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() string
 )
@@ -114,6 +150,11 @@ func ByExtension(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExtension, opts...).ToFunc()
 }
 
+// ByMimeType orders the results by the mime_type field.
+func ByMimeType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMimeType, opts...).ToFunc()
+}
+
 // ByFileSizeBytes orders the results by the file_size_bytes field.
 func ByFileSizeBytes(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFileSizeBytes, opts...).ToFunc()
@@ -134,16 +175,46 @@ func ByIsCompressed(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsCompressed, opts...).ToFunc()
 }
 
+// ByCompressedAt orders the results by the compressed_at field.
+func ByCompressedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompressedAt, opts...).ToFunc()
+}
+
 // ByOriginalPath orders the results by the original_path field.
 func ByOriginalPath(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldOriginalPath, opts...).ToFunc()
 }
 
+// ByOriginalKeptAt orders the results by the original_kept_at field.
+func ByOriginalKeptAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOriginalKeptAt, opts...).ToFunc()
+}
+
 // ByCompressionRatio orders the results by the compression_ratio field.
 func ByCompressionRatio(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCompressionRatio, opts...).ToFunc()
 }
 
+// ByAlreadyOptimal orders the results by the already_optimal field.
+func ByAlreadyOptimal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAlreadyOptimal, opts...).ToFunc()
+}
+
+// ByMetadataStripped orders the results by the metadata_stripped field.
+func ByMetadataStripped(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMetadataStripped, opts...).ToFunc()
+}
+
+// ByHasLocation orders the results by the has_location field.
+func ByHasLocation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHasLocation, opts...).ToFunc()
+}
+
+// ByHlsPath orders the results by the hls_path field.
+func ByHlsPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHlsPath, opts...).ToFunc()
+}
+
 // ByTagsCount orders the results by tags count.
 func ByTagsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -171,6 +242,20 @@ func ByCompressionJobs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newCompressionJobsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByVariantsCount orders the results by variants count.
+func ByVariantsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newVariantsStep(), opts...)
+	}
+}
+
+// ByVariants orders the results by variants terms.
+func ByVariants(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVariantsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTagsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -185,3 +270,10 @@ func newCompressionJobsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, CompressionJobsTable, CompressionJobsColumn),
 	)
 }
+func newVariantsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VariantsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, VariantsTable, VariantsColumn),
+	)
+}
@@ -80,6 +80,11 @@ func Extension(v string) predicate.Asset {
 	return predicate.Asset(sql.FieldEQ(FieldExtension, v))
 }
 
+// MimeType applies equality check predicate on the "mime_type" field. It's identical to MimeTypeEQ.
+func MimeType(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldMimeType, v))
+}
+
 // FileSizeBytes applies equality check predicate on the "file_size_bytes" field. It's identical to FileSizeBytesEQ.
 func FileSizeBytes(v int64) predicate.Asset {
 	return predicate.Asset(sql.FieldEQ(FieldFileSizeBytes, v))
@@ -100,16 +105,46 @@ func IsCompressed(v bool) predicate.Asset {
 	return predicate.Asset(sql.FieldEQ(FieldIsCompressed, v))
 }
 
+// CompressedAt applies equality check predicate on the "compressed_at" field. It's identical to CompressedAtEQ.
+func CompressedAt(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldCompressedAt, v))
+}
+
 // OriginalPath applies equality check predicate on the "original_path" field. It's identical to OriginalPathEQ.
 func OriginalPath(v string) predicate.Asset {
 	return predicate.Asset(sql.FieldEQ(FieldOriginalPath, v))
 }
 
+// OriginalKeptAt applies equality check predicate on the "original_kept_at" field. It's identical to OriginalKeptAtEQ.
+func OriginalKeptAt(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldOriginalKeptAt, v))
+}
+
 // CompressionRatio applies equality check predicate on the "compression_ratio" field. It's identical to CompressionRatioEQ.
 func CompressionRatio(v float64) predicate.Asset {
 	return predicate.Asset(sql.FieldEQ(FieldCompressionRatio, v))
 }
 
+// AlreadyOptimal applies equality check predicate on the "already_optimal" field. It's identical to AlreadyOptimalEQ.
+func AlreadyOptimal(v bool) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldAlreadyOptimal, v))
+}
+
+// MetadataStripped applies equality check predicate on the "metadata_stripped" field. It's identical to MetadataStrippedEQ.
+func MetadataStripped(v bool) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldMetadataStripped, v))
+}
+
+// HasLocation applies equality check predicate on the "has_location" field. It's identical to HasLocationEQ.
+func HasLocation(v bool) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldHasLocation, v))
+}
+
+// HlsPath applies equality check predicate on the "hls_path" field. It's identical to HlsPathEQ.
+func HlsPath(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldHlsPath, v))
+}
+
 // OriginalFilenameEQ applies the EQ predicate on the "original_filename" field.
 func OriginalFilenameEQ(v string) predicate.Asset {
 	return predicate.Asset(sql.FieldEQ(FieldOriginalFilename, v))
@@ -305,6 +340,81 @@ func ExtensionContainsFold(v string) predicate.Asset {
 	return predicate.Asset(sql.FieldContainsFold(FieldExtension, v))
 }
 
+// MimeTypeEQ applies the EQ predicate on the "mime_type" field.
+func MimeTypeEQ(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldMimeType, v))
+}
+
+// MimeTypeNEQ applies the NEQ predicate on the "mime_type" field.
+func MimeTypeNEQ(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldNEQ(FieldMimeType, v))
+}
+
+// MimeTypeIn applies the In predicate on the "mime_type" field.
+func MimeTypeIn(vs ...string) predicate.Asset {
+	return predicate.Asset(sql.FieldIn(FieldMimeType, vs...))
+}
+
+// MimeTypeNotIn applies the NotIn predicate on the "mime_type" field.
+func MimeTypeNotIn(vs ...string) predicate.Asset {
+	return predicate.Asset(sql.FieldNotIn(FieldMimeType, vs...))
+}
+
+// MimeTypeGT applies the GT predicate on the "mime_type" field.
+func MimeTypeGT(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldGT(FieldMimeType, v))
+}
+
+// MimeTypeGTE applies the GTE predicate on the "mime_type" field.
+func MimeTypeGTE(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldGTE(FieldMimeType, v))
+}
+
+// MimeTypeLT applies the LT predicate on the "mime_type" field.
+func MimeTypeLT(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldLT(FieldMimeType, v))
+}
+
+// MimeTypeLTE applies the LTE predicate on the "mime_type" field.
+func MimeTypeLTE(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldLTE(FieldMimeType, v))
+}
+
+// MimeTypeContains applies the Contains predicate on the "mime_type" field.
+func MimeTypeContains(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldContains(FieldMimeType, v))
+}
+
+// MimeTypeHasPrefix applies the HasPrefix predicate on the "mime_type" field.
+func MimeTypeHasPrefix(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldHasPrefix(FieldMimeType, v))
+}
+
+// MimeTypeHasSuffix applies the HasSuffix predicate on the "mime_type" field.
+func MimeTypeHasSuffix(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldHasSuffix(FieldMimeType, v))
+}
+
+// MimeTypeIsNil applies the IsNil predicate on the "mime_type" field.
+func MimeTypeIsNil() predicate.Asset {
+	return predicate.Asset(sql.FieldIsNull(FieldMimeType))
+}
+
+// MimeTypeNotNil applies the NotNil predicate on the "mime_type" field.
+func MimeTypeNotNil() predicate.Asset {
+	return predicate.Asset(sql.FieldNotNull(FieldMimeType))
+}
+
+// MimeTypeEqualFold applies the EqualFold predicate on the "mime_type" field.
+func MimeTypeEqualFold(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldEqualFold(FieldMimeType, v))
+}
+
+// MimeTypeContainsFold applies the ContainsFold predicate on the "mime_type" field.
+func MimeTypeContainsFold(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldContainsFold(FieldMimeType, v))
+}
+
 // FileSizeBytesEQ applies the EQ predicate on the "file_size_bytes" field.
 func FileSizeBytesEQ(v int64) predicate.Asset {
 	return predicate.Asset(sql.FieldEQ(FieldFileSizeBytes, v))
@@ -460,6 +570,56 @@ func IsCompressedNEQ(v bool) predicate.Asset {
 	return predicate.Asset(sql.FieldNEQ(FieldIsCompressed, v))
 }
 
+// CompressedAtEQ applies the EQ predicate on the "compressed_at" field.
+func CompressedAtEQ(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldCompressedAt, v))
+}
+
+// CompressedAtNEQ applies the NEQ predicate on the "compressed_at" field.
+func CompressedAtNEQ(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldNEQ(FieldCompressedAt, v))
+}
+
+// CompressedAtIn applies the In predicate on the "compressed_at" field.
+func CompressedAtIn(vs ...time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldIn(FieldCompressedAt, vs...))
+}
+
+// CompressedAtNotIn applies the NotIn predicate on the "compressed_at" field.
+func CompressedAtNotIn(vs ...time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldNotIn(FieldCompressedAt, vs...))
+}
+
+// CompressedAtGT applies the GT predicate on the "compressed_at" field.
+func CompressedAtGT(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldGT(FieldCompressedAt, v))
+}
+
+// CompressedAtGTE applies the GTE predicate on the "compressed_at" field.
+func CompressedAtGTE(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldGTE(FieldCompressedAt, v))
+}
+
+// CompressedAtLT applies the LT predicate on the "compressed_at" field.
+func CompressedAtLT(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldLT(FieldCompressedAt, v))
+}
+
+// CompressedAtLTE applies the LTE predicate on the "compressed_at" field.
+func CompressedAtLTE(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldLTE(FieldCompressedAt, v))
+}
+
+// CompressedAtIsNil applies the IsNil predicate on the "compressed_at" field.
+func CompressedAtIsNil() predicate.Asset {
+	return predicate.Asset(sql.FieldIsNull(FieldCompressedAt))
+}
+
+// CompressedAtNotNil applies the NotNil predicate on the "compressed_at" field.
+func CompressedAtNotNil() predicate.Asset {
+	return predicate.Asset(sql.FieldNotNull(FieldCompressedAt))
+}
+
 // OriginalPathEQ applies the EQ predicate on the "original_path" field.
 func OriginalPathEQ(v string) predicate.Asset {
 	return predicate.Asset(sql.FieldEQ(FieldOriginalPath, v))
@@ -535,6 +695,56 @@ func OriginalPathContainsFold(v string) predicate.Asset {
 	return predicate.Asset(sql.FieldContainsFold(FieldOriginalPath, v))
 }
 
+// OriginalKeptAtEQ applies the EQ predicate on the "original_kept_at" field.
+func OriginalKeptAtEQ(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldOriginalKeptAt, v))
+}
+
+// OriginalKeptAtNEQ applies the NEQ predicate on the "original_kept_at" field.
+func OriginalKeptAtNEQ(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldNEQ(FieldOriginalKeptAt, v))
+}
+
+// OriginalKeptAtIn applies the In predicate on the "original_kept_at" field.
+func OriginalKeptAtIn(vs ...time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldIn(FieldOriginalKeptAt, vs...))
+}
+
+// OriginalKeptAtNotIn applies the NotIn predicate on the "original_kept_at" field.
+func OriginalKeptAtNotIn(vs ...time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldNotIn(FieldOriginalKeptAt, vs...))
+}
+
+// OriginalKeptAtGT applies the GT predicate on the "original_kept_at" field.
+func OriginalKeptAtGT(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldGT(FieldOriginalKeptAt, v))
+}
+
+// OriginalKeptAtGTE applies the GTE predicate on the "original_kept_at" field.
+func OriginalKeptAtGTE(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldGTE(FieldOriginalKeptAt, v))
+}
+
+// OriginalKeptAtLT applies the LT predicate on the "original_kept_at" field.
+func OriginalKeptAtLT(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldLT(FieldOriginalKeptAt, v))
+}
+
+// OriginalKeptAtLTE applies the LTE predicate on the "original_kept_at" field.
+func OriginalKeptAtLTE(v time.Time) predicate.Asset {
+	return predicate.Asset(sql.FieldLTE(FieldOriginalKeptAt, v))
+}
+
+// OriginalKeptAtIsNil applies the IsNil predicate on the "original_kept_at" field.
+func OriginalKeptAtIsNil() predicate.Asset {
+	return predicate.Asset(sql.FieldIsNull(FieldOriginalKeptAt))
+}
+
+// OriginalKeptAtNotNil applies the NotNil predicate on the "original_kept_at" field.
+func OriginalKeptAtNotNil() predicate.Asset {
+	return predicate.Asset(sql.FieldNotNull(FieldOriginalKeptAt))
+}
+
 // CompressionRatioEQ applies the EQ predicate on the "compression_ratio" field.
 func CompressionRatioEQ(v float64) predicate.Asset {
 	return predicate.Asset(sql.FieldEQ(FieldCompressionRatio, v))
@@ -585,6 +795,111 @@ func CompressionRatioNotNil() predicate.Asset {
 	return predicate.Asset(sql.FieldNotNull(FieldCompressionRatio))
 }
 
+// AlreadyOptimalEQ applies the EQ predicate on the "already_optimal" field.
+func AlreadyOptimalEQ(v bool) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldAlreadyOptimal, v))
+}
+
+// AlreadyOptimalNEQ applies the NEQ predicate on the "already_optimal" field.
+func AlreadyOptimalNEQ(v bool) predicate.Asset {
+	return predicate.Asset(sql.FieldNEQ(FieldAlreadyOptimal, v))
+}
+
+// MetadataStrippedEQ applies the EQ predicate on the "metadata_stripped" field.
+func MetadataStrippedEQ(v bool) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldMetadataStripped, v))
+}
+
+// MetadataStrippedNEQ applies the NEQ predicate on the "metadata_stripped" field.
+func MetadataStrippedNEQ(v bool) predicate.Asset {
+	return predicate.Asset(sql.FieldNEQ(FieldMetadataStripped, v))
+}
+
+// HasLocationEQ applies the EQ predicate on the "has_location" field.
+func HasLocationEQ(v bool) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldHasLocation, v))
+}
+
+// HasLocationNEQ applies the NEQ predicate on the "has_location" field.
+func HasLocationNEQ(v bool) predicate.Asset {
+	return predicate.Asset(sql.FieldNEQ(FieldHasLocation, v))
+}
+
+// HlsPathEQ applies the EQ predicate on the "hls_path" field.
+func HlsPathEQ(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldEQ(FieldHlsPath, v))
+}
+
+// HlsPathNEQ applies the NEQ predicate on the "hls_path" field.
+func HlsPathNEQ(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldNEQ(FieldHlsPath, v))
+}
+
+// HlsPathIn applies the In predicate on the "hls_path" field.
+func HlsPathIn(vs ...string) predicate.Asset {
+	return predicate.Asset(sql.FieldIn(FieldHlsPath, vs...))
+}
+
+// HlsPathNotIn applies the NotIn predicate on the "hls_path" field.
+func HlsPathNotIn(vs ...string) predicate.Asset {
+	return predicate.Asset(sql.FieldNotIn(FieldHlsPath, vs...))
+}
+
+// HlsPathGT applies the GT predicate on the "hls_path" field.
+func HlsPathGT(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldGT(FieldHlsPath, v))
+}
+
+// HlsPathGTE applies the GTE predicate on the "hls_path" field.
+func HlsPathGTE(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldGTE(FieldHlsPath, v))
+}
+
+// HlsPathLT applies the LT predicate on the "hls_path" field.
+func HlsPathLT(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldLT(FieldHlsPath, v))
+}
+
+// HlsPathLTE applies the LTE predicate on the "hls_path" field.
+func HlsPathLTE(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldLTE(FieldHlsPath, v))
+}
+
+// HlsPathContains applies the Contains predicate on the "hls_path" field.
+func HlsPathContains(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldContains(FieldHlsPath, v))
+}
+
+// HlsPathHasPrefix applies the HasPrefix predicate on the "hls_path" field.
+func HlsPathHasPrefix(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldHasPrefix(FieldHlsPath, v))
+}
+
+// HlsPathHasSuffix applies the HasSuffix predicate on the "hls_path" field.
+func HlsPathHasSuffix(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldHasSuffix(FieldHlsPath, v))
+}
+
+// HlsPathIsNil applies the IsNil predicate on the "hls_path" field.
+func HlsPathIsNil() predicate.Asset {
+	return predicate.Asset(sql.FieldIsNull(FieldHlsPath))
+}
+
+// HlsPathNotNil applies the NotNil predicate on the "hls_path" field.
+func HlsPathNotNil() predicate.Asset {
+	return predicate.Asset(sql.FieldNotNull(FieldHlsPath))
+}
+
+// HlsPathEqualFold applies the EqualFold predicate on the "hls_path" field.
+func HlsPathEqualFold(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldEqualFold(FieldHlsPath, v))
+}
+
+// HlsPathContainsFold applies the ContainsFold predicate on the "hls_path" field.
+func HlsPathContainsFold(v string) predicate.Asset {
+	return predicate.Asset(sql.FieldContainsFold(FieldHlsPath, v))
+}
+
 // HasTags applies the HasEdge predicate on the "tags" edge.
 func HasTags() predicate.Asset {
 	return predicate.Asset(func(s *sql.Selector) {
@@ -631,6 +946,29 @@ func HasCompressionJobsWith(preds ...predicate.CompressionJob) predicate.Asset {
 	})
 }
 
+// HasVariants applies the HasEdge predicate on the "variants" edge.
+func HasVariants() predicate.Asset {
+	return predicate.Asset(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, VariantsTable, VariantsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVariantsWith applies the HasEdge predicate on the "variants" edge with a given conditions (other predicates).
+func HasVariantsWith(preds ...predicate.Variant) predicate.Asset {
+	return predicate.Asset(func(s *sql.Selector) {
+		step := newVariantsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Asset) predicate.Asset {
 	return predicate.Asset(sql.AndPredicates(predicates...))
@@ -13,6 +13,7 @@ import (
 	"github.com/adimail/asset-manager/ent/asset"
 	"github.com/adimail/asset-manager/ent/compressionjob"
 	"github.com/adimail/asset-manager/ent/tag"
+	"github.com/adimail/asset-manager/ent/variant"
 )
 
 // AssetCreate is the builder for creating a Asset entity.
@@ -40,6 +41,20 @@ func (_c *AssetCreate) SetExtension(v string) *AssetCreate {
 	return _c
 }
 
+// SetMimeType sets the "mime_type" field.
+func (_c *AssetCreate) SetMimeType(v string) *AssetCreate {
+	_c.mutation.SetMimeType(v)
+	return _c
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (_c *AssetCreate) SetNillableMimeType(v *string) *AssetCreate {
+	if v != nil {
+		_c.SetMimeType(*v)
+	}
+	return _c
+}
+
 // SetFileSizeBytes sets the "file_size_bytes" field.
 func (_c *AssetCreate) SetFileSizeBytes(v int64) *AssetCreate {
 	_c.mutation.SetFileSizeBytes(v)
@@ -80,6 +95,20 @@ func (_c *AssetCreate) SetNillableIsCompressed(v *bool) *AssetCreate {
 	return _c
 }
 
+// SetCompressedAt sets the "compressed_at" field.
+func (_c *AssetCreate) SetCompressedAt(v time.Time) *AssetCreate {
+	_c.mutation.SetCompressedAt(v)
+	return _c
+}
+
+// SetNillableCompressedAt sets the "compressed_at" field if the given value is not nil.
+func (_c *AssetCreate) SetNillableCompressedAt(v *time.Time) *AssetCreate {
+	if v != nil {
+		_c.SetCompressedAt(*v)
+	}
+	return _c
+}
+
 // SetOriginalPath sets the "original_path" field.
 func (_c *AssetCreate) SetOriginalPath(v string) *AssetCreate {
 	_c.mutation.SetOriginalPath(v)
@@ -94,6 +123,20 @@ func (_c *AssetCreate) SetNillableOriginalPath(v *string) *AssetCreate {
 	return _c
 }
 
+// SetOriginalKeptAt sets the "original_kept_at" field.
+func (_c *AssetCreate) SetOriginalKeptAt(v time.Time) *AssetCreate {
+	_c.mutation.SetOriginalKeptAt(v)
+	return _c
+}
+
+// SetNillableOriginalKeptAt sets the "original_kept_at" field if the given value is not nil.
+func (_c *AssetCreate) SetNillableOriginalKeptAt(v *time.Time) *AssetCreate {
+	if v != nil {
+		_c.SetOriginalKeptAt(*v)
+	}
+	return _c
+}
+
 // SetCompressionRatio sets the "compression_ratio" field.
 func (_c *AssetCreate) SetCompressionRatio(v float64) *AssetCreate {
 	_c.mutation.SetCompressionRatio(v)
@@ -108,6 +151,62 @@ func (_c *AssetCreate) SetNillableCompressionRatio(v *float64) *AssetCreate {
 	return _c
 }
 
+// SetAlreadyOptimal sets the "already_optimal" field.
+func (_c *AssetCreate) SetAlreadyOptimal(v bool) *AssetCreate {
+	_c.mutation.SetAlreadyOptimal(v)
+	return _c
+}
+
+// SetNillableAlreadyOptimal sets the "already_optimal" field if the given value is not nil.
+func (_c *AssetCreate) SetNillableAlreadyOptimal(v *bool) *AssetCreate {
+	if v != nil {
+		_c.SetAlreadyOptimal(*v)
+	}
+	return _c
+}
+
+// SetMetadataStripped sets the "metadata_stripped" field.
+func (_c *AssetCreate) SetMetadataStripped(v bool) *AssetCreate {
+	_c.mutation.SetMetadataStripped(v)
+	return _c
+}
+
+// SetNillableMetadataStripped sets the "metadata_stripped" field if the given value is not nil.
+func (_c *AssetCreate) SetNillableMetadataStripped(v *bool) *AssetCreate {
+	if v != nil {
+		_c.SetMetadataStripped(*v)
+	}
+	return _c
+}
+
+// SetHasLocation sets the "has_location" field.
+func (_c *AssetCreate) SetHasLocation(v bool) *AssetCreate {
+	_c.mutation.SetHasLocation(v)
+	return _c
+}
+
+// SetNillableHasLocation sets the "has_location" field if the given value is not nil.
+func (_c *AssetCreate) SetNillableHasLocation(v *bool) *AssetCreate {
+	if v != nil {
+		_c.SetHasLocation(*v)
+	}
+	return _c
+}
+
+// SetHlsPath sets the "hls_path" field.
+func (_c *AssetCreate) SetHlsPath(v string) *AssetCreate {
+	_c.mutation.SetHlsPath(v)
+	return _c
+}
+
+// SetNillableHlsPath sets the "hls_path" field if the given value is not nil.
+func (_c *AssetCreate) SetNillableHlsPath(v *string) *AssetCreate {
+	if v != nil {
+		_c.SetHlsPath(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *AssetCreate) SetID(v string) *AssetCreate {
 	_c.mutation.SetID(v)
@@ -152,6 +251,21 @@ func (_c *AssetCreate) AddCompressionJobs(v ...*CompressionJob) *AssetCreate {
 	return _c.AddCompressionJobIDs(ids...)
 }
 
+// AddVariantIDs adds the "variants" edge to the Variant entity by IDs.
+func (_c *AssetCreate) AddVariantIDs(ids ...string) *AssetCreate {
+	_c.mutation.AddVariantIDs(ids...)
+	return _c
+}
+
+// AddVariants adds the "variants" edges to the Variant entity.
+func (_c *AssetCreate) AddVariants(v ...*Variant) *AssetCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddVariantIDs(ids...)
+}
+
 // Mutation returns the AssetMutation object of the builder.
 func (_c *AssetCreate) Mutation() *AssetMutation {
 	return _c.mutation
@@ -195,6 +309,18 @@ func (_c *AssetCreate) defaults() {
 		v := asset.DefaultIsCompressed
 		_c.mutation.SetIsCompressed(v)
 	}
+	if _, ok := _c.mutation.AlreadyOptimal(); !ok {
+		v := asset.DefaultAlreadyOptimal
+		_c.mutation.SetAlreadyOptimal(v)
+	}
+	if _, ok := _c.mutation.MetadataStripped(); !ok {
+		v := asset.DefaultMetadataStripped
+		_c.mutation.SetMetadataStripped(v)
+	}
+	if _, ok := _c.mutation.HasLocation(); !ok {
+		v := asset.DefaultHasLocation
+		_c.mutation.SetHasLocation(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := asset.DefaultID()
 		_c.mutation.SetID(v)
@@ -224,6 +350,15 @@ func (_c *AssetCreate) check() error {
 	if _, ok := _c.mutation.IsCompressed(); !ok {
 		return &ValidationError{Name: "is_compressed", err: errors.New(`ent: missing required field "Asset.is_compressed"`)}
 	}
+	if _, ok := _c.mutation.AlreadyOptimal(); !ok {
+		return &ValidationError{Name: "already_optimal", err: errors.New(`ent: missing required field "Asset.already_optimal"`)}
+	}
+	if _, ok := _c.mutation.MetadataStripped(); !ok {
+		return &ValidationError{Name: "metadata_stripped", err: errors.New(`ent: missing required field "Asset.metadata_stripped"`)}
+	}
+	if _, ok := _c.mutation.HasLocation(); !ok {
+		return &ValidationError{Name: "has_location", err: errors.New(`ent: missing required field "Asset.has_location"`)}
+	}
 	return nil
 }
 
@@ -271,6 +406,10 @@ func (_c *AssetCreate) createSpec() (*Asset, *sqlgraph.CreateSpec) {
 		_spec.SetField(asset.FieldExtension, field.TypeString, value)
 		_node.Extension = value
 	}
+	if value, ok := _c.mutation.MimeType(); ok {
+		_spec.SetField(asset.FieldMimeType, field.TypeString, value)
+		_node.MimeType = value
+	}
 	if value, ok := _c.mutation.FileSizeBytes(); ok {
 		_spec.SetField(asset.FieldFileSizeBytes, field.TypeInt64, value)
 		_node.FileSizeBytes = value
@@ -287,14 +426,38 @@ func (_c *AssetCreate) createSpec() (*Asset, *sqlgraph.CreateSpec) {
 		_spec.SetField(asset.FieldIsCompressed, field.TypeBool, value)
 		_node.IsCompressed = value
 	}
+	if value, ok := _c.mutation.CompressedAt(); ok {
+		_spec.SetField(asset.FieldCompressedAt, field.TypeTime, value)
+		_node.CompressedAt = value
+	}
 	if value, ok := _c.mutation.OriginalPath(); ok {
 		_spec.SetField(asset.FieldOriginalPath, field.TypeString, value)
 		_node.OriginalPath = value
 	}
+	if value, ok := _c.mutation.OriginalKeptAt(); ok {
+		_spec.SetField(asset.FieldOriginalKeptAt, field.TypeTime, value)
+		_node.OriginalKeptAt = value
+	}
 	if value, ok := _c.mutation.CompressionRatio(); ok {
 		_spec.SetField(asset.FieldCompressionRatio, field.TypeFloat64, value)
 		_node.CompressionRatio = value
 	}
+	if value, ok := _c.mutation.AlreadyOptimal(); ok {
+		_spec.SetField(asset.FieldAlreadyOptimal, field.TypeBool, value)
+		_node.AlreadyOptimal = value
+	}
+	if value, ok := _c.mutation.MetadataStripped(); ok {
+		_spec.SetField(asset.FieldMetadataStripped, field.TypeBool, value)
+		_node.MetadataStripped = value
+	}
+	if value, ok := _c.mutation.HasLocation(); ok {
+		_spec.SetField(asset.FieldHasLocation, field.TypeBool, value)
+		_node.HasLocation = value
+	}
+	if value, ok := _c.mutation.HlsPath(); ok {
+		_spec.SetField(asset.FieldHlsPath, field.TypeString, value)
+		_node.HlsPath = value
+	}
 	if nodes := _c.mutation.TagsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -327,6 +490,22 @@ func (_c *AssetCreate) createSpec() (*Asset, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.VariantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   asset.VariantsTable,
+			Columns: []string{asset.VariantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(variant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -16,6 +16,7 @@ import (
 	"github.com/adimail/asset-manager/ent/compressionjob"
 	"github.com/adimail/asset-manager/ent/predicate"
 	"github.com/adimail/asset-manager/ent/tag"
+	"github.com/adimail/asset-manager/ent/variant"
 )
 
 // AssetQuery is the builder for querying Asset entities.
@@ -27,6 +28,7 @@ type AssetQuery struct {
 	predicates          []predicate.Asset
 	withTags            *TagQuery
 	withCompressionJobs *CompressionJobQuery
+	withVariants        *VariantQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +109,28 @@ func (_q *AssetQuery) QueryCompressionJobs() *CompressionJobQuery {
 	return query
 }
 
+// QueryVariants chains the current query on the "variants" edge.
+func (_q *AssetQuery) QueryVariants() *VariantQuery {
+	query := (&VariantClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(asset.Table, asset.FieldID, selector),
+			sqlgraph.To(variant.Table, variant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, asset.VariantsTable, asset.VariantsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Asset entity from the query.
 // Returns a *NotFoundError when no Asset was found.
 func (_q *AssetQuery) First(ctx context.Context) (*Asset, error) {
@@ -301,6 +325,7 @@ func (_q *AssetQuery) Clone() *AssetQuery {
 		predicates:          append([]predicate.Asset{}, _q.predicates...),
 		withTags:            _q.withTags.Clone(),
 		withCompressionJobs: _q.withCompressionJobs.Clone(),
+		withVariants:        _q.withVariants.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -329,6 +354,17 @@ func (_q *AssetQuery) WithCompressionJobs(opts ...func(*CompressionJobQuery)) *A
 	return _q
 }
 
+// WithVariants tells the query-builder to eager-load the nodes that are connected to
+// the "variants" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AssetQuery) WithVariants(opts ...func(*VariantQuery)) *AssetQuery {
+	query := (&VariantClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withVariants = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -407,9 +443,10 @@ func (_q *AssetQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Asset,
 	var (
 		nodes       = []*Asset{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			_q.withTags != nil,
 			_q.withCompressionJobs != nil,
+			_q.withVariants != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -444,6 +481,13 @@ func (_q *AssetQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Asset,
 			return nil, err
 		}
 	}
+	if query := _q.withVariants; query != nil {
+		if err := _q.loadVariants(ctx, query, nodes,
+			func(n *Asset) { n.Edges.Variants = []*Variant{} },
+			func(n *Asset, e *Variant) { n.Edges.Variants = append(n.Edges.Variants, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -539,6 +583,37 @@ func (_q *AssetQuery) loadCompressionJobs(ctx context.Context, query *Compressio
 	}
 	return nil
 }
+func (_q *AssetQuery) loadVariants(ctx context.Context, query *VariantQuery, nodes []*Asset, init func(*Asset), assign func(*Asset, *Variant)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Asset)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Variant(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(asset.VariantsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.asset_variants
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "asset_variants" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "asset_variants" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *AssetQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
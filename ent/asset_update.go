@@ -15,6 +15,7 @@ import (
 	"github.com/adimail/asset-manager/ent/compressionjob"
 	"github.com/adimail/asset-manager/ent/predicate"
 	"github.com/adimail/asset-manager/ent/tag"
+	"github.com/adimail/asset-manager/ent/variant"
 )
 
 // AssetUpdate is the builder for updating Asset entities.
@@ -72,6 +73,26 @@ func (_u *AssetUpdate) SetNillableExtension(v *string) *AssetUpdate {
 	return _u
 }
 
+// SetMimeType sets the "mime_type" field.
+func (_u *AssetUpdate) SetMimeType(v string) *AssetUpdate {
+	_u.mutation.SetMimeType(v)
+	return _u
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (_u *AssetUpdate) SetNillableMimeType(v *string) *AssetUpdate {
+	if v != nil {
+		_u.SetMimeType(*v)
+	}
+	return _u
+}
+
+// ClearMimeType clears the value of the "mime_type" field.
+func (_u *AssetUpdate) ClearMimeType() *AssetUpdate {
+	_u.mutation.ClearMimeType()
+	return _u
+}
+
 // SetFileSizeBytes sets the "file_size_bytes" field.
 func (_u *AssetUpdate) SetFileSizeBytes(v int64) *AssetUpdate {
 	_u.mutation.ResetFileSizeBytes()
@@ -135,6 +156,26 @@ func (_u *AssetUpdate) SetNillableIsCompressed(v *bool) *AssetUpdate {
 	return _u
 }
 
+// SetCompressedAt sets the "compressed_at" field.
+func (_u *AssetUpdate) SetCompressedAt(v time.Time) *AssetUpdate {
+	_u.mutation.SetCompressedAt(v)
+	return _u
+}
+
+// SetNillableCompressedAt sets the "compressed_at" field if the given value is not nil.
+func (_u *AssetUpdate) SetNillableCompressedAt(v *time.Time) *AssetUpdate {
+	if v != nil {
+		_u.SetCompressedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompressedAt clears the value of the "compressed_at" field.
+func (_u *AssetUpdate) ClearCompressedAt() *AssetUpdate {
+	_u.mutation.ClearCompressedAt()
+	return _u
+}
+
 // SetOriginalPath sets the "original_path" field.
 func (_u *AssetUpdate) SetOriginalPath(v string) *AssetUpdate {
 	_u.mutation.SetOriginalPath(v)
@@ -155,6 +196,26 @@ func (_u *AssetUpdate) ClearOriginalPath() *AssetUpdate {
 	return _u
 }
 
+// SetOriginalKeptAt sets the "original_kept_at" field.
+func (_u *AssetUpdate) SetOriginalKeptAt(v time.Time) *AssetUpdate {
+	_u.mutation.SetOriginalKeptAt(v)
+	return _u
+}
+
+// SetNillableOriginalKeptAt sets the "original_kept_at" field if the given value is not nil.
+func (_u *AssetUpdate) SetNillableOriginalKeptAt(v *time.Time) *AssetUpdate {
+	if v != nil {
+		_u.SetOriginalKeptAt(*v)
+	}
+	return _u
+}
+
+// ClearOriginalKeptAt clears the value of the "original_kept_at" field.
+func (_u *AssetUpdate) ClearOriginalKeptAt() *AssetUpdate {
+	_u.mutation.ClearOriginalKeptAt()
+	return _u
+}
+
 // SetCompressionRatio sets the "compression_ratio" field.
 func (_u *AssetUpdate) SetCompressionRatio(v float64) *AssetUpdate {
 	_u.mutation.ResetCompressionRatio()
@@ -182,6 +243,68 @@ func (_u *AssetUpdate) ClearCompressionRatio() *AssetUpdate {
 	return _u
 }
 
+// SetAlreadyOptimal sets the "already_optimal" field.
+func (_u *AssetUpdate) SetAlreadyOptimal(v bool) *AssetUpdate {
+	_u.mutation.SetAlreadyOptimal(v)
+	return _u
+}
+
+// SetNillableAlreadyOptimal sets the "already_optimal" field if the given value is not nil.
+func (_u *AssetUpdate) SetNillableAlreadyOptimal(v *bool) *AssetUpdate {
+	if v != nil {
+		_u.SetAlreadyOptimal(*v)
+	}
+	return _u
+}
+
+// SetMetadataStripped sets the "metadata_stripped" field.
+func (_u *AssetUpdate) SetMetadataStripped(v bool) *AssetUpdate {
+	_u.mutation.SetMetadataStripped(v)
+	return _u
+}
+
+// SetNillableMetadataStripped sets the "metadata_stripped" field if the given value is not nil.
+func (_u *AssetUpdate) SetNillableMetadataStripped(v *bool) *AssetUpdate {
+	if v != nil {
+		_u.SetMetadataStripped(*v)
+	}
+	return _u
+}
+
+// SetHasLocation sets the "has_location" field.
+func (_u *AssetUpdate) SetHasLocation(v bool) *AssetUpdate {
+	_u.mutation.SetHasLocation(v)
+	return _u
+}
+
+// SetNillableHasLocation sets the "has_location" field if the given value is not nil.
+func (_u *AssetUpdate) SetNillableHasLocation(v *bool) *AssetUpdate {
+	if v != nil {
+		_u.SetHasLocation(*v)
+	}
+	return _u
+}
+
+// SetHlsPath sets the "hls_path" field.
+func (_u *AssetUpdate) SetHlsPath(v string) *AssetUpdate {
+	_u.mutation.SetHlsPath(v)
+	return _u
+}
+
+// SetNillableHlsPath sets the "hls_path" field if the given value is not nil.
+func (_u *AssetUpdate) SetNillableHlsPath(v *string) *AssetUpdate {
+	if v != nil {
+		_u.SetHlsPath(*v)
+	}
+	return _u
+}
+
+// ClearHlsPath clears the value of the "hls_path" field.
+func (_u *AssetUpdate) ClearHlsPath() *AssetUpdate {
+	_u.mutation.ClearHlsPath()
+	return _u
+}
+
 // AddTagIDs adds the "tags" edge to the Tag entity by IDs.
 func (_u *AssetUpdate) AddTagIDs(ids ...string) *AssetUpdate {
 	_u.mutation.AddTagIDs(ids...)
@@ -212,6 +335,21 @@ func (_u *AssetUpdate) AddCompressionJobs(v ...*CompressionJob) *AssetUpdate {
 	return _u.AddCompressionJobIDs(ids...)
 }
 
+// AddVariantIDs adds the "variants" edge to the Variant entity by IDs.
+func (_u *AssetUpdate) AddVariantIDs(ids ...string) *AssetUpdate {
+	_u.mutation.AddVariantIDs(ids...)
+	return _u
+}
+
+// AddVariants adds the "variants" edges to the Variant entity.
+func (_u *AssetUpdate) AddVariants(v ...*Variant) *AssetUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVariantIDs(ids...)
+}
+
 // Mutation returns the AssetMutation object of the builder.
 func (_u *AssetUpdate) Mutation() *AssetMutation {
 	return _u.mutation
@@ -259,6 +397,27 @@ func (_u *AssetUpdate) RemoveCompressionJobs(v ...*CompressionJob) *AssetUpdate
 	return _u.RemoveCompressionJobIDs(ids...)
 }
 
+// ClearVariants clears all "variants" edges to the Variant entity.
+func (_u *AssetUpdate) ClearVariants() *AssetUpdate {
+	_u.mutation.ClearVariants()
+	return _u
+}
+
+// RemoveVariantIDs removes the "variants" edge to Variant entities by IDs.
+func (_u *AssetUpdate) RemoveVariantIDs(ids ...string) *AssetUpdate {
+	_u.mutation.RemoveVariantIDs(ids...)
+	return _u
+}
+
+// RemoveVariants removes "variants" edges to Variant entities.
+func (_u *AssetUpdate) RemoveVariants(v ...*Variant) *AssetUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVariantIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *AssetUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -304,6 +463,12 @@ func (_u *AssetUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Extension(); ok {
 		_spec.SetField(asset.FieldExtension, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.MimeType(); ok {
+		_spec.SetField(asset.FieldMimeType, field.TypeString, value)
+	}
+	if _u.mutation.MimeTypeCleared() {
+		_spec.ClearField(asset.FieldMimeType, field.TypeString)
+	}
 	if value, ok := _u.mutation.FileSizeBytes(); ok {
 		_spec.SetField(asset.FieldFileSizeBytes, field.TypeInt64, value)
 	}
@@ -319,12 +484,24 @@ func (_u *AssetUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.IsCompressed(); ok {
 		_spec.SetField(asset.FieldIsCompressed, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.CompressedAt(); ok {
+		_spec.SetField(asset.FieldCompressedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompressedAtCleared() {
+		_spec.ClearField(asset.FieldCompressedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.OriginalPath(); ok {
 		_spec.SetField(asset.FieldOriginalPath, field.TypeString, value)
 	}
 	if _u.mutation.OriginalPathCleared() {
 		_spec.ClearField(asset.FieldOriginalPath, field.TypeString)
 	}
+	if value, ok := _u.mutation.OriginalKeptAt(); ok {
+		_spec.SetField(asset.FieldOriginalKeptAt, field.TypeTime, value)
+	}
+	if _u.mutation.OriginalKeptAtCleared() {
+		_spec.ClearField(asset.FieldOriginalKeptAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.CompressionRatio(); ok {
 		_spec.SetField(asset.FieldCompressionRatio, field.TypeFloat64, value)
 	}
@@ -334,6 +511,21 @@ func (_u *AssetUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.CompressionRatioCleared() {
 		_spec.ClearField(asset.FieldCompressionRatio, field.TypeFloat64)
 	}
+	if value, ok := _u.mutation.AlreadyOptimal(); ok {
+		_spec.SetField(asset.FieldAlreadyOptimal, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MetadataStripped(); ok {
+		_spec.SetField(asset.FieldMetadataStripped, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.HasLocation(); ok {
+		_spec.SetField(asset.FieldHasLocation, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.HlsPath(); ok {
+		_spec.SetField(asset.FieldHlsPath, field.TypeString, value)
+	}
+	if _u.mutation.HlsPathCleared() {
+		_spec.ClearField(asset.FieldHlsPath, field.TypeString)
+	}
 	if _u.mutation.TagsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -424,6 +616,51 @@ func (_u *AssetUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.VariantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   asset.VariantsTable,
+			Columns: []string{asset.VariantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(variant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVariantsIDs(); len(nodes) > 0 && !_u.mutation.VariantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   asset.VariantsTable,
+			Columns: []string{asset.VariantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(variant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VariantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   asset.VariantsTable,
+			Columns: []string{asset.VariantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(variant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{asset.Label}
@@ -486,6 +723,26 @@ func (_u *AssetUpdateOne) SetNillableExtension(v *string) *AssetUpdateOne {
 	return _u
 }
 
+// SetMimeType sets the "mime_type" field.
+func (_u *AssetUpdateOne) SetMimeType(v string) *AssetUpdateOne {
+	_u.mutation.SetMimeType(v)
+	return _u
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (_u *AssetUpdateOne) SetNillableMimeType(v *string) *AssetUpdateOne {
+	if v != nil {
+		_u.SetMimeType(*v)
+	}
+	return _u
+}
+
+// ClearMimeType clears the value of the "mime_type" field.
+func (_u *AssetUpdateOne) ClearMimeType() *AssetUpdateOne {
+	_u.mutation.ClearMimeType()
+	return _u
+}
+
 // SetFileSizeBytes sets the "file_size_bytes" field.
 func (_u *AssetUpdateOne) SetFileSizeBytes(v int64) *AssetUpdateOne {
 	_u.mutation.ResetFileSizeBytes()
@@ -549,6 +806,26 @@ func (_u *AssetUpdateOne) SetNillableIsCompressed(v *bool) *AssetUpdateOne {
 	return _u
 }
 
+// SetCompressedAt sets the "compressed_at" field.
+func (_u *AssetUpdateOne) SetCompressedAt(v time.Time) *AssetUpdateOne {
+	_u.mutation.SetCompressedAt(v)
+	return _u
+}
+
+// SetNillableCompressedAt sets the "compressed_at" field if the given value is not nil.
+func (_u *AssetUpdateOne) SetNillableCompressedAt(v *time.Time) *AssetUpdateOne {
+	if v != nil {
+		_u.SetCompressedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompressedAt clears the value of the "compressed_at" field.
+func (_u *AssetUpdateOne) ClearCompressedAt() *AssetUpdateOne {
+	_u.mutation.ClearCompressedAt()
+	return _u
+}
+
 // SetOriginalPath sets the "original_path" field.
 func (_u *AssetUpdateOne) SetOriginalPath(v string) *AssetUpdateOne {
 	_u.mutation.SetOriginalPath(v)
@@ -569,6 +846,26 @@ func (_u *AssetUpdateOne) ClearOriginalPath() *AssetUpdateOne {
 	return _u
 }
 
+// SetOriginalKeptAt sets the "original_kept_at" field.
+func (_u *AssetUpdateOne) SetOriginalKeptAt(v time.Time) *AssetUpdateOne {
+	_u.mutation.SetOriginalKeptAt(v)
+	return _u
+}
+
+// SetNillableOriginalKeptAt sets the "original_kept_at" field if the given value is not nil.
+func (_u *AssetUpdateOne) SetNillableOriginalKeptAt(v *time.Time) *AssetUpdateOne {
+	if v != nil {
+		_u.SetOriginalKeptAt(*v)
+	}
+	return _u
+}
+
+// ClearOriginalKeptAt clears the value of the "original_kept_at" field.
+func (_u *AssetUpdateOne) ClearOriginalKeptAt() *AssetUpdateOne {
+	_u.mutation.ClearOriginalKeptAt()
+	return _u
+}
+
 // SetCompressionRatio sets the "compression_ratio" field.
 func (_u *AssetUpdateOne) SetCompressionRatio(v float64) *AssetUpdateOne {
 	_u.mutation.ResetCompressionRatio()
@@ -596,6 +893,68 @@ func (_u *AssetUpdateOne) ClearCompressionRatio() *AssetUpdateOne {
 	return _u
 }
 
+// SetAlreadyOptimal sets the "already_optimal" field.
+func (_u *AssetUpdateOne) SetAlreadyOptimal(v bool) *AssetUpdateOne {
+	_u.mutation.SetAlreadyOptimal(v)
+	return _u
+}
+
+// SetNillableAlreadyOptimal sets the "already_optimal" field if the given value is not nil.
+func (_u *AssetUpdateOne) SetNillableAlreadyOptimal(v *bool) *AssetUpdateOne {
+	if v != nil {
+		_u.SetAlreadyOptimal(*v)
+	}
+	return _u
+}
+
+// SetMetadataStripped sets the "metadata_stripped" field.
+func (_u *AssetUpdateOne) SetMetadataStripped(v bool) *AssetUpdateOne {
+	_u.mutation.SetMetadataStripped(v)
+	return _u
+}
+
+// SetNillableMetadataStripped sets the "metadata_stripped" field if the given value is not nil.
+func (_u *AssetUpdateOne) SetNillableMetadataStripped(v *bool) *AssetUpdateOne {
+	if v != nil {
+		_u.SetMetadataStripped(*v)
+	}
+	return _u
+}
+
+// SetHasLocation sets the "has_location" field.
+func (_u *AssetUpdateOne) SetHasLocation(v bool) *AssetUpdateOne {
+	_u.mutation.SetHasLocation(v)
+	return _u
+}
+
+// SetNillableHasLocation sets the "has_location" field if the given value is not nil.
+func (_u *AssetUpdateOne) SetNillableHasLocation(v *bool) *AssetUpdateOne {
+	if v != nil {
+		_u.SetHasLocation(*v)
+	}
+	return _u
+}
+
+// SetHlsPath sets the "hls_path" field.
+func (_u *AssetUpdateOne) SetHlsPath(v string) *AssetUpdateOne {
+	_u.mutation.SetHlsPath(v)
+	return _u
+}
+
+// SetNillableHlsPath sets the "hls_path" field if the given value is not nil.
+func (_u *AssetUpdateOne) SetNillableHlsPath(v *string) *AssetUpdateOne {
+	if v != nil {
+		_u.SetHlsPath(*v)
+	}
+	return _u
+}
+
+// ClearHlsPath clears the value of the "hls_path" field.
+func (_u *AssetUpdateOne) ClearHlsPath() *AssetUpdateOne {
+	_u.mutation.ClearHlsPath()
+	return _u
+}
+
 // AddTagIDs adds the "tags" edge to the Tag entity by IDs.
 func (_u *AssetUpdateOne) AddTagIDs(ids ...string) *AssetUpdateOne {
 	_u.mutation.AddTagIDs(ids...)
@@ -626,6 +985,21 @@ func (_u *AssetUpdateOne) AddCompressionJobs(v ...*CompressionJob) *AssetUpdateO
 	return _u.AddCompressionJobIDs(ids...)
 }
 
+// AddVariantIDs adds the "variants" edge to the Variant entity by IDs.
+func (_u *AssetUpdateOne) AddVariantIDs(ids ...string) *AssetUpdateOne {
+	_u.mutation.AddVariantIDs(ids...)
+	return _u
+}
+
+// AddVariants adds the "variants" edges to the Variant entity.
+func (_u *AssetUpdateOne) AddVariants(v ...*Variant) *AssetUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVariantIDs(ids...)
+}
+
 // Mutation returns the AssetMutation object of the builder.
 func (_u *AssetUpdateOne) Mutation() *AssetMutation {
 	return _u.mutation
@@ -673,6 +1047,27 @@ func (_u *AssetUpdateOne) RemoveCompressionJobs(v ...*CompressionJob) *AssetUpda
 	return _u.RemoveCompressionJobIDs(ids...)
 }
 
+// ClearVariants clears all "variants" edges to the Variant entity.
+func (_u *AssetUpdateOne) ClearVariants() *AssetUpdateOne {
+	_u.mutation.ClearVariants()
+	return _u
+}
+
+// RemoveVariantIDs removes the "variants" edge to Variant entities by IDs.
+func (_u *AssetUpdateOne) RemoveVariantIDs(ids ...string) *AssetUpdateOne {
+	_u.mutation.RemoveVariantIDs(ids...)
+	return _u
+}
+
+// RemoveVariants removes "variants" edges to Variant entities.
+func (_u *AssetUpdateOne) RemoveVariants(v ...*Variant) *AssetUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVariantIDs(ids...)
+}
+
 // Where appends a list predicates to the AssetUpdate builder.
 func (_u *AssetUpdateOne) Where(ps ...predicate.Asset) *AssetUpdateOne {
 	_u.mutation.Where(ps...)
@@ -748,6 +1143,12 @@ func (_u *AssetUpdateOne) sqlSave(ctx context.Context) (_node *Asset, err error)
 	if value, ok := _u.mutation.Extension(); ok {
 		_spec.SetField(asset.FieldExtension, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.MimeType(); ok {
+		_spec.SetField(asset.FieldMimeType, field.TypeString, value)
+	}
+	if _u.mutation.MimeTypeCleared() {
+		_spec.ClearField(asset.FieldMimeType, field.TypeString)
+	}
 	if value, ok := _u.mutation.FileSizeBytes(); ok {
 		_spec.SetField(asset.FieldFileSizeBytes, field.TypeInt64, value)
 	}
@@ -763,12 +1164,24 @@ func (_u *AssetUpdateOne) sqlSave(ctx context.Context) (_node *Asset, err error)
 	if value, ok := _u.mutation.IsCompressed(); ok {
 		_spec.SetField(asset.FieldIsCompressed, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.CompressedAt(); ok {
+		_spec.SetField(asset.FieldCompressedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompressedAtCleared() {
+		_spec.ClearField(asset.FieldCompressedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.OriginalPath(); ok {
 		_spec.SetField(asset.FieldOriginalPath, field.TypeString, value)
 	}
 	if _u.mutation.OriginalPathCleared() {
 		_spec.ClearField(asset.FieldOriginalPath, field.TypeString)
 	}
+	if value, ok := _u.mutation.OriginalKeptAt(); ok {
+		_spec.SetField(asset.FieldOriginalKeptAt, field.TypeTime, value)
+	}
+	if _u.mutation.OriginalKeptAtCleared() {
+		_spec.ClearField(asset.FieldOriginalKeptAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.CompressionRatio(); ok {
 		_spec.SetField(asset.FieldCompressionRatio, field.TypeFloat64, value)
 	}
@@ -778,6 +1191,21 @@ func (_u *AssetUpdateOne) sqlSave(ctx context.Context) (_node *Asset, err error)
 	if _u.mutation.CompressionRatioCleared() {
 		_spec.ClearField(asset.FieldCompressionRatio, field.TypeFloat64)
 	}
+	if value, ok := _u.mutation.AlreadyOptimal(); ok {
+		_spec.SetField(asset.FieldAlreadyOptimal, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MetadataStripped(); ok {
+		_spec.SetField(asset.FieldMetadataStripped, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.HasLocation(); ok {
+		_spec.SetField(asset.FieldHasLocation, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.HlsPath(); ok {
+		_spec.SetField(asset.FieldHlsPath, field.TypeString, value)
+	}
+	if _u.mutation.HlsPathCleared() {
+		_spec.ClearField(asset.FieldHlsPath, field.TypeString)
+	}
 	if _u.mutation.TagsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -868,6 +1296,51 @@ func (_u *AssetUpdateOne) sqlSave(ctx context.Context) (_node *Asset, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.VariantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   asset.VariantsTable,
+			Columns: []string{asset.VariantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(variant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVariantsIDs(); len(nodes) > 0 && !_u.mutation.VariantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   asset.VariantsTable,
+			Columns: []string{asset.VariantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(variant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VariantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   asset.VariantsTable,
+			Columns: []string{asset.VariantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(variant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Asset{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
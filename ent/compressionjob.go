@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/adimail/asset-manager/ent/asset"
 	"github.com/adimail/asset-manager/ent/compressionjob"
+	"github.com/adimail/asset-manager/ent/schema"
 )
 
 // CompressionJob is the model entity for the CompressionJob schema.
@@ -18,16 +20,70 @@ type CompressionJob struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID string `json:"id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
 	// Status holds the value of the "status" field.
 	Status string `json:"status,omitempty"`
 	// Progress holds the value of the "progress" field.
 	Progress int `json:"progress,omitempty"`
 	// Error holds the value of the "error" field.
 	Error string `json:"error,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
 	// StartedAt holds the value of the "started_at" field.
 	StartedAt time.Time `json:"started_at,omitempty"`
 	// CompletedAt holds the value of the "completed_at" field.
 	CompletedAt time.Time `json:"completed_at,omitempty"`
+	// Profile holds the value of the "profile" field.
+	Profile string `json:"profile,omitempty"`
+	// TargetFormat holds the value of the "target_format" field.
+	TargetFormat string `json:"target_format,omitempty"`
+	// AudioBitrate holds the value of the "audio_bitrate" field.
+	AudioBitrate string `json:"audio_bitrate,omitempty"`
+	// Normalize holds the value of the "normalize" field.
+	Normalize bool `json:"normalize,omitempty"`
+	// Outcome holds the value of the "outcome" field.
+	Outcome string `json:"outcome,omitempty"`
+	// Steps holds the value of the "steps" field.
+	Steps []schema.PipelineStep `json:"steps,omitempty"`
+	// TargetBytes holds the value of the "target_bytes" field.
+	TargetBytes int64 `json:"target_bytes,omitempty"`
+	// TargetParams holds the value of the "target_params" field.
+	TargetParams schema.TargetParams `json:"target_params,omitempty"`
+	// Ssim holds the value of the "ssim" field.
+	Ssim *float64 `json:"ssim,omitempty"`
+	// Psnr holds the value of the "psnr" field.
+	Psnr *float64 `json:"psnr,omitempty"`
+	// Vmaf holds the value of the "vmaf" field.
+	Vmaf *float64 `json:"vmaf,omitempty"`
+	// EncoderParams holds the value of the "encoder_params" field.
+	EncoderParams schema.EncoderParams `json:"encoder_params,omitempty"`
+	// FfmpegVersion holds the value of the "ffmpeg_version" field.
+	FfmpegVersion string `json:"ffmpeg_version,omitempty"`
+	// Commands holds the value of the "commands" field.
+	Commands []string `json:"commands,omitempty"`
+	// Log holds the value of the "log" field.
+	Log string `json:"log,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority int `json:"priority,omitempty"`
+	// Submitter holds the value of the "submitter" field.
+	Submitter string `json:"submitter,omitempty"`
+	// Pool holds the value of the "pool" field.
+	Pool string `json:"pool,omitempty"`
+	// WorkerID holds the value of the "worker_id" field.
+	WorkerID string `json:"worker_id,omitempty"`
+	// LeaseExpiresAt holds the value of the "lease_expires_at" field.
+	LeaseExpiresAt time.Time `json:"lease_expires_at,omitempty"`
+	// CancelRequested holds the value of the "cancel_requested" field.
+	CancelRequested bool `json:"cancel_requested,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// MaxAttempts holds the value of the "max_attempts" field.
+	MaxAttempts int `json:"max_attempts,omitempty"`
+	// NextAttemptAt holds the value of the "next_attempt_at" field.
+	NextAttemptAt time.Time `json:"next_attempt_at,omitempty"`
+	// AttemptErrors holds the value of the "attempt_errors" field.
+	AttemptErrors []string `json:"attempt_errors,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CompressionJobQuery when eager-loading is set.
 	Edges                  CompressionJobEdges `json:"edges"`
@@ -60,11 +116,17 @@ func (*CompressionJob) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case compressionjob.FieldProgress:
+		case compressionjob.FieldSteps, compressionjob.FieldTargetParams, compressionjob.FieldEncoderParams, compressionjob.FieldCommands, compressionjob.FieldAttemptErrors:
+			values[i] = new([]byte)
+		case compressionjob.FieldNormalize, compressionjob.FieldCancelRequested:
+			values[i] = new(sql.NullBool)
+		case compressionjob.FieldSsim, compressionjob.FieldPsnr, compressionjob.FieldVmaf:
+			values[i] = new(sql.NullFloat64)
+		case compressionjob.FieldProgress, compressionjob.FieldTargetBytes, compressionjob.FieldPriority, compressionjob.FieldAttempts, compressionjob.FieldMaxAttempts:
 			values[i] = new(sql.NullInt64)
-		case compressionjob.FieldID, compressionjob.FieldStatus, compressionjob.FieldError:
+		case compressionjob.FieldID, compressionjob.FieldKind, compressionjob.FieldStatus, compressionjob.FieldError, compressionjob.FieldProfile, compressionjob.FieldTargetFormat, compressionjob.FieldAudioBitrate, compressionjob.FieldOutcome, compressionjob.FieldFfmpegVersion, compressionjob.FieldLog, compressionjob.FieldSubmitter, compressionjob.FieldPool, compressionjob.FieldWorkerID:
 			values[i] = new(sql.NullString)
-		case compressionjob.FieldStartedAt, compressionjob.FieldCompletedAt:
+		case compressionjob.FieldCreatedAt, compressionjob.FieldStartedAt, compressionjob.FieldCompletedAt, compressionjob.FieldLeaseExpiresAt, compressionjob.FieldNextAttemptAt:
 			values[i] = new(sql.NullTime)
 		case compressionjob.ForeignKeys[0]: // asset_compression_jobs
 			values[i] = new(sql.NullString)
@@ -89,6 +151,12 @@ func (_m *CompressionJob) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ID = value.String
 			}
+		case compressionjob.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = value.String
+			}
 		case compressionjob.FieldStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
@@ -107,6 +175,12 @@ func (_m *CompressionJob) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Error = value.String
 			}
+		case compressionjob.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
 		case compressionjob.FieldStartedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field started_at", values[i])
@@ -119,6 +193,169 @@ func (_m *CompressionJob) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.CompletedAt = value.Time
 			}
+		case compressionjob.FieldProfile:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field profile", values[i])
+			} else if value.Valid {
+				_m.Profile = value.String
+			}
+		case compressionjob.FieldTargetFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_format", values[i])
+			} else if value.Valid {
+				_m.TargetFormat = value.String
+			}
+		case compressionjob.FieldAudioBitrate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field audio_bitrate", values[i])
+			} else if value.Valid {
+				_m.AudioBitrate = value.String
+			}
+		case compressionjob.FieldNormalize:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field normalize", values[i])
+			} else if value.Valid {
+				_m.Normalize = value.Bool
+			}
+		case compressionjob.FieldOutcome:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field outcome", values[i])
+			} else if value.Valid {
+				_m.Outcome = value.String
+			}
+		case compressionjob.FieldSteps:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field steps", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Steps); err != nil {
+					return fmt.Errorf("unmarshal field steps: %w", err)
+				}
+			}
+		case compressionjob.FieldTargetBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field target_bytes", values[i])
+			} else if value.Valid {
+				_m.TargetBytes = value.Int64
+			}
+		case compressionjob.FieldTargetParams:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field target_params", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TargetParams); err != nil {
+					return fmt.Errorf("unmarshal field target_params: %w", err)
+				}
+			}
+		case compressionjob.FieldSsim:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field ssim", values[i])
+			} else if value.Valid {
+				_m.Ssim = new(float64)
+				*_m.Ssim = value.Float64
+			}
+		case compressionjob.FieldPsnr:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field psnr", values[i])
+			} else if value.Valid {
+				_m.Psnr = new(float64)
+				*_m.Psnr = value.Float64
+			}
+		case compressionjob.FieldVmaf:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field vmaf", values[i])
+			} else if value.Valid {
+				_m.Vmaf = new(float64)
+				*_m.Vmaf = value.Float64
+			}
+		case compressionjob.FieldEncoderParams:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field encoder_params", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.EncoderParams); err != nil {
+					return fmt.Errorf("unmarshal field encoder_params: %w", err)
+				}
+			}
+		case compressionjob.FieldFfmpegVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ffmpeg_version", values[i])
+			} else if value.Valid {
+				_m.FfmpegVersion = value.String
+			}
+		case compressionjob.FieldCommands:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field commands", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Commands); err != nil {
+					return fmt.Errorf("unmarshal field commands: %w", err)
+				}
+			}
+		case compressionjob.FieldLog:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field log", values[i])
+			} else if value.Valid {
+				_m.Log = value.String
+			}
+		case compressionjob.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				_m.Priority = int(value.Int64)
+			}
+		case compressionjob.FieldSubmitter:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field submitter", values[i])
+			} else if value.Valid {
+				_m.Submitter = value.String
+			}
+		case compressionjob.FieldPool:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pool", values[i])
+			} else if value.Valid {
+				_m.Pool = value.String
+			}
+		case compressionjob.FieldWorkerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field worker_id", values[i])
+			} else if value.Valid {
+				_m.WorkerID = value.String
+			}
+		case compressionjob.FieldLeaseExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field lease_expires_at", values[i])
+			} else if value.Valid {
+				_m.LeaseExpiresAt = value.Time
+			}
+		case compressionjob.FieldCancelRequested:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field cancel_requested", values[i])
+			} else if value.Valid {
+				_m.CancelRequested = value.Bool
+			}
+		case compressionjob.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				_m.Attempts = int(value.Int64)
+			}
+		case compressionjob.FieldMaxAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_attempts", values[i])
+			} else if value.Valid {
+				_m.MaxAttempts = int(value.Int64)
+			}
+		case compressionjob.FieldNextAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_attempt_at", values[i])
+			} else if value.Valid {
+				_m.NextAttemptAt = value.Time
+			}
+		case compressionjob.FieldAttemptErrors:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field attempt_errors", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AttemptErrors); err != nil {
+					return fmt.Errorf("unmarshal field attempt_errors: %w", err)
+				}
+			}
 		case compressionjob.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field asset_compression_jobs", values[i])
@@ -167,6 +404,9 @@ func (_m *CompressionJob) String() string {
 	var builder strings.Builder
 	builder.WriteString("CompressionJob(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("kind=")
+	builder.WriteString(_m.Kind)
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(_m.Status)
 	builder.WriteString(", ")
@@ -176,11 +416,95 @@ func (_m *CompressionJob) String() string {
 	builder.WriteString("error=")
 	builder.WriteString(_m.Error)
 	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("started_at=")
 	builder.WriteString(_m.StartedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("completed_at=")
 	builder.WriteString(_m.CompletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("profile=")
+	builder.WriteString(_m.Profile)
+	builder.WriteString(", ")
+	builder.WriteString("target_format=")
+	builder.WriteString(_m.TargetFormat)
+	builder.WriteString(", ")
+	builder.WriteString("audio_bitrate=")
+	builder.WriteString(_m.AudioBitrate)
+	builder.WriteString(", ")
+	builder.WriteString("normalize=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Normalize))
+	builder.WriteString(", ")
+	builder.WriteString("outcome=")
+	builder.WriteString(_m.Outcome)
+	builder.WriteString(", ")
+	builder.WriteString("steps=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Steps))
+	builder.WriteString(", ")
+	builder.WriteString("target_bytes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TargetBytes))
+	builder.WriteString(", ")
+	builder.WriteString("target_params=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TargetParams))
+	builder.WriteString(", ")
+	if v := _m.Ssim; v != nil {
+		builder.WriteString("ssim=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Psnr; v != nil {
+		builder.WriteString("psnr=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Vmaf; v != nil {
+		builder.WriteString("vmaf=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("encoder_params=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EncoderParams))
+	builder.WriteString(", ")
+	builder.WriteString("ffmpeg_version=")
+	builder.WriteString(_m.FfmpegVersion)
+	builder.WriteString(", ")
+	builder.WriteString("commands=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Commands))
+	builder.WriteString(", ")
+	builder.WriteString("log=")
+	builder.WriteString(_m.Log)
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("submitter=")
+	builder.WriteString(_m.Submitter)
+	builder.WriteString(", ")
+	builder.WriteString("pool=")
+	builder.WriteString(_m.Pool)
+	builder.WriteString(", ")
+	builder.WriteString("worker_id=")
+	builder.WriteString(_m.WorkerID)
+	builder.WriteString(", ")
+	builder.WriteString("lease_expires_at=")
+	builder.WriteString(_m.LeaseExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("cancel_requested=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CancelRequested))
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("max_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxAttempts))
+	builder.WriteString(", ")
+	builder.WriteString("next_attempt_at=")
+	builder.WriteString(_m.NextAttemptAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("attempt_errors=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AttemptErrors))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,8 @@
 package compressionjob
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -12,16 +14,70 @@ const (
 	Label = "compression_job"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
 	// FieldProgress holds the string denoting the progress field in the database.
 	FieldProgress = "progress"
 	// FieldError holds the string denoting the error field in the database.
 	FieldError = "error"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
 	// FieldStartedAt holds the string denoting the started_at field in the database.
 	FieldStartedAt = "started_at"
 	// FieldCompletedAt holds the string denoting the completed_at field in the database.
 	FieldCompletedAt = "completed_at"
+	// FieldProfile holds the string denoting the profile field in the database.
+	FieldProfile = "profile"
+	// FieldTargetFormat holds the string denoting the target_format field in the database.
+	FieldTargetFormat = "target_format"
+	// FieldAudioBitrate holds the string denoting the audio_bitrate field in the database.
+	FieldAudioBitrate = "audio_bitrate"
+	// FieldNormalize holds the string denoting the normalize field in the database.
+	FieldNormalize = "normalize"
+	// FieldOutcome holds the string denoting the outcome field in the database.
+	FieldOutcome = "outcome"
+	// FieldSteps holds the string denoting the steps field in the database.
+	FieldSteps = "steps"
+	// FieldTargetBytes holds the string denoting the target_bytes field in the database.
+	FieldTargetBytes = "target_bytes"
+	// FieldTargetParams holds the string denoting the target_params field in the database.
+	FieldTargetParams = "target_params"
+	// FieldSsim holds the string denoting the ssim field in the database.
+	FieldSsim = "ssim"
+	// FieldPsnr holds the string denoting the psnr field in the database.
+	FieldPsnr = "psnr"
+	// FieldVmaf holds the string denoting the vmaf field in the database.
+	FieldVmaf = "vmaf"
+	// FieldEncoderParams holds the string denoting the encoder_params field in the database.
+	FieldEncoderParams = "encoder_params"
+	// FieldFfmpegVersion holds the string denoting the ffmpeg_version field in the database.
+	FieldFfmpegVersion = "ffmpeg_version"
+	// FieldCommands holds the string denoting the commands field in the database.
+	FieldCommands = "commands"
+	// FieldLog holds the string denoting the log field in the database.
+	FieldLog = "log"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
+	// FieldSubmitter holds the string denoting the submitter field in the database.
+	FieldSubmitter = "submitter"
+	// FieldPool holds the string denoting the pool field in the database.
+	FieldPool = "pool"
+	// FieldWorkerID holds the string denoting the worker_id field in the database.
+	FieldWorkerID = "worker_id"
+	// FieldLeaseExpiresAt holds the string denoting the lease_expires_at field in the database.
+	FieldLeaseExpiresAt = "lease_expires_at"
+	// FieldCancelRequested holds the string denoting the cancel_requested field in the database.
+	FieldCancelRequested = "cancel_requested"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldMaxAttempts holds the string denoting the max_attempts field in the database.
+	FieldMaxAttempts = "max_attempts"
+	// FieldNextAttemptAt holds the string denoting the next_attempt_at field in the database.
+	FieldNextAttemptAt = "next_attempt_at"
+	// FieldAttemptErrors holds the string denoting the attempt_errors field in the database.
+	FieldAttemptErrors = "attempt_errors"
 	// EdgeAsset holds the string denoting the asset edge name in mutations.
 	EdgeAsset = "asset"
 	// Table holds the table name of the compressionjob in the database.
@@ -38,11 +94,38 @@ const (
 // Columns holds all SQL columns for compressionjob fields.
 var Columns = []string{
 	FieldID,
+	FieldKind,
 	FieldStatus,
 	FieldProgress,
 	FieldError,
+	FieldCreatedAt,
 	FieldStartedAt,
 	FieldCompletedAt,
+	FieldProfile,
+	FieldTargetFormat,
+	FieldAudioBitrate,
+	FieldNormalize,
+	FieldOutcome,
+	FieldSteps,
+	FieldTargetBytes,
+	FieldTargetParams,
+	FieldSsim,
+	FieldPsnr,
+	FieldVmaf,
+	FieldEncoderParams,
+	FieldFfmpegVersion,
+	FieldCommands,
+	FieldLog,
+	FieldPriority,
+	FieldSubmitter,
+	FieldPool,
+	FieldWorkerID,
+	FieldLeaseExpiresAt,
+	FieldCancelRequested,
+	FieldAttempts,
+	FieldMaxAttempts,
+	FieldNextAttemptAt,
+	FieldAttemptErrors,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "compression_jobs"
@@ -67,10 +150,26 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultKind holds the default value on creation for the "kind" field.
+	DefaultKind string
 	// DefaultStatus holds the default value on creation for the "status" field.
 	DefaultStatus string
 	// DefaultProgress holds the default value on creation for the "progress" field.
 	DefaultProgress int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultNormalize holds the default value on creation for the "normalize" field.
+	DefaultNormalize bool
+	// DefaultPriority holds the default value on creation for the "priority" field.
+	DefaultPriority int
+	// DefaultSubmitter holds the default value on creation for the "submitter" field.
+	DefaultSubmitter string
+	// DefaultCancelRequested holds the default value on creation for the "cancel_requested" field.
+	DefaultCancelRequested bool
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
+	// DefaultMaxAttempts holds the default value on creation for the "max_attempts" field.
+	DefaultMaxAttempts int
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() string
 )
@@ -83,6 +182,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
 // ByStatus orders the results by the status field.
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
@@ -98,6 +202,11 @@ func ByError(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldError, opts...).ToFunc()
 }
 
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
 // ByStartedAt orders the results by the started_at field.
 func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
@@ -108,6 +217,106 @@ func ByCompletedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCompletedAt, opts...).ToFunc()
 }
 
+// ByProfile orders the results by the profile field.
+func ByProfile(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProfile, opts...).ToFunc()
+}
+
+// ByTargetFormat orders the results by the target_format field.
+func ByTargetFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetFormat, opts...).ToFunc()
+}
+
+// ByAudioBitrate orders the results by the audio_bitrate field.
+func ByAudioBitrate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAudioBitrate, opts...).ToFunc()
+}
+
+// ByNormalize orders the results by the normalize field.
+func ByNormalize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNormalize, opts...).ToFunc()
+}
+
+// ByOutcome orders the results by the outcome field.
+func ByOutcome(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutcome, opts...).ToFunc()
+}
+
+// ByTargetBytes orders the results by the target_bytes field.
+func ByTargetBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetBytes, opts...).ToFunc()
+}
+
+// BySsim orders the results by the ssim field.
+func BySsim(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSsim, opts...).ToFunc()
+}
+
+// ByPsnr orders the results by the psnr field.
+func ByPsnr(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPsnr, opts...).ToFunc()
+}
+
+// ByVmaf orders the results by the vmaf field.
+func ByVmaf(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVmaf, opts...).ToFunc()
+}
+
+// ByFfmpegVersion orders the results by the ffmpeg_version field.
+func ByFfmpegVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFfmpegVersion, opts...).ToFunc()
+}
+
+// ByLog orders the results by the log field.
+func ByLog(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLog, opts...).ToFunc()
+}
+
+// ByPriority orders the results by the priority field.
+func ByPriority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriority, opts...).ToFunc()
+}
+
+// BySubmitter orders the results by the submitter field.
+func BySubmitter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubmitter, opts...).ToFunc()
+}
+
+// ByPool orders the results by the pool field.
+func ByPool(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPool, opts...).ToFunc()
+}
+
+// ByWorkerID orders the results by the worker_id field.
+func ByWorkerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkerID, opts...).ToFunc()
+}
+
+// ByLeaseExpiresAt orders the results by the lease_expires_at field.
+func ByLeaseExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLeaseExpiresAt, opts...).ToFunc()
+}
+
+// ByCancelRequested orders the results by the cancel_requested field.
+func ByCancelRequested(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelRequested, opts...).ToFunc()
+}
+
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
+// ByMaxAttempts orders the results by the max_attempts field.
+func ByMaxAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxAttempts, opts...).ToFunc()
+}
+
+// ByNextAttemptAt orders the results by the next_attempt_at field.
+func ByNextAttemptAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextAttemptAt, opts...).ToFunc()
+}
+
 // ByAssetField orders the results by asset field.
 func ByAssetField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
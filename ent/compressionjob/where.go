@@ -65,6 +65,11 @@ func IDContainsFold(id string) predicate.CompressionJob {
 	return predicate.CompressionJob(sql.FieldContainsFold(FieldID, id))
 }
 
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldKind, v))
+}
+
 // Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
 func Status(v string) predicate.CompressionJob {
 	return predicate.CompressionJob(sql.FieldEQ(FieldStatus, v))
@@ -80,6 +85,11 @@ func Error(v string) predicate.CompressionJob {
 	return predicate.CompressionJob(sql.FieldEQ(FieldError, v))
 }
 
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
 // StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
 func StartedAt(v time.Time) predicate.CompressionJob {
 	return predicate.CompressionJob(sql.FieldEQ(FieldStartedAt, v))
@@ -90,6 +100,171 @@ func CompletedAt(v time.Time) predicate.CompressionJob {
 	return predicate.CompressionJob(sql.FieldEQ(FieldCompletedAt, v))
 }
 
+// Profile applies equality check predicate on the "profile" field. It's identical to ProfileEQ.
+func Profile(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldProfile, v))
+}
+
+// TargetFormat applies equality check predicate on the "target_format" field. It's identical to TargetFormatEQ.
+func TargetFormat(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldTargetFormat, v))
+}
+
+// AudioBitrate applies equality check predicate on the "audio_bitrate" field. It's identical to AudioBitrateEQ.
+func AudioBitrate(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldAudioBitrate, v))
+}
+
+// Normalize applies equality check predicate on the "normalize" field. It's identical to NormalizeEQ.
+func Normalize(v bool) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldNormalize, v))
+}
+
+// Outcome applies equality check predicate on the "outcome" field. It's identical to OutcomeEQ.
+func Outcome(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldOutcome, v))
+}
+
+// TargetBytes applies equality check predicate on the "target_bytes" field. It's identical to TargetBytesEQ.
+func TargetBytes(v int64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldTargetBytes, v))
+}
+
+// Ssim applies equality check predicate on the "ssim" field. It's identical to SsimEQ.
+func Ssim(v float64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldSsim, v))
+}
+
+// Psnr applies equality check predicate on the "psnr" field. It's identical to PsnrEQ.
+func Psnr(v float64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldPsnr, v))
+}
+
+// Vmaf applies equality check predicate on the "vmaf" field. It's identical to VmafEQ.
+func Vmaf(v float64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldVmaf, v))
+}
+
+// FfmpegVersion applies equality check predicate on the "ffmpeg_version" field. It's identical to FfmpegVersionEQ.
+func FfmpegVersion(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldFfmpegVersion, v))
+}
+
+// Log applies equality check predicate on the "log" field. It's identical to LogEQ.
+func Log(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldLog, v))
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldPriority, v))
+}
+
+// Submitter applies equality check predicate on the "submitter" field. It's identical to SubmitterEQ.
+func Submitter(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldSubmitter, v))
+}
+
+// Pool applies equality check predicate on the "pool" field. It's identical to PoolEQ.
+func Pool(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldPool, v))
+}
+
+// WorkerID applies equality check predicate on the "worker_id" field. It's identical to WorkerIDEQ.
+func WorkerID(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldWorkerID, v))
+}
+
+// LeaseExpiresAt applies equality check predicate on the "lease_expires_at" field. It's identical to LeaseExpiresAtEQ.
+func LeaseExpiresAt(v time.Time) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldLeaseExpiresAt, v))
+}
+
+// CancelRequested applies equality check predicate on the "cancel_requested" field. It's identical to CancelRequestedEQ.
+func CancelRequested(v bool) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldCancelRequested, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldAttempts, v))
+}
+
+// MaxAttempts applies equality check predicate on the "max_attempts" field. It's identical to MaxAttemptsEQ.
+func MaxAttempts(v int) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldMaxAttempts, v))
+}
+
+// NextAttemptAt applies equality check predicate on the "next_attempt_at" field. It's identical to NextAttemptAtEQ.
+func NextAttemptAt(v time.Time) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldContainsFold(FieldKind, v))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v string) predicate.CompressionJob {
 	return predicate.CompressionJob(sql.FieldEQ(FieldStatus, v))
@@ -270,6 +445,56 @@ func ErrorContainsFold(v string) predicate.CompressionJob {
 	return predicate.CompressionJob(sql.FieldContainsFold(FieldError, v))
 }
 
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotNull(FieldCreatedAt))
+}
+
 // StartedAtEQ applies the EQ predicate on the "started_at" field.
 func StartedAtEQ(v time.Time) predicate.CompressionJob {
 	return predicate.CompressionJob(sql.FieldEQ(FieldStartedAt, v))
@@ -370,6 +595,1161 @@ func CompletedAtNotNil() predicate.CompressionJob {
 	return predicate.CompressionJob(sql.FieldNotNull(FieldCompletedAt))
 }
 
+// ProfileEQ applies the EQ predicate on the "profile" field.
+func ProfileEQ(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldProfile, v))
+}
+
+// ProfileNEQ applies the NEQ predicate on the "profile" field.
+func ProfileNEQ(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNEQ(FieldProfile, v))
+}
+
+// ProfileIn applies the In predicate on the "profile" field.
+func ProfileIn(vs ...string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIn(FieldProfile, vs...))
+}
+
+// ProfileNotIn applies the NotIn predicate on the "profile" field.
+func ProfileNotIn(vs ...string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotIn(FieldProfile, vs...))
+}
+
+// ProfileGT applies the GT predicate on the "profile" field.
+func ProfileGT(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGT(FieldProfile, v))
+}
+
+// ProfileGTE applies the GTE predicate on the "profile" field.
+func ProfileGTE(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGTE(FieldProfile, v))
+}
+
+// ProfileLT applies the LT predicate on the "profile" field.
+func ProfileLT(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLT(FieldProfile, v))
+}
+
+// ProfileLTE applies the LTE predicate on the "profile" field.
+func ProfileLTE(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLTE(FieldProfile, v))
+}
+
+// ProfileContains applies the Contains predicate on the "profile" field.
+func ProfileContains(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldContains(FieldProfile, v))
+}
+
+// ProfileHasPrefix applies the HasPrefix predicate on the "profile" field.
+func ProfileHasPrefix(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldHasPrefix(FieldProfile, v))
+}
+
+// ProfileHasSuffix applies the HasSuffix predicate on the "profile" field.
+func ProfileHasSuffix(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldHasSuffix(FieldProfile, v))
+}
+
+// ProfileIsNil applies the IsNil predicate on the "profile" field.
+func ProfileIsNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIsNull(FieldProfile))
+}
+
+// ProfileNotNil applies the NotNil predicate on the "profile" field.
+func ProfileNotNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotNull(FieldProfile))
+}
+
+// ProfileEqualFold applies the EqualFold predicate on the "profile" field.
+func ProfileEqualFold(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEqualFold(FieldProfile, v))
+}
+
+// ProfileContainsFold applies the ContainsFold predicate on the "profile" field.
+func ProfileContainsFold(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldContainsFold(FieldProfile, v))
+}
+
+// TargetFormatEQ applies the EQ predicate on the "target_format" field.
+func TargetFormatEQ(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldTargetFormat, v))
+}
+
+// TargetFormatNEQ applies the NEQ predicate on the "target_format" field.
+func TargetFormatNEQ(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNEQ(FieldTargetFormat, v))
+}
+
+// TargetFormatIn applies the In predicate on the "target_format" field.
+func TargetFormatIn(vs ...string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIn(FieldTargetFormat, vs...))
+}
+
+// TargetFormatNotIn applies the NotIn predicate on the "target_format" field.
+func TargetFormatNotIn(vs ...string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotIn(FieldTargetFormat, vs...))
+}
+
+// TargetFormatGT applies the GT predicate on the "target_format" field.
+func TargetFormatGT(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGT(FieldTargetFormat, v))
+}
+
+// TargetFormatGTE applies the GTE predicate on the "target_format" field.
+func TargetFormatGTE(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGTE(FieldTargetFormat, v))
+}
+
+// TargetFormatLT applies the LT predicate on the "target_format" field.
+func TargetFormatLT(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLT(FieldTargetFormat, v))
+}
+
+// TargetFormatLTE applies the LTE predicate on the "target_format" field.
+func TargetFormatLTE(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLTE(FieldTargetFormat, v))
+}
+
+// TargetFormatContains applies the Contains predicate on the "target_format" field.
+func TargetFormatContains(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldContains(FieldTargetFormat, v))
+}
+
+// TargetFormatHasPrefix applies the HasPrefix predicate on the "target_format" field.
+func TargetFormatHasPrefix(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldHasPrefix(FieldTargetFormat, v))
+}
+
+// TargetFormatHasSuffix applies the HasSuffix predicate on the "target_format" field.
+func TargetFormatHasSuffix(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldHasSuffix(FieldTargetFormat, v))
+}
+
+// TargetFormatIsNil applies the IsNil predicate on the "target_format" field.
+func TargetFormatIsNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIsNull(FieldTargetFormat))
+}
+
+// TargetFormatNotNil applies the NotNil predicate on the "target_format" field.
+func TargetFormatNotNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotNull(FieldTargetFormat))
+}
+
+// TargetFormatEqualFold applies the EqualFold predicate on the "target_format" field.
+func TargetFormatEqualFold(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEqualFold(FieldTargetFormat, v))
+}
+
+// TargetFormatContainsFold applies the ContainsFold predicate on the "target_format" field.
+func TargetFormatContainsFold(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldContainsFold(FieldTargetFormat, v))
+}
+
+// AudioBitrateEQ applies the EQ predicate on the "audio_bitrate" field.
+func AudioBitrateEQ(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldAudioBitrate, v))
+}
+
+// AudioBitrateNEQ applies the NEQ predicate on the "audio_bitrate" field.
+func AudioBitrateNEQ(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNEQ(FieldAudioBitrate, v))
+}
+
+// AudioBitrateIn applies the In predicate on the "audio_bitrate" field.
+func AudioBitrateIn(vs ...string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIn(FieldAudioBitrate, vs...))
+}
+
+// AudioBitrateNotIn applies the NotIn predicate on the "audio_bitrate" field.
+func AudioBitrateNotIn(vs ...string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotIn(FieldAudioBitrate, vs...))
+}
+
+// AudioBitrateGT applies the GT predicate on the "audio_bitrate" field.
+func AudioBitrateGT(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGT(FieldAudioBitrate, v))
+}
+
+// AudioBitrateGTE applies the GTE predicate on the "audio_bitrate" field.
+func AudioBitrateGTE(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGTE(FieldAudioBitrate, v))
+}
+
+// AudioBitrateLT applies the LT predicate on the "audio_bitrate" field.
+func AudioBitrateLT(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLT(FieldAudioBitrate, v))
+}
+
+// AudioBitrateLTE applies the LTE predicate on the "audio_bitrate" field.
+func AudioBitrateLTE(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLTE(FieldAudioBitrate, v))
+}
+
+// AudioBitrateContains applies the Contains predicate on the "audio_bitrate" field.
+func AudioBitrateContains(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldContains(FieldAudioBitrate, v))
+}
+
+// AudioBitrateHasPrefix applies the HasPrefix predicate on the "audio_bitrate" field.
+func AudioBitrateHasPrefix(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldHasPrefix(FieldAudioBitrate, v))
+}
+
+// AudioBitrateHasSuffix applies the HasSuffix predicate on the "audio_bitrate" field.
+func AudioBitrateHasSuffix(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldHasSuffix(FieldAudioBitrate, v))
+}
+
+// AudioBitrateIsNil applies the IsNil predicate on the "audio_bitrate" field.
+func AudioBitrateIsNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIsNull(FieldAudioBitrate))
+}
+
+// AudioBitrateNotNil applies the NotNil predicate on the "audio_bitrate" field.
+func AudioBitrateNotNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotNull(FieldAudioBitrate))
+}
+
+// AudioBitrateEqualFold applies the EqualFold predicate on the "audio_bitrate" field.
+func AudioBitrateEqualFold(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEqualFold(FieldAudioBitrate, v))
+}
+
+// AudioBitrateContainsFold applies the ContainsFold predicate on the "audio_bitrate" field.
+func AudioBitrateContainsFold(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldContainsFold(FieldAudioBitrate, v))
+}
+
+// NormalizeEQ applies the EQ predicate on the "normalize" field.
+func NormalizeEQ(v bool) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldNormalize, v))
+}
+
+// NormalizeNEQ applies the NEQ predicate on the "normalize" field.
+func NormalizeNEQ(v bool) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNEQ(FieldNormalize, v))
+}
+
+// OutcomeEQ applies the EQ predicate on the "outcome" field.
+func OutcomeEQ(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldOutcome, v))
+}
+
+// OutcomeNEQ applies the NEQ predicate on the "outcome" field.
+func OutcomeNEQ(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNEQ(FieldOutcome, v))
+}
+
+// OutcomeIn applies the In predicate on the "outcome" field.
+func OutcomeIn(vs ...string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIn(FieldOutcome, vs...))
+}
+
+// OutcomeNotIn applies the NotIn predicate on the "outcome" field.
+func OutcomeNotIn(vs ...string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotIn(FieldOutcome, vs...))
+}
+
+// OutcomeGT applies the GT predicate on the "outcome" field.
+func OutcomeGT(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGT(FieldOutcome, v))
+}
+
+// OutcomeGTE applies the GTE predicate on the "outcome" field.
+func OutcomeGTE(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGTE(FieldOutcome, v))
+}
+
+// OutcomeLT applies the LT predicate on the "outcome" field.
+func OutcomeLT(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLT(FieldOutcome, v))
+}
+
+// OutcomeLTE applies the LTE predicate on the "outcome" field.
+func OutcomeLTE(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLTE(FieldOutcome, v))
+}
+
+// OutcomeContains applies the Contains predicate on the "outcome" field.
+func OutcomeContains(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldContains(FieldOutcome, v))
+}
+
+// OutcomeHasPrefix applies the HasPrefix predicate on the "outcome" field.
+func OutcomeHasPrefix(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldHasPrefix(FieldOutcome, v))
+}
+
+// OutcomeHasSuffix applies the HasSuffix predicate on the "outcome" field.
+func OutcomeHasSuffix(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldHasSuffix(FieldOutcome, v))
+}
+
+// OutcomeIsNil applies the IsNil predicate on the "outcome" field.
+func OutcomeIsNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIsNull(FieldOutcome))
+}
+
+// OutcomeNotNil applies the NotNil predicate on the "outcome" field.
+func OutcomeNotNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotNull(FieldOutcome))
+}
+
+// OutcomeEqualFold applies the EqualFold predicate on the "outcome" field.
+func OutcomeEqualFold(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEqualFold(FieldOutcome, v))
+}
+
+// OutcomeContainsFold applies the ContainsFold predicate on the "outcome" field.
+func OutcomeContainsFold(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldContainsFold(FieldOutcome, v))
+}
+
+// StepsIsNil applies the IsNil predicate on the "steps" field.
+func StepsIsNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIsNull(FieldSteps))
+}
+
+// StepsNotNil applies the NotNil predicate on the "steps" field.
+func StepsNotNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotNull(FieldSteps))
+}
+
+// TargetBytesEQ applies the EQ predicate on the "target_bytes" field.
+func TargetBytesEQ(v int64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldTargetBytes, v))
+}
+
+// TargetBytesNEQ applies the NEQ predicate on the "target_bytes" field.
+func TargetBytesNEQ(v int64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNEQ(FieldTargetBytes, v))
+}
+
+// TargetBytesIn applies the In predicate on the "target_bytes" field.
+func TargetBytesIn(vs ...int64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIn(FieldTargetBytes, vs...))
+}
+
+// TargetBytesNotIn applies the NotIn predicate on the "target_bytes" field.
+func TargetBytesNotIn(vs ...int64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotIn(FieldTargetBytes, vs...))
+}
+
+// TargetBytesGT applies the GT predicate on the "target_bytes" field.
+func TargetBytesGT(v int64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGT(FieldTargetBytes, v))
+}
+
+// TargetBytesGTE applies the GTE predicate on the "target_bytes" field.
+func TargetBytesGTE(v int64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGTE(FieldTargetBytes, v))
+}
+
+// TargetBytesLT applies the LT predicate on the "target_bytes" field.
+func TargetBytesLT(v int64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLT(FieldTargetBytes, v))
+}
+
+// TargetBytesLTE applies the LTE predicate on the "target_bytes" field.
+func TargetBytesLTE(v int64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLTE(FieldTargetBytes, v))
+}
+
+// TargetBytesIsNil applies the IsNil predicate on the "target_bytes" field.
+func TargetBytesIsNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIsNull(FieldTargetBytes))
+}
+
+// TargetBytesNotNil applies the NotNil predicate on the "target_bytes" field.
+func TargetBytesNotNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotNull(FieldTargetBytes))
+}
+
+// TargetParamsIsNil applies the IsNil predicate on the "target_params" field.
+func TargetParamsIsNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIsNull(FieldTargetParams))
+}
+
+// TargetParamsNotNil applies the NotNil predicate on the "target_params" field.
+func TargetParamsNotNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotNull(FieldTargetParams))
+}
+
+// SsimEQ applies the EQ predicate on the "ssim" field.
+func SsimEQ(v float64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldSsim, v))
+}
+
+// SsimNEQ applies the NEQ predicate on the "ssim" field.
+func SsimNEQ(v float64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNEQ(FieldSsim, v))
+}
+
+// SsimIn applies the In predicate on the "ssim" field.
+func SsimIn(vs ...float64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIn(FieldSsim, vs...))
+}
+
+// SsimNotIn applies the NotIn predicate on the "ssim" field.
+func SsimNotIn(vs ...float64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotIn(FieldSsim, vs...))
+}
+
+// SsimGT applies the GT predicate on the "ssim" field.
+func SsimGT(v float64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGT(FieldSsim, v))
+}
+
+// SsimGTE applies the GTE predicate on the "ssim" field.
+func SsimGTE(v float64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGTE(FieldSsim, v))
+}
+
+// SsimLT applies the LT predicate on the "ssim" field.
+func SsimLT(v float64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLT(FieldSsim, v))
+}
+
+// SsimLTE applies the LTE predicate on the "ssim" field.
+func SsimLTE(v float64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLTE(FieldSsim, v))
+}
+
+// SsimIsNil applies the IsNil predicate on the "ssim" field.
+func SsimIsNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIsNull(FieldSsim))
+}
+
+// SsimNotNil applies the NotNil predicate on the "ssim" field.
+func SsimNotNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotNull(FieldSsim))
+}
+
+// PsnrEQ applies the EQ predicate on the "psnr" field.
+func PsnrEQ(v float64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldPsnr, v))
+}
+
+// PsnrNEQ applies the NEQ predicate on the "psnr" field.
+func PsnrNEQ(v float64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNEQ(FieldPsnr, v))
+}
+
+// PsnrIn applies the In predicate on the "psnr" field.
+func PsnrIn(vs ...float64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIn(FieldPsnr, vs...))
+}
+
+// PsnrNotIn applies the NotIn predicate on the "psnr" field.
+func PsnrNotIn(vs ...float64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotIn(FieldPsnr, vs...))
+}
+
+// PsnrGT applies the GT predicate on the "psnr" field.
+func PsnrGT(v float64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGT(FieldPsnr, v))
+}
+
+// PsnrGTE applies the GTE predicate on the "psnr" field.
+func PsnrGTE(v float64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGTE(FieldPsnr, v))
+}
+
+// PsnrLT applies the LT predicate on the "psnr" field.
+func PsnrLT(v float64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLT(FieldPsnr, v))
+}
+
+// PsnrLTE applies the LTE predicate on the "psnr" field.
+func PsnrLTE(v float64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLTE(FieldPsnr, v))
+}
+
+// PsnrIsNil applies the IsNil predicate on the "psnr" field.
+func PsnrIsNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIsNull(FieldPsnr))
+}
+
+// PsnrNotNil applies the NotNil predicate on the "psnr" field.
+func PsnrNotNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotNull(FieldPsnr))
+}
+
+// VmafEQ applies the EQ predicate on the "vmaf" field.
+func VmafEQ(v float64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldVmaf, v))
+}
+
+// VmafNEQ applies the NEQ predicate on the "vmaf" field.
+func VmafNEQ(v float64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNEQ(FieldVmaf, v))
+}
+
+// VmafIn applies the In predicate on the "vmaf" field.
+func VmafIn(vs ...float64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIn(FieldVmaf, vs...))
+}
+
+// VmafNotIn applies the NotIn predicate on the "vmaf" field.
+func VmafNotIn(vs ...float64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotIn(FieldVmaf, vs...))
+}
+
+// VmafGT applies the GT predicate on the "vmaf" field.
+func VmafGT(v float64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGT(FieldVmaf, v))
+}
+
+// VmafGTE applies the GTE predicate on the "vmaf" field.
+func VmafGTE(v float64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGTE(FieldVmaf, v))
+}
+
+// VmafLT applies the LT predicate on the "vmaf" field.
+func VmafLT(v float64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLT(FieldVmaf, v))
+}
+
+// VmafLTE applies the LTE predicate on the "vmaf" field.
+func VmafLTE(v float64) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLTE(FieldVmaf, v))
+}
+
+// VmafIsNil applies the IsNil predicate on the "vmaf" field.
+func VmafIsNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIsNull(FieldVmaf))
+}
+
+// VmafNotNil applies the NotNil predicate on the "vmaf" field.
+func VmafNotNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotNull(FieldVmaf))
+}
+
+// EncoderParamsIsNil applies the IsNil predicate on the "encoder_params" field.
+func EncoderParamsIsNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIsNull(FieldEncoderParams))
+}
+
+// EncoderParamsNotNil applies the NotNil predicate on the "encoder_params" field.
+func EncoderParamsNotNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotNull(FieldEncoderParams))
+}
+
+// FfmpegVersionEQ applies the EQ predicate on the "ffmpeg_version" field.
+func FfmpegVersionEQ(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldFfmpegVersion, v))
+}
+
+// FfmpegVersionNEQ applies the NEQ predicate on the "ffmpeg_version" field.
+func FfmpegVersionNEQ(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNEQ(FieldFfmpegVersion, v))
+}
+
+// FfmpegVersionIn applies the In predicate on the "ffmpeg_version" field.
+func FfmpegVersionIn(vs ...string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIn(FieldFfmpegVersion, vs...))
+}
+
+// FfmpegVersionNotIn applies the NotIn predicate on the "ffmpeg_version" field.
+func FfmpegVersionNotIn(vs ...string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotIn(FieldFfmpegVersion, vs...))
+}
+
+// FfmpegVersionGT applies the GT predicate on the "ffmpeg_version" field.
+func FfmpegVersionGT(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGT(FieldFfmpegVersion, v))
+}
+
+// FfmpegVersionGTE applies the GTE predicate on the "ffmpeg_version" field.
+func FfmpegVersionGTE(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGTE(FieldFfmpegVersion, v))
+}
+
+// FfmpegVersionLT applies the LT predicate on the "ffmpeg_version" field.
+func FfmpegVersionLT(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLT(FieldFfmpegVersion, v))
+}
+
+// FfmpegVersionLTE applies the LTE predicate on the "ffmpeg_version" field.
+func FfmpegVersionLTE(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLTE(FieldFfmpegVersion, v))
+}
+
+// FfmpegVersionContains applies the Contains predicate on the "ffmpeg_version" field.
+func FfmpegVersionContains(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldContains(FieldFfmpegVersion, v))
+}
+
+// FfmpegVersionHasPrefix applies the HasPrefix predicate on the "ffmpeg_version" field.
+func FfmpegVersionHasPrefix(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldHasPrefix(FieldFfmpegVersion, v))
+}
+
+// FfmpegVersionHasSuffix applies the HasSuffix predicate on the "ffmpeg_version" field.
+func FfmpegVersionHasSuffix(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldHasSuffix(FieldFfmpegVersion, v))
+}
+
+// FfmpegVersionIsNil applies the IsNil predicate on the "ffmpeg_version" field.
+func FfmpegVersionIsNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIsNull(FieldFfmpegVersion))
+}
+
+// FfmpegVersionNotNil applies the NotNil predicate on the "ffmpeg_version" field.
+func FfmpegVersionNotNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotNull(FieldFfmpegVersion))
+}
+
+// FfmpegVersionEqualFold applies the EqualFold predicate on the "ffmpeg_version" field.
+func FfmpegVersionEqualFold(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEqualFold(FieldFfmpegVersion, v))
+}
+
+// FfmpegVersionContainsFold applies the ContainsFold predicate on the "ffmpeg_version" field.
+func FfmpegVersionContainsFold(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldContainsFold(FieldFfmpegVersion, v))
+}
+
+// CommandsIsNil applies the IsNil predicate on the "commands" field.
+func CommandsIsNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIsNull(FieldCommands))
+}
+
+// CommandsNotNil applies the NotNil predicate on the "commands" field.
+func CommandsNotNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotNull(FieldCommands))
+}
+
+// LogEQ applies the EQ predicate on the "log" field.
+func LogEQ(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldLog, v))
+}
+
+// LogNEQ applies the NEQ predicate on the "log" field.
+func LogNEQ(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNEQ(FieldLog, v))
+}
+
+// LogIn applies the In predicate on the "log" field.
+func LogIn(vs ...string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIn(FieldLog, vs...))
+}
+
+// LogNotIn applies the NotIn predicate on the "log" field.
+func LogNotIn(vs ...string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotIn(FieldLog, vs...))
+}
+
+// LogGT applies the GT predicate on the "log" field.
+func LogGT(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGT(FieldLog, v))
+}
+
+// LogGTE applies the GTE predicate on the "log" field.
+func LogGTE(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGTE(FieldLog, v))
+}
+
+// LogLT applies the LT predicate on the "log" field.
+func LogLT(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLT(FieldLog, v))
+}
+
+// LogLTE applies the LTE predicate on the "log" field.
+func LogLTE(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLTE(FieldLog, v))
+}
+
+// LogContains applies the Contains predicate on the "log" field.
+func LogContains(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldContains(FieldLog, v))
+}
+
+// LogHasPrefix applies the HasPrefix predicate on the "log" field.
+func LogHasPrefix(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldHasPrefix(FieldLog, v))
+}
+
+// LogHasSuffix applies the HasSuffix predicate on the "log" field.
+func LogHasSuffix(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldHasSuffix(FieldLog, v))
+}
+
+// LogIsNil applies the IsNil predicate on the "log" field.
+func LogIsNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIsNull(FieldLog))
+}
+
+// LogNotNil applies the NotNil predicate on the "log" field.
+func LogNotNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotNull(FieldLog))
+}
+
+// LogEqualFold applies the EqualFold predicate on the "log" field.
+func LogEqualFold(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEqualFold(FieldLog, v))
+}
+
+// LogContainsFold applies the ContainsFold predicate on the "log" field.
+func LogContainsFold(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldContainsFold(FieldLog, v))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLTE(FieldPriority, v))
+}
+
+// SubmitterEQ applies the EQ predicate on the "submitter" field.
+func SubmitterEQ(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldSubmitter, v))
+}
+
+// SubmitterNEQ applies the NEQ predicate on the "submitter" field.
+func SubmitterNEQ(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNEQ(FieldSubmitter, v))
+}
+
+// SubmitterIn applies the In predicate on the "submitter" field.
+func SubmitterIn(vs ...string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIn(FieldSubmitter, vs...))
+}
+
+// SubmitterNotIn applies the NotIn predicate on the "submitter" field.
+func SubmitterNotIn(vs ...string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotIn(FieldSubmitter, vs...))
+}
+
+// SubmitterGT applies the GT predicate on the "submitter" field.
+func SubmitterGT(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGT(FieldSubmitter, v))
+}
+
+// SubmitterGTE applies the GTE predicate on the "submitter" field.
+func SubmitterGTE(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGTE(FieldSubmitter, v))
+}
+
+// SubmitterLT applies the LT predicate on the "submitter" field.
+func SubmitterLT(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLT(FieldSubmitter, v))
+}
+
+// SubmitterLTE applies the LTE predicate on the "submitter" field.
+func SubmitterLTE(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLTE(FieldSubmitter, v))
+}
+
+// SubmitterContains applies the Contains predicate on the "submitter" field.
+func SubmitterContains(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldContains(FieldSubmitter, v))
+}
+
+// SubmitterHasPrefix applies the HasPrefix predicate on the "submitter" field.
+func SubmitterHasPrefix(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldHasPrefix(FieldSubmitter, v))
+}
+
+// SubmitterHasSuffix applies the HasSuffix predicate on the "submitter" field.
+func SubmitterHasSuffix(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldHasSuffix(FieldSubmitter, v))
+}
+
+// SubmitterEqualFold applies the EqualFold predicate on the "submitter" field.
+func SubmitterEqualFold(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEqualFold(FieldSubmitter, v))
+}
+
+// SubmitterContainsFold applies the ContainsFold predicate on the "submitter" field.
+func SubmitterContainsFold(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldContainsFold(FieldSubmitter, v))
+}
+
+// PoolEQ applies the EQ predicate on the "pool" field.
+func PoolEQ(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldPool, v))
+}
+
+// PoolNEQ applies the NEQ predicate on the "pool" field.
+func PoolNEQ(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNEQ(FieldPool, v))
+}
+
+// PoolIn applies the In predicate on the "pool" field.
+func PoolIn(vs ...string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIn(FieldPool, vs...))
+}
+
+// PoolNotIn applies the NotIn predicate on the "pool" field.
+func PoolNotIn(vs ...string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotIn(FieldPool, vs...))
+}
+
+// PoolGT applies the GT predicate on the "pool" field.
+func PoolGT(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGT(FieldPool, v))
+}
+
+// PoolGTE applies the GTE predicate on the "pool" field.
+func PoolGTE(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGTE(FieldPool, v))
+}
+
+// PoolLT applies the LT predicate on the "pool" field.
+func PoolLT(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLT(FieldPool, v))
+}
+
+// PoolLTE applies the LTE predicate on the "pool" field.
+func PoolLTE(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLTE(FieldPool, v))
+}
+
+// PoolContains applies the Contains predicate on the "pool" field.
+func PoolContains(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldContains(FieldPool, v))
+}
+
+// PoolHasPrefix applies the HasPrefix predicate on the "pool" field.
+func PoolHasPrefix(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldHasPrefix(FieldPool, v))
+}
+
+// PoolHasSuffix applies the HasSuffix predicate on the "pool" field.
+func PoolHasSuffix(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldHasSuffix(FieldPool, v))
+}
+
+// PoolIsNil applies the IsNil predicate on the "pool" field.
+func PoolIsNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIsNull(FieldPool))
+}
+
+// PoolNotNil applies the NotNil predicate on the "pool" field.
+func PoolNotNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotNull(FieldPool))
+}
+
+// PoolEqualFold applies the EqualFold predicate on the "pool" field.
+func PoolEqualFold(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEqualFold(FieldPool, v))
+}
+
+// PoolContainsFold applies the ContainsFold predicate on the "pool" field.
+func PoolContainsFold(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldContainsFold(FieldPool, v))
+}
+
+// WorkerIDEQ applies the EQ predicate on the "worker_id" field.
+func WorkerIDEQ(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldWorkerID, v))
+}
+
+// WorkerIDNEQ applies the NEQ predicate on the "worker_id" field.
+func WorkerIDNEQ(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNEQ(FieldWorkerID, v))
+}
+
+// WorkerIDIn applies the In predicate on the "worker_id" field.
+func WorkerIDIn(vs ...string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIn(FieldWorkerID, vs...))
+}
+
+// WorkerIDNotIn applies the NotIn predicate on the "worker_id" field.
+func WorkerIDNotIn(vs ...string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotIn(FieldWorkerID, vs...))
+}
+
+// WorkerIDGT applies the GT predicate on the "worker_id" field.
+func WorkerIDGT(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGT(FieldWorkerID, v))
+}
+
+// WorkerIDGTE applies the GTE predicate on the "worker_id" field.
+func WorkerIDGTE(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGTE(FieldWorkerID, v))
+}
+
+// WorkerIDLT applies the LT predicate on the "worker_id" field.
+func WorkerIDLT(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLT(FieldWorkerID, v))
+}
+
+// WorkerIDLTE applies the LTE predicate on the "worker_id" field.
+func WorkerIDLTE(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLTE(FieldWorkerID, v))
+}
+
+// WorkerIDContains applies the Contains predicate on the "worker_id" field.
+func WorkerIDContains(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldContains(FieldWorkerID, v))
+}
+
+// WorkerIDHasPrefix applies the HasPrefix predicate on the "worker_id" field.
+func WorkerIDHasPrefix(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldHasPrefix(FieldWorkerID, v))
+}
+
+// WorkerIDHasSuffix applies the HasSuffix predicate on the "worker_id" field.
+func WorkerIDHasSuffix(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldHasSuffix(FieldWorkerID, v))
+}
+
+// WorkerIDIsNil applies the IsNil predicate on the "worker_id" field.
+func WorkerIDIsNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIsNull(FieldWorkerID))
+}
+
+// WorkerIDNotNil applies the NotNil predicate on the "worker_id" field.
+func WorkerIDNotNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotNull(FieldWorkerID))
+}
+
+// WorkerIDEqualFold applies the EqualFold predicate on the "worker_id" field.
+func WorkerIDEqualFold(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEqualFold(FieldWorkerID, v))
+}
+
+// WorkerIDContainsFold applies the ContainsFold predicate on the "worker_id" field.
+func WorkerIDContainsFold(v string) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldContainsFold(FieldWorkerID, v))
+}
+
+// LeaseExpiresAtEQ applies the EQ predicate on the "lease_expires_at" field.
+func LeaseExpiresAtEQ(v time.Time) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldLeaseExpiresAt, v))
+}
+
+// LeaseExpiresAtNEQ applies the NEQ predicate on the "lease_expires_at" field.
+func LeaseExpiresAtNEQ(v time.Time) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNEQ(FieldLeaseExpiresAt, v))
+}
+
+// LeaseExpiresAtIn applies the In predicate on the "lease_expires_at" field.
+func LeaseExpiresAtIn(vs ...time.Time) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIn(FieldLeaseExpiresAt, vs...))
+}
+
+// LeaseExpiresAtNotIn applies the NotIn predicate on the "lease_expires_at" field.
+func LeaseExpiresAtNotIn(vs ...time.Time) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotIn(FieldLeaseExpiresAt, vs...))
+}
+
+// LeaseExpiresAtGT applies the GT predicate on the "lease_expires_at" field.
+func LeaseExpiresAtGT(v time.Time) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGT(FieldLeaseExpiresAt, v))
+}
+
+// LeaseExpiresAtGTE applies the GTE predicate on the "lease_expires_at" field.
+func LeaseExpiresAtGTE(v time.Time) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGTE(FieldLeaseExpiresAt, v))
+}
+
+// LeaseExpiresAtLT applies the LT predicate on the "lease_expires_at" field.
+func LeaseExpiresAtLT(v time.Time) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLT(FieldLeaseExpiresAt, v))
+}
+
+// LeaseExpiresAtLTE applies the LTE predicate on the "lease_expires_at" field.
+func LeaseExpiresAtLTE(v time.Time) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLTE(FieldLeaseExpiresAt, v))
+}
+
+// LeaseExpiresAtIsNil applies the IsNil predicate on the "lease_expires_at" field.
+func LeaseExpiresAtIsNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIsNull(FieldLeaseExpiresAt))
+}
+
+// LeaseExpiresAtNotNil applies the NotNil predicate on the "lease_expires_at" field.
+func LeaseExpiresAtNotNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotNull(FieldLeaseExpiresAt))
+}
+
+// CancelRequestedEQ applies the EQ predicate on the "cancel_requested" field.
+func CancelRequestedEQ(v bool) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldCancelRequested, v))
+}
+
+// CancelRequestedNEQ applies the NEQ predicate on the "cancel_requested" field.
+func CancelRequestedNEQ(v bool) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNEQ(FieldCancelRequested, v))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLTE(FieldAttempts, v))
+}
+
+// MaxAttemptsEQ applies the EQ predicate on the "max_attempts" field.
+func MaxAttemptsEQ(v int) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsNEQ applies the NEQ predicate on the "max_attempts" field.
+func MaxAttemptsNEQ(v int) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNEQ(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsIn applies the In predicate on the "max_attempts" field.
+func MaxAttemptsIn(vs ...int) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIn(FieldMaxAttempts, vs...))
+}
+
+// MaxAttemptsNotIn applies the NotIn predicate on the "max_attempts" field.
+func MaxAttemptsNotIn(vs ...int) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotIn(FieldMaxAttempts, vs...))
+}
+
+// MaxAttemptsGT applies the GT predicate on the "max_attempts" field.
+func MaxAttemptsGT(v int) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGT(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsGTE applies the GTE predicate on the "max_attempts" field.
+func MaxAttemptsGTE(v int) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGTE(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsLT applies the LT predicate on the "max_attempts" field.
+func MaxAttemptsLT(v int) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLT(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsLTE applies the LTE predicate on the "max_attempts" field.
+func MaxAttemptsLTE(v int) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLTE(FieldMaxAttempts, v))
+}
+
+// NextAttemptAtEQ applies the EQ predicate on the "next_attempt_at" field.
+func NextAttemptAtEQ(v time.Time) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtNEQ applies the NEQ predicate on the "next_attempt_at" field.
+func NextAttemptAtNEQ(v time.Time) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtIn applies the In predicate on the "next_attempt_at" field.
+func NextAttemptAtIn(vs ...time.Time) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtNotIn applies the NotIn predicate on the "next_attempt_at" field.
+func NextAttemptAtNotIn(vs ...time.Time) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtGT applies the GT predicate on the "next_attempt_at" field.
+func NextAttemptAtGT(v time.Time) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtGTE applies the GTE predicate on the "next_attempt_at" field.
+func NextAttemptAtGTE(v time.Time) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldGTE(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLT applies the LT predicate on the "next_attempt_at" field.
+func NextAttemptAtLT(v time.Time) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLTE applies the LTE predicate on the "next_attempt_at" field.
+func NextAttemptAtLTE(v time.Time) predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldLTE(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtIsNil applies the IsNil predicate on the "next_attempt_at" field.
+func NextAttemptAtIsNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIsNull(FieldNextAttemptAt))
+}
+
+// NextAttemptAtNotNil applies the NotNil predicate on the "next_attempt_at" field.
+func NextAttemptAtNotNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotNull(FieldNextAttemptAt))
+}
+
+// AttemptErrorsIsNil applies the IsNil predicate on the "attempt_errors" field.
+func AttemptErrorsIsNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldIsNull(FieldAttemptErrors))
+}
+
+// AttemptErrorsNotNil applies the NotNil predicate on the "attempt_errors" field.
+func AttemptErrorsNotNil() predicate.CompressionJob {
+	return predicate.CompressionJob(sql.FieldNotNull(FieldAttemptErrors))
+}
+
 // HasAsset applies the HasEdge predicate on the "asset" edge.
 func HasAsset() predicate.CompressionJob {
 	return predicate.CompressionJob(func(s *sql.Selector) {
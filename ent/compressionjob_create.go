@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/adimail/asset-manager/ent/asset"
 	"github.com/adimail/asset-manager/ent/compressionjob"
+	"github.com/adimail/asset-manager/ent/schema"
 )
 
 // CompressionJobCreate is the builder for creating a CompressionJob entity.
@@ -21,6 +22,20 @@ type CompressionJobCreate struct {
 	hooks    []Hook
 }
 
+// SetKind sets the "kind" field.
+func (_c *CompressionJobCreate) SetKind(v string) *CompressionJobCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_c *CompressionJobCreate) SetNillableKind(v *string) *CompressionJobCreate {
+	if v != nil {
+		_c.SetKind(*v)
+	}
+	return _c
+}
+
 // SetStatus sets the "status" field.
 func (_c *CompressionJobCreate) SetStatus(v string) *CompressionJobCreate {
 	_c.mutation.SetStatus(v)
@@ -63,6 +78,20 @@ func (_c *CompressionJobCreate) SetNillableError(v *string) *CompressionJobCreat
 	return _c
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (_c *CompressionJobCreate) SetCreatedAt(v time.Time) *CompressionJobCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CompressionJobCreate) SetNillableCreatedAt(v *time.Time) *CompressionJobCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
 // SetStartedAt sets the "started_at" field.
 func (_c *CompressionJobCreate) SetStartedAt(v time.Time) *CompressionJobCreate {
 	_c.mutation.SetStartedAt(v)
@@ -91,6 +120,332 @@ func (_c *CompressionJobCreate) SetNillableCompletedAt(v *time.Time) *Compressio
 	return _c
 }
 
+// SetProfile sets the "profile" field.
+func (_c *CompressionJobCreate) SetProfile(v string) *CompressionJobCreate {
+	_c.mutation.SetProfile(v)
+	return _c
+}
+
+// SetNillableProfile sets the "profile" field if the given value is not nil.
+func (_c *CompressionJobCreate) SetNillableProfile(v *string) *CompressionJobCreate {
+	if v != nil {
+		_c.SetProfile(*v)
+	}
+	return _c
+}
+
+// SetTargetFormat sets the "target_format" field.
+func (_c *CompressionJobCreate) SetTargetFormat(v string) *CompressionJobCreate {
+	_c.mutation.SetTargetFormat(v)
+	return _c
+}
+
+// SetNillableTargetFormat sets the "target_format" field if the given value is not nil.
+func (_c *CompressionJobCreate) SetNillableTargetFormat(v *string) *CompressionJobCreate {
+	if v != nil {
+		_c.SetTargetFormat(*v)
+	}
+	return _c
+}
+
+// SetAudioBitrate sets the "audio_bitrate" field.
+func (_c *CompressionJobCreate) SetAudioBitrate(v string) *CompressionJobCreate {
+	_c.mutation.SetAudioBitrate(v)
+	return _c
+}
+
+// SetNillableAudioBitrate sets the "audio_bitrate" field if the given value is not nil.
+func (_c *CompressionJobCreate) SetNillableAudioBitrate(v *string) *CompressionJobCreate {
+	if v != nil {
+		_c.SetAudioBitrate(*v)
+	}
+	return _c
+}
+
+// SetNormalize sets the "normalize" field.
+func (_c *CompressionJobCreate) SetNormalize(v bool) *CompressionJobCreate {
+	_c.mutation.SetNormalize(v)
+	return _c
+}
+
+// SetNillableNormalize sets the "normalize" field if the given value is not nil.
+func (_c *CompressionJobCreate) SetNillableNormalize(v *bool) *CompressionJobCreate {
+	if v != nil {
+		_c.SetNormalize(*v)
+	}
+	return _c
+}
+
+// SetOutcome sets the "outcome" field.
+func (_c *CompressionJobCreate) SetOutcome(v string) *CompressionJobCreate {
+	_c.mutation.SetOutcome(v)
+	return _c
+}
+
+// SetNillableOutcome sets the "outcome" field if the given value is not nil.
+func (_c *CompressionJobCreate) SetNillableOutcome(v *string) *CompressionJobCreate {
+	if v != nil {
+		_c.SetOutcome(*v)
+	}
+	return _c
+}
+
+// SetSteps sets the "steps" field.
+func (_c *CompressionJobCreate) SetSteps(v []schema.PipelineStep) *CompressionJobCreate {
+	_c.mutation.SetSteps(v)
+	return _c
+}
+
+// SetTargetBytes sets the "target_bytes" field.
+func (_c *CompressionJobCreate) SetTargetBytes(v int64) *CompressionJobCreate {
+	_c.mutation.SetTargetBytes(v)
+	return _c
+}
+
+// SetNillableTargetBytes sets the "target_bytes" field if the given value is not nil.
+func (_c *CompressionJobCreate) SetNillableTargetBytes(v *int64) *CompressionJobCreate {
+	if v != nil {
+		_c.SetTargetBytes(*v)
+	}
+	return _c
+}
+
+// SetTargetParams sets the "target_params" field.
+func (_c *CompressionJobCreate) SetTargetParams(v schema.TargetParams) *CompressionJobCreate {
+	_c.mutation.SetTargetParams(v)
+	return _c
+}
+
+// SetNillableTargetParams sets the "target_params" field if the given value is not nil.
+func (_c *CompressionJobCreate) SetNillableTargetParams(v *schema.TargetParams) *CompressionJobCreate {
+	if v != nil {
+		_c.SetTargetParams(*v)
+	}
+	return _c
+}
+
+// SetSsim sets the "ssim" field.
+func (_c *CompressionJobCreate) SetSsim(v float64) *CompressionJobCreate {
+	_c.mutation.SetSsim(v)
+	return _c
+}
+
+// SetNillableSsim sets the "ssim" field if the given value is not nil.
+func (_c *CompressionJobCreate) SetNillableSsim(v *float64) *CompressionJobCreate {
+	if v != nil {
+		_c.SetSsim(*v)
+	}
+	return _c
+}
+
+// SetPsnr sets the "psnr" field.
+func (_c *CompressionJobCreate) SetPsnr(v float64) *CompressionJobCreate {
+	_c.mutation.SetPsnr(v)
+	return _c
+}
+
+// SetNillablePsnr sets the "psnr" field if the given value is not nil.
+func (_c *CompressionJobCreate) SetNillablePsnr(v *float64) *CompressionJobCreate {
+	if v != nil {
+		_c.SetPsnr(*v)
+	}
+	return _c
+}
+
+// SetVmaf sets the "vmaf" field.
+func (_c *CompressionJobCreate) SetVmaf(v float64) *CompressionJobCreate {
+	_c.mutation.SetVmaf(v)
+	return _c
+}
+
+// SetNillableVmaf sets the "vmaf" field if the given value is not nil.
+func (_c *CompressionJobCreate) SetNillableVmaf(v *float64) *CompressionJobCreate {
+	if v != nil {
+		_c.SetVmaf(*v)
+	}
+	return _c
+}
+
+// SetEncoderParams sets the "encoder_params" field.
+func (_c *CompressionJobCreate) SetEncoderParams(v schema.EncoderParams) *CompressionJobCreate {
+	_c.mutation.SetEncoderParams(v)
+	return _c
+}
+
+// SetNillableEncoderParams sets the "encoder_params" field if the given value is not nil.
+func (_c *CompressionJobCreate) SetNillableEncoderParams(v *schema.EncoderParams) *CompressionJobCreate {
+	if v != nil {
+		_c.SetEncoderParams(*v)
+	}
+	return _c
+}
+
+// SetFfmpegVersion sets the "ffmpeg_version" field.
+func (_c *CompressionJobCreate) SetFfmpegVersion(v string) *CompressionJobCreate {
+	_c.mutation.SetFfmpegVersion(v)
+	return _c
+}
+
+// SetNillableFfmpegVersion sets the "ffmpeg_version" field if the given value is not nil.
+func (_c *CompressionJobCreate) SetNillableFfmpegVersion(v *string) *CompressionJobCreate {
+	if v != nil {
+		_c.SetFfmpegVersion(*v)
+	}
+	return _c
+}
+
+// SetCommands sets the "commands" field.
+func (_c *CompressionJobCreate) SetCommands(v []string) *CompressionJobCreate {
+	_c.mutation.SetCommands(v)
+	return _c
+}
+
+// SetLog sets the "log" field.
+func (_c *CompressionJobCreate) SetLog(v string) *CompressionJobCreate {
+	_c.mutation.SetLog(v)
+	return _c
+}
+
+// SetNillableLog sets the "log" field if the given value is not nil.
+func (_c *CompressionJobCreate) SetNillableLog(v *string) *CompressionJobCreate {
+	if v != nil {
+		_c.SetLog(*v)
+	}
+	return _c
+}
+
+// SetPriority sets the "priority" field.
+func (_c *CompressionJobCreate) SetPriority(v int) *CompressionJobCreate {
+	_c.mutation.SetPriority(v)
+	return _c
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_c *CompressionJobCreate) SetNillablePriority(v *int) *CompressionJobCreate {
+	if v != nil {
+		_c.SetPriority(*v)
+	}
+	return _c
+}
+
+// SetSubmitter sets the "submitter" field.
+func (_c *CompressionJobCreate) SetSubmitter(v string) *CompressionJobCreate {
+	_c.mutation.SetSubmitter(v)
+	return _c
+}
+
+// SetNillableSubmitter sets the "submitter" field if the given value is not nil.
+func (_c *CompressionJobCreate) SetNillableSubmitter(v *string) *CompressionJobCreate {
+	if v != nil {
+		_c.SetSubmitter(*v)
+	}
+	return _c
+}
+
+// SetPool sets the "pool" field.
+func (_c *CompressionJobCreate) SetPool(v string) *CompressionJobCreate {
+	_c.mutation.SetPool(v)
+	return _c
+}
+
+// SetNillablePool sets the "pool" field if the given value is not nil.
+func (_c *CompressionJobCreate) SetNillablePool(v *string) *CompressionJobCreate {
+	if v != nil {
+		_c.SetPool(*v)
+	}
+	return _c
+}
+
+// SetWorkerID sets the "worker_id" field.
+func (_c *CompressionJobCreate) SetWorkerID(v string) *CompressionJobCreate {
+	_c.mutation.SetWorkerID(v)
+	return _c
+}
+
+// SetNillableWorkerID sets the "worker_id" field if the given value is not nil.
+func (_c *CompressionJobCreate) SetNillableWorkerID(v *string) *CompressionJobCreate {
+	if v != nil {
+		_c.SetWorkerID(*v)
+	}
+	return _c
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (_c *CompressionJobCreate) SetLeaseExpiresAt(v time.Time) *CompressionJobCreate {
+	_c.mutation.SetLeaseExpiresAt(v)
+	return _c
+}
+
+// SetNillableLeaseExpiresAt sets the "lease_expires_at" field if the given value is not nil.
+func (_c *CompressionJobCreate) SetNillableLeaseExpiresAt(v *time.Time) *CompressionJobCreate {
+	if v != nil {
+		_c.SetLeaseExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetCancelRequested sets the "cancel_requested" field.
+func (_c *CompressionJobCreate) SetCancelRequested(v bool) *CompressionJobCreate {
+	_c.mutation.SetCancelRequested(v)
+	return _c
+}
+
+// SetNillableCancelRequested sets the "cancel_requested" field if the given value is not nil.
+func (_c *CompressionJobCreate) SetNillableCancelRequested(v *bool) *CompressionJobCreate {
+	if v != nil {
+		_c.SetCancelRequested(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *CompressionJobCreate) SetAttempts(v int) *CompressionJobCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *CompressionJobCreate) SetNillableAttempts(v *int) *CompressionJobCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (_c *CompressionJobCreate) SetMaxAttempts(v int) *CompressionJobCreate {
+	_c.mutation.SetMaxAttempts(v)
+	return _c
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (_c *CompressionJobCreate) SetNillableMaxAttempts(v *int) *CompressionJobCreate {
+	if v != nil {
+		_c.SetMaxAttempts(*v)
+	}
+	return _c
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_c *CompressionJobCreate) SetNextAttemptAt(v time.Time) *CompressionJobCreate {
+	_c.mutation.SetNextAttemptAt(v)
+	return _c
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_c *CompressionJobCreate) SetNillableNextAttemptAt(v *time.Time) *CompressionJobCreate {
+	if v != nil {
+		_c.SetNextAttemptAt(*v)
+	}
+	return _c
+}
+
+// SetAttemptErrors sets the "attempt_errors" field.
+func (_c *CompressionJobCreate) SetAttemptErrors(v []string) *CompressionJobCreate {
+	_c.mutation.SetAttemptErrors(v)
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *CompressionJobCreate) SetID(v string) *CompressionJobCreate {
 	_c.mutation.SetID(v)
@@ -151,6 +506,10 @@ func (_c *CompressionJobCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *CompressionJobCreate) defaults() {
+	if _, ok := _c.mutation.Kind(); !ok {
+		v := compressionjob.DefaultKind
+		_c.mutation.SetKind(v)
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		v := compressionjob.DefaultStatus
 		_c.mutation.SetStatus(v)
@@ -159,6 +518,34 @@ func (_c *CompressionJobCreate) defaults() {
 		v := compressionjob.DefaultProgress
 		_c.mutation.SetProgress(v)
 	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := compressionjob.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.Normalize(); !ok {
+		v := compressionjob.DefaultNormalize
+		_c.mutation.SetNormalize(v)
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		v := compressionjob.DefaultPriority
+		_c.mutation.SetPriority(v)
+	}
+	if _, ok := _c.mutation.Submitter(); !ok {
+		v := compressionjob.DefaultSubmitter
+		_c.mutation.SetSubmitter(v)
+	}
+	if _, ok := _c.mutation.CancelRequested(); !ok {
+		v := compressionjob.DefaultCancelRequested
+		_c.mutation.SetCancelRequested(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := compressionjob.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.MaxAttempts(); !ok {
+		v := compressionjob.DefaultMaxAttempts
+		_c.mutation.SetMaxAttempts(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := compressionjob.DefaultID()
 		_c.mutation.SetID(v)
@@ -167,12 +554,33 @@ func (_c *CompressionJobCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *CompressionJobCreate) check() error {
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "CompressionJob.kind"`)}
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "CompressionJob.status"`)}
 	}
 	if _, ok := _c.mutation.Progress(); !ok {
 		return &ValidationError{Name: "progress", err: errors.New(`ent: missing required field "CompressionJob.progress"`)}
 	}
+	if _, ok := _c.mutation.Normalize(); !ok {
+		return &ValidationError{Name: "normalize", err: errors.New(`ent: missing required field "CompressionJob.normalize"`)}
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "CompressionJob.priority"`)}
+	}
+	if _, ok := _c.mutation.Submitter(); !ok {
+		return &ValidationError{Name: "submitter", err: errors.New(`ent: missing required field "CompressionJob.submitter"`)}
+	}
+	if _, ok := _c.mutation.CancelRequested(); !ok {
+		return &ValidationError{Name: "cancel_requested", err: errors.New(`ent: missing required field "CompressionJob.cancel_requested"`)}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "CompressionJob.attempts"`)}
+	}
+	if _, ok := _c.mutation.MaxAttempts(); !ok {
+		return &ValidationError{Name: "max_attempts", err: errors.New(`ent: missing required field "CompressionJob.max_attempts"`)}
+	}
 	if len(_c.mutation.AssetIDs()) == 0 {
 		return &ValidationError{Name: "asset", err: errors.New(`ent: missing required edge "CompressionJob.asset"`)}
 	}
@@ -211,6 +619,10 @@ func (_c *CompressionJobCreate) createSpec() (*CompressionJob, *sqlgraph.CreateS
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(compressionjob.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
 	if value, ok := _c.mutation.Status(); ok {
 		_spec.SetField(compressionjob.FieldStatus, field.TypeString, value)
 		_node.Status = value
@@ -223,6 +635,10 @@ func (_c *CompressionJobCreate) createSpec() (*CompressionJob, *sqlgraph.CreateS
 		_spec.SetField(compressionjob.FieldError, field.TypeString, value)
 		_node.Error = value
 	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(compressionjob.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
 	if value, ok := _c.mutation.StartedAt(); ok {
 		_spec.SetField(compressionjob.FieldStartedAt, field.TypeTime, value)
 		_node.StartedAt = value
@@ -231,6 +647,106 @@ func (_c *CompressionJobCreate) createSpec() (*CompressionJob, *sqlgraph.CreateS
 		_spec.SetField(compressionjob.FieldCompletedAt, field.TypeTime, value)
 		_node.CompletedAt = value
 	}
+	if value, ok := _c.mutation.Profile(); ok {
+		_spec.SetField(compressionjob.FieldProfile, field.TypeString, value)
+		_node.Profile = value
+	}
+	if value, ok := _c.mutation.TargetFormat(); ok {
+		_spec.SetField(compressionjob.FieldTargetFormat, field.TypeString, value)
+		_node.TargetFormat = value
+	}
+	if value, ok := _c.mutation.AudioBitrate(); ok {
+		_spec.SetField(compressionjob.FieldAudioBitrate, field.TypeString, value)
+		_node.AudioBitrate = value
+	}
+	if value, ok := _c.mutation.Normalize(); ok {
+		_spec.SetField(compressionjob.FieldNormalize, field.TypeBool, value)
+		_node.Normalize = value
+	}
+	if value, ok := _c.mutation.Outcome(); ok {
+		_spec.SetField(compressionjob.FieldOutcome, field.TypeString, value)
+		_node.Outcome = value
+	}
+	if value, ok := _c.mutation.Steps(); ok {
+		_spec.SetField(compressionjob.FieldSteps, field.TypeJSON, value)
+		_node.Steps = value
+	}
+	if value, ok := _c.mutation.TargetBytes(); ok {
+		_spec.SetField(compressionjob.FieldTargetBytes, field.TypeInt64, value)
+		_node.TargetBytes = value
+	}
+	if value, ok := _c.mutation.TargetParams(); ok {
+		_spec.SetField(compressionjob.FieldTargetParams, field.TypeJSON, value)
+		_node.TargetParams = value
+	}
+	if value, ok := _c.mutation.Ssim(); ok {
+		_spec.SetField(compressionjob.FieldSsim, field.TypeFloat64, value)
+		_node.Ssim = &value
+	}
+	if value, ok := _c.mutation.Psnr(); ok {
+		_spec.SetField(compressionjob.FieldPsnr, field.TypeFloat64, value)
+		_node.Psnr = &value
+	}
+	if value, ok := _c.mutation.Vmaf(); ok {
+		_spec.SetField(compressionjob.FieldVmaf, field.TypeFloat64, value)
+		_node.Vmaf = &value
+	}
+	if value, ok := _c.mutation.EncoderParams(); ok {
+		_spec.SetField(compressionjob.FieldEncoderParams, field.TypeJSON, value)
+		_node.EncoderParams = value
+	}
+	if value, ok := _c.mutation.FfmpegVersion(); ok {
+		_spec.SetField(compressionjob.FieldFfmpegVersion, field.TypeString, value)
+		_node.FfmpegVersion = value
+	}
+	if value, ok := _c.mutation.Commands(); ok {
+		_spec.SetField(compressionjob.FieldCommands, field.TypeJSON, value)
+		_node.Commands = value
+	}
+	if value, ok := _c.mutation.Log(); ok {
+		_spec.SetField(compressionjob.FieldLog, field.TypeString, value)
+		_node.Log = value
+	}
+	if value, ok := _c.mutation.Priority(); ok {
+		_spec.SetField(compressionjob.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := _c.mutation.Submitter(); ok {
+		_spec.SetField(compressionjob.FieldSubmitter, field.TypeString, value)
+		_node.Submitter = value
+	}
+	if value, ok := _c.mutation.Pool(); ok {
+		_spec.SetField(compressionjob.FieldPool, field.TypeString, value)
+		_node.Pool = value
+	}
+	if value, ok := _c.mutation.WorkerID(); ok {
+		_spec.SetField(compressionjob.FieldWorkerID, field.TypeString, value)
+		_node.WorkerID = value
+	}
+	if value, ok := _c.mutation.LeaseExpiresAt(); ok {
+		_spec.SetField(compressionjob.FieldLeaseExpiresAt, field.TypeTime, value)
+		_node.LeaseExpiresAt = value
+	}
+	if value, ok := _c.mutation.CancelRequested(); ok {
+		_spec.SetField(compressionjob.FieldCancelRequested, field.TypeBool, value)
+		_node.CancelRequested = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(compressionjob.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.MaxAttempts(); ok {
+		_spec.SetField(compressionjob.FieldMaxAttempts, field.TypeInt, value)
+		_node.MaxAttempts = value
+	}
+	if value, ok := _c.mutation.NextAttemptAt(); ok {
+		_spec.SetField(compressionjob.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = value
+	}
+	if value, ok := _c.mutation.AttemptErrors(); ok {
+		_spec.SetField(compressionjob.FieldAttemptErrors, field.TypeJSON, value)
+		_node.AttemptErrors = value
+	}
 	if nodes := _c.mutation.AssetIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
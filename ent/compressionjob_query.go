@@ -299,12 +299,12 @@ func (_q *CompressionJobQuery) WithAsset(opts ...func(*AssetQuery)) *Compression
 // Example:
 //
 //	var v []struct {
-//		Status string `json:"status,omitempty"`
+//		Kind string `json:"kind,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.CompressionJob.Query().
-//		GroupBy(compressionjob.FieldStatus).
+//		GroupBy(compressionjob.FieldKind).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *CompressionJobQuery) GroupBy(field string, fields ...string) *CompressionJobGroupBy {
@@ -322,11 +322,11 @@ func (_q *CompressionJobQuery) GroupBy(field string, fields ...string) *Compress
 // Example:
 //
 //	var v []struct {
-//		Status string `json:"status,omitempty"`
+//		Kind string `json:"kind,omitempty"`
 //	}
 //
 //	client.CompressionJob.Query().
-//		Select(compressionjob.FieldStatus).
+//		Select(compressionjob.FieldKind).
 //		Scan(ctx, &v)
 func (_q *CompressionJobQuery) Select(fields ...string) *CompressionJobSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -10,10 +10,12 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/adimail/asset-manager/ent/asset"
 	"github.com/adimail/asset-manager/ent/compressionjob"
 	"github.com/adimail/asset-manager/ent/predicate"
+	"github.com/adimail/asset-manager/ent/schema"
 )
 
 // CompressionJobUpdate is the builder for updating CompressionJob entities.
@@ -29,6 +31,20 @@ func (_u *CompressionJobUpdate) Where(ps ...predicate.CompressionJob) *Compressi
 	return _u
 }
 
+// SetKind sets the "kind" field.
+func (_u *CompressionJobUpdate) SetKind(v string) *CompressionJobUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *CompressionJobUpdate) SetNillableKind(v *string) *CompressionJobUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *CompressionJobUpdate) SetStatus(v string) *CompressionJobUpdate {
 	_u.mutation.SetStatus(v)
@@ -84,283 +100,1485 @@ func (_u *CompressionJobUpdate) ClearError() *CompressionJobUpdate {
 	return _u
 }
 
-// SetStartedAt sets the "started_at" field.
-func (_u *CompressionJobUpdate) SetStartedAt(v time.Time) *CompressionJobUpdate {
-	_u.mutation.SetStartedAt(v)
+// SetStartedAt sets the "started_at" field.
+func (_u *CompressionJobUpdate) SetStartedAt(v time.Time) *CompressionJobUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *CompressionJobUpdate) SetNillableStartedAt(v *time.Time) *CompressionJobUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *CompressionJobUpdate) ClearStartedAt() *CompressionJobUpdate {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *CompressionJobUpdate) SetCompletedAt(v time.Time) *CompressionJobUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *CompressionJobUpdate) SetNillableCompletedAt(v *time.Time) *CompressionJobUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *CompressionJobUpdate) ClearCompletedAt() *CompressionJobUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetProfile sets the "profile" field.
+func (_u *CompressionJobUpdate) SetProfile(v string) *CompressionJobUpdate {
+	_u.mutation.SetProfile(v)
+	return _u
+}
+
+// SetNillableProfile sets the "profile" field if the given value is not nil.
+func (_u *CompressionJobUpdate) SetNillableProfile(v *string) *CompressionJobUpdate {
+	if v != nil {
+		_u.SetProfile(*v)
+	}
+	return _u
+}
+
+// ClearProfile clears the value of the "profile" field.
+func (_u *CompressionJobUpdate) ClearProfile() *CompressionJobUpdate {
+	_u.mutation.ClearProfile()
+	return _u
+}
+
+// SetTargetFormat sets the "target_format" field.
+func (_u *CompressionJobUpdate) SetTargetFormat(v string) *CompressionJobUpdate {
+	_u.mutation.SetTargetFormat(v)
+	return _u
+}
+
+// SetNillableTargetFormat sets the "target_format" field if the given value is not nil.
+func (_u *CompressionJobUpdate) SetNillableTargetFormat(v *string) *CompressionJobUpdate {
+	if v != nil {
+		_u.SetTargetFormat(*v)
+	}
+	return _u
+}
+
+// ClearTargetFormat clears the value of the "target_format" field.
+func (_u *CompressionJobUpdate) ClearTargetFormat() *CompressionJobUpdate {
+	_u.mutation.ClearTargetFormat()
+	return _u
+}
+
+// SetAudioBitrate sets the "audio_bitrate" field.
+func (_u *CompressionJobUpdate) SetAudioBitrate(v string) *CompressionJobUpdate {
+	_u.mutation.SetAudioBitrate(v)
+	return _u
+}
+
+// SetNillableAudioBitrate sets the "audio_bitrate" field if the given value is not nil.
+func (_u *CompressionJobUpdate) SetNillableAudioBitrate(v *string) *CompressionJobUpdate {
+	if v != nil {
+		_u.SetAudioBitrate(*v)
+	}
+	return _u
+}
+
+// ClearAudioBitrate clears the value of the "audio_bitrate" field.
+func (_u *CompressionJobUpdate) ClearAudioBitrate() *CompressionJobUpdate {
+	_u.mutation.ClearAudioBitrate()
+	return _u
+}
+
+// SetNormalize sets the "normalize" field.
+func (_u *CompressionJobUpdate) SetNormalize(v bool) *CompressionJobUpdate {
+	_u.mutation.SetNormalize(v)
+	return _u
+}
+
+// SetNillableNormalize sets the "normalize" field if the given value is not nil.
+func (_u *CompressionJobUpdate) SetNillableNormalize(v *bool) *CompressionJobUpdate {
+	if v != nil {
+		_u.SetNormalize(*v)
+	}
+	return _u
+}
+
+// SetOutcome sets the "outcome" field.
+func (_u *CompressionJobUpdate) SetOutcome(v string) *CompressionJobUpdate {
+	_u.mutation.SetOutcome(v)
+	return _u
+}
+
+// SetNillableOutcome sets the "outcome" field if the given value is not nil.
+func (_u *CompressionJobUpdate) SetNillableOutcome(v *string) *CompressionJobUpdate {
+	if v != nil {
+		_u.SetOutcome(*v)
+	}
+	return _u
+}
+
+// ClearOutcome clears the value of the "outcome" field.
+func (_u *CompressionJobUpdate) ClearOutcome() *CompressionJobUpdate {
+	_u.mutation.ClearOutcome()
+	return _u
+}
+
+// SetSteps sets the "steps" field.
+func (_u *CompressionJobUpdate) SetSteps(v []schema.PipelineStep) *CompressionJobUpdate {
+	_u.mutation.SetSteps(v)
+	return _u
+}
+
+// AppendSteps appends value to the "steps" field.
+func (_u *CompressionJobUpdate) AppendSteps(v []schema.PipelineStep) *CompressionJobUpdate {
+	_u.mutation.AppendSteps(v)
+	return _u
+}
+
+// ClearSteps clears the value of the "steps" field.
+func (_u *CompressionJobUpdate) ClearSteps() *CompressionJobUpdate {
+	_u.mutation.ClearSteps()
+	return _u
+}
+
+// SetTargetBytes sets the "target_bytes" field.
+func (_u *CompressionJobUpdate) SetTargetBytes(v int64) *CompressionJobUpdate {
+	_u.mutation.ResetTargetBytes()
+	_u.mutation.SetTargetBytes(v)
+	return _u
+}
+
+// SetNillableTargetBytes sets the "target_bytes" field if the given value is not nil.
+func (_u *CompressionJobUpdate) SetNillableTargetBytes(v *int64) *CompressionJobUpdate {
+	if v != nil {
+		_u.SetTargetBytes(*v)
+	}
+	return _u
+}
+
+// AddTargetBytes adds value to the "target_bytes" field.
+func (_u *CompressionJobUpdate) AddTargetBytes(v int64) *CompressionJobUpdate {
+	_u.mutation.AddTargetBytes(v)
+	return _u
+}
+
+// ClearTargetBytes clears the value of the "target_bytes" field.
+func (_u *CompressionJobUpdate) ClearTargetBytes() *CompressionJobUpdate {
+	_u.mutation.ClearTargetBytes()
+	return _u
+}
+
+// SetTargetParams sets the "target_params" field.
+func (_u *CompressionJobUpdate) SetTargetParams(v schema.TargetParams) *CompressionJobUpdate {
+	_u.mutation.SetTargetParams(v)
+	return _u
+}
+
+// SetNillableTargetParams sets the "target_params" field if the given value is not nil.
+func (_u *CompressionJobUpdate) SetNillableTargetParams(v *schema.TargetParams) *CompressionJobUpdate {
+	if v != nil {
+		_u.SetTargetParams(*v)
+	}
+	return _u
+}
+
+// ClearTargetParams clears the value of the "target_params" field.
+func (_u *CompressionJobUpdate) ClearTargetParams() *CompressionJobUpdate {
+	_u.mutation.ClearTargetParams()
+	return _u
+}
+
+// SetSsim sets the "ssim" field.
+func (_u *CompressionJobUpdate) SetSsim(v float64) *CompressionJobUpdate {
+	_u.mutation.ResetSsim()
+	_u.mutation.SetSsim(v)
+	return _u
+}
+
+// SetNillableSsim sets the "ssim" field if the given value is not nil.
+func (_u *CompressionJobUpdate) SetNillableSsim(v *float64) *CompressionJobUpdate {
+	if v != nil {
+		_u.SetSsim(*v)
+	}
+	return _u
+}
+
+// AddSsim adds value to the "ssim" field.
+func (_u *CompressionJobUpdate) AddSsim(v float64) *CompressionJobUpdate {
+	_u.mutation.AddSsim(v)
+	return _u
+}
+
+// ClearSsim clears the value of the "ssim" field.
+func (_u *CompressionJobUpdate) ClearSsim() *CompressionJobUpdate {
+	_u.mutation.ClearSsim()
+	return _u
+}
+
+// SetPsnr sets the "psnr" field.
+func (_u *CompressionJobUpdate) SetPsnr(v float64) *CompressionJobUpdate {
+	_u.mutation.ResetPsnr()
+	_u.mutation.SetPsnr(v)
+	return _u
+}
+
+// SetNillablePsnr sets the "psnr" field if the given value is not nil.
+func (_u *CompressionJobUpdate) SetNillablePsnr(v *float64) *CompressionJobUpdate {
+	if v != nil {
+		_u.SetPsnr(*v)
+	}
+	return _u
+}
+
+// AddPsnr adds value to the "psnr" field.
+func (_u *CompressionJobUpdate) AddPsnr(v float64) *CompressionJobUpdate {
+	_u.mutation.AddPsnr(v)
+	return _u
+}
+
+// ClearPsnr clears the value of the "psnr" field.
+func (_u *CompressionJobUpdate) ClearPsnr() *CompressionJobUpdate {
+	_u.mutation.ClearPsnr()
+	return _u
+}
+
+// SetVmaf sets the "vmaf" field.
+func (_u *CompressionJobUpdate) SetVmaf(v float64) *CompressionJobUpdate {
+	_u.mutation.ResetVmaf()
+	_u.mutation.SetVmaf(v)
+	return _u
+}
+
+// SetNillableVmaf sets the "vmaf" field if the given value is not nil.
+func (_u *CompressionJobUpdate) SetNillableVmaf(v *float64) *CompressionJobUpdate {
+	if v != nil {
+		_u.SetVmaf(*v)
+	}
+	return _u
+}
+
+// AddVmaf adds value to the "vmaf" field.
+func (_u *CompressionJobUpdate) AddVmaf(v float64) *CompressionJobUpdate {
+	_u.mutation.AddVmaf(v)
+	return _u
+}
+
+// ClearVmaf clears the value of the "vmaf" field.
+func (_u *CompressionJobUpdate) ClearVmaf() *CompressionJobUpdate {
+	_u.mutation.ClearVmaf()
+	return _u
+}
+
+// SetEncoderParams sets the "encoder_params" field.
+func (_u *CompressionJobUpdate) SetEncoderParams(v schema.EncoderParams) *CompressionJobUpdate {
+	_u.mutation.SetEncoderParams(v)
+	return _u
+}
+
+// SetNillableEncoderParams sets the "encoder_params" field if the given value is not nil.
+func (_u *CompressionJobUpdate) SetNillableEncoderParams(v *schema.EncoderParams) *CompressionJobUpdate {
+	if v != nil {
+		_u.SetEncoderParams(*v)
+	}
+	return _u
+}
+
+// ClearEncoderParams clears the value of the "encoder_params" field.
+func (_u *CompressionJobUpdate) ClearEncoderParams() *CompressionJobUpdate {
+	_u.mutation.ClearEncoderParams()
+	return _u
+}
+
+// SetFfmpegVersion sets the "ffmpeg_version" field.
+func (_u *CompressionJobUpdate) SetFfmpegVersion(v string) *CompressionJobUpdate {
+	_u.mutation.SetFfmpegVersion(v)
+	return _u
+}
+
+// SetNillableFfmpegVersion sets the "ffmpeg_version" field if the given value is not nil.
+func (_u *CompressionJobUpdate) SetNillableFfmpegVersion(v *string) *CompressionJobUpdate {
+	if v != nil {
+		_u.SetFfmpegVersion(*v)
+	}
+	return _u
+}
+
+// ClearFfmpegVersion clears the value of the "ffmpeg_version" field.
+func (_u *CompressionJobUpdate) ClearFfmpegVersion() *CompressionJobUpdate {
+	_u.mutation.ClearFfmpegVersion()
+	return _u
+}
+
+// SetCommands sets the "commands" field.
+func (_u *CompressionJobUpdate) SetCommands(v []string) *CompressionJobUpdate {
+	_u.mutation.SetCommands(v)
+	return _u
+}
+
+// AppendCommands appends value to the "commands" field.
+func (_u *CompressionJobUpdate) AppendCommands(v []string) *CompressionJobUpdate {
+	_u.mutation.AppendCommands(v)
+	return _u
+}
+
+// ClearCommands clears the value of the "commands" field.
+func (_u *CompressionJobUpdate) ClearCommands() *CompressionJobUpdate {
+	_u.mutation.ClearCommands()
+	return _u
+}
+
+// SetLog sets the "log" field.
+func (_u *CompressionJobUpdate) SetLog(v string) *CompressionJobUpdate {
+	_u.mutation.SetLog(v)
+	return _u
+}
+
+// SetNillableLog sets the "log" field if the given value is not nil.
+func (_u *CompressionJobUpdate) SetNillableLog(v *string) *CompressionJobUpdate {
+	if v != nil {
+		_u.SetLog(*v)
+	}
+	return _u
+}
+
+// ClearLog clears the value of the "log" field.
+func (_u *CompressionJobUpdate) ClearLog() *CompressionJobUpdate {
+	_u.mutation.ClearLog()
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *CompressionJobUpdate) SetPriority(v int) *CompressionJobUpdate {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *CompressionJobUpdate) SetNillablePriority(v *int) *CompressionJobUpdate {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *CompressionJobUpdate) AddPriority(v int) *CompressionJobUpdate {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetSubmitter sets the "submitter" field.
+func (_u *CompressionJobUpdate) SetSubmitter(v string) *CompressionJobUpdate {
+	_u.mutation.SetSubmitter(v)
+	return _u
+}
+
+// SetNillableSubmitter sets the "submitter" field if the given value is not nil.
+func (_u *CompressionJobUpdate) SetNillableSubmitter(v *string) *CompressionJobUpdate {
+	if v != nil {
+		_u.SetSubmitter(*v)
+	}
+	return _u
+}
+
+// SetPool sets the "pool" field.
+func (_u *CompressionJobUpdate) SetPool(v string) *CompressionJobUpdate {
+	_u.mutation.SetPool(v)
+	return _u
+}
+
+// SetNillablePool sets the "pool" field if the given value is not nil.
+func (_u *CompressionJobUpdate) SetNillablePool(v *string) *CompressionJobUpdate {
+	if v != nil {
+		_u.SetPool(*v)
+	}
+	return _u
+}
+
+// ClearPool clears the value of the "pool" field.
+func (_u *CompressionJobUpdate) ClearPool() *CompressionJobUpdate {
+	_u.mutation.ClearPool()
+	return _u
+}
+
+// SetWorkerID sets the "worker_id" field.
+func (_u *CompressionJobUpdate) SetWorkerID(v string) *CompressionJobUpdate {
+	_u.mutation.SetWorkerID(v)
+	return _u
+}
+
+// SetNillableWorkerID sets the "worker_id" field if the given value is not nil.
+func (_u *CompressionJobUpdate) SetNillableWorkerID(v *string) *CompressionJobUpdate {
+	if v != nil {
+		_u.SetWorkerID(*v)
+	}
+	return _u
+}
+
+// ClearWorkerID clears the value of the "worker_id" field.
+func (_u *CompressionJobUpdate) ClearWorkerID() *CompressionJobUpdate {
+	_u.mutation.ClearWorkerID()
+	return _u
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (_u *CompressionJobUpdate) SetLeaseExpiresAt(v time.Time) *CompressionJobUpdate {
+	_u.mutation.SetLeaseExpiresAt(v)
+	return _u
+}
+
+// SetNillableLeaseExpiresAt sets the "lease_expires_at" field if the given value is not nil.
+func (_u *CompressionJobUpdate) SetNillableLeaseExpiresAt(v *time.Time) *CompressionJobUpdate {
+	if v != nil {
+		_u.SetLeaseExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (_u *CompressionJobUpdate) ClearLeaseExpiresAt() *CompressionJobUpdate {
+	_u.mutation.ClearLeaseExpiresAt()
+	return _u
+}
+
+// SetCancelRequested sets the "cancel_requested" field.
+func (_u *CompressionJobUpdate) SetCancelRequested(v bool) *CompressionJobUpdate {
+	_u.mutation.SetCancelRequested(v)
+	return _u
+}
+
+// SetNillableCancelRequested sets the "cancel_requested" field if the given value is not nil.
+func (_u *CompressionJobUpdate) SetNillableCancelRequested(v *bool) *CompressionJobUpdate {
+	if v != nil {
+		_u.SetCancelRequested(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *CompressionJobUpdate) SetAttempts(v int) *CompressionJobUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *CompressionJobUpdate) SetNillableAttempts(v *int) *CompressionJobUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *CompressionJobUpdate) AddAttempts(v int) *CompressionJobUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (_u *CompressionJobUpdate) SetMaxAttempts(v int) *CompressionJobUpdate {
+	_u.mutation.ResetMaxAttempts()
+	_u.mutation.SetMaxAttempts(v)
+	return _u
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (_u *CompressionJobUpdate) SetNillableMaxAttempts(v *int) *CompressionJobUpdate {
+	if v != nil {
+		_u.SetMaxAttempts(*v)
+	}
+	return _u
+}
+
+// AddMaxAttempts adds value to the "max_attempts" field.
+func (_u *CompressionJobUpdate) AddMaxAttempts(v int) *CompressionJobUpdate {
+	_u.mutation.AddMaxAttempts(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *CompressionJobUpdate) SetNextAttemptAt(v time.Time) *CompressionJobUpdate {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *CompressionJobUpdate) SetNillableNextAttemptAt(v *time.Time) *CompressionJobUpdate {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (_u *CompressionJobUpdate) ClearNextAttemptAt() *CompressionJobUpdate {
+	_u.mutation.ClearNextAttemptAt()
+	return _u
+}
+
+// SetAttemptErrors sets the "attempt_errors" field.
+func (_u *CompressionJobUpdate) SetAttemptErrors(v []string) *CompressionJobUpdate {
+	_u.mutation.SetAttemptErrors(v)
+	return _u
+}
+
+// AppendAttemptErrors appends value to the "attempt_errors" field.
+func (_u *CompressionJobUpdate) AppendAttemptErrors(v []string) *CompressionJobUpdate {
+	_u.mutation.AppendAttemptErrors(v)
+	return _u
+}
+
+// ClearAttemptErrors clears the value of the "attempt_errors" field.
+func (_u *CompressionJobUpdate) ClearAttemptErrors() *CompressionJobUpdate {
+	_u.mutation.ClearAttemptErrors()
+	return _u
+}
+
+// SetAssetID sets the "asset" edge to the Asset entity by ID.
+func (_u *CompressionJobUpdate) SetAssetID(id string) *CompressionJobUpdate {
+	_u.mutation.SetAssetID(id)
+	return _u
+}
+
+// SetAsset sets the "asset" edge to the Asset entity.
+func (_u *CompressionJobUpdate) SetAsset(v *Asset) *CompressionJobUpdate {
+	return _u.SetAssetID(v.ID)
+}
+
+// Mutation returns the CompressionJobMutation object of the builder.
+func (_u *CompressionJobUpdate) Mutation() *CompressionJobMutation {
+	return _u.mutation
+}
+
+// ClearAsset clears the "asset" edge to the Asset entity.
+func (_u *CompressionJobUpdate) ClearAsset() *CompressionJobUpdate {
+	_u.mutation.ClearAsset()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CompressionJobUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CompressionJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CompressionJobUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CompressionJobUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CompressionJobUpdate) check() error {
+	if _u.mutation.AssetCleared() && len(_u.mutation.AssetIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CompressionJob.asset"`)
+	}
+	return nil
+}
+
+func (_u *CompressionJobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(compressionjob.Table, compressionjob.Columns, sqlgraph.NewFieldSpec(compressionjob.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(compressionjob.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(compressionjob.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Progress(); ok {
+		_spec.SetField(compressionjob.FieldProgress, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedProgress(); ok {
+		_spec.AddField(compressionjob.FieldProgress, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(compressionjob.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(compressionjob.FieldError, field.TypeString)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(compressionjob.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(compressionjob.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(compressionjob.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(compressionjob.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(compressionjob.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Profile(); ok {
+		_spec.SetField(compressionjob.FieldProfile, field.TypeString, value)
+	}
+	if _u.mutation.ProfileCleared() {
+		_spec.ClearField(compressionjob.FieldProfile, field.TypeString)
+	}
+	if value, ok := _u.mutation.TargetFormat(); ok {
+		_spec.SetField(compressionjob.FieldTargetFormat, field.TypeString, value)
+	}
+	if _u.mutation.TargetFormatCleared() {
+		_spec.ClearField(compressionjob.FieldTargetFormat, field.TypeString)
+	}
+	if value, ok := _u.mutation.AudioBitrate(); ok {
+		_spec.SetField(compressionjob.FieldAudioBitrate, field.TypeString, value)
+	}
+	if _u.mutation.AudioBitrateCleared() {
+		_spec.ClearField(compressionjob.FieldAudioBitrate, field.TypeString)
+	}
+	if value, ok := _u.mutation.Normalize(); ok {
+		_spec.SetField(compressionjob.FieldNormalize, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Outcome(); ok {
+		_spec.SetField(compressionjob.FieldOutcome, field.TypeString, value)
+	}
+	if _u.mutation.OutcomeCleared() {
+		_spec.ClearField(compressionjob.FieldOutcome, field.TypeString)
+	}
+	if value, ok := _u.mutation.Steps(); ok {
+		_spec.SetField(compressionjob.FieldSteps, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSteps(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, compressionjob.FieldSteps, value)
+		})
+	}
+	if _u.mutation.StepsCleared() {
+		_spec.ClearField(compressionjob.FieldSteps, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TargetBytes(); ok {
+		_spec.SetField(compressionjob.FieldTargetBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTargetBytes(); ok {
+		_spec.AddField(compressionjob.FieldTargetBytes, field.TypeInt64, value)
+	}
+	if _u.mutation.TargetBytesCleared() {
+		_spec.ClearField(compressionjob.FieldTargetBytes, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.TargetParams(); ok {
+		_spec.SetField(compressionjob.FieldTargetParams, field.TypeJSON, value)
+	}
+	if _u.mutation.TargetParamsCleared() {
+		_spec.ClearField(compressionjob.FieldTargetParams, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Ssim(); ok {
+		_spec.SetField(compressionjob.FieldSsim, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedSsim(); ok {
+		_spec.AddField(compressionjob.FieldSsim, field.TypeFloat64, value)
+	}
+	if _u.mutation.SsimCleared() {
+		_spec.ClearField(compressionjob.FieldSsim, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Psnr(); ok {
+		_spec.SetField(compressionjob.FieldPsnr, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPsnr(); ok {
+		_spec.AddField(compressionjob.FieldPsnr, field.TypeFloat64, value)
+	}
+	if _u.mutation.PsnrCleared() {
+		_spec.ClearField(compressionjob.FieldPsnr, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Vmaf(); ok {
+		_spec.SetField(compressionjob.FieldVmaf, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedVmaf(); ok {
+		_spec.AddField(compressionjob.FieldVmaf, field.TypeFloat64, value)
+	}
+	if _u.mutation.VmafCleared() {
+		_spec.ClearField(compressionjob.FieldVmaf, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.EncoderParams(); ok {
+		_spec.SetField(compressionjob.FieldEncoderParams, field.TypeJSON, value)
+	}
+	if _u.mutation.EncoderParamsCleared() {
+		_spec.ClearField(compressionjob.FieldEncoderParams, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.FfmpegVersion(); ok {
+		_spec.SetField(compressionjob.FieldFfmpegVersion, field.TypeString, value)
+	}
+	if _u.mutation.FfmpegVersionCleared() {
+		_spec.ClearField(compressionjob.FieldFfmpegVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.Commands(); ok {
+		_spec.SetField(compressionjob.FieldCommands, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCommands(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, compressionjob.FieldCommands, value)
+		})
+	}
+	if _u.mutation.CommandsCleared() {
+		_spec.ClearField(compressionjob.FieldCommands, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Log(); ok {
+		_spec.SetField(compressionjob.FieldLog, field.TypeString, value)
+	}
+	if _u.mutation.LogCleared() {
+		_spec.ClearField(compressionjob.FieldLog, field.TypeString)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(compressionjob.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(compressionjob.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Submitter(); ok {
+		_spec.SetField(compressionjob.FieldSubmitter, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Pool(); ok {
+		_spec.SetField(compressionjob.FieldPool, field.TypeString, value)
+	}
+	if _u.mutation.PoolCleared() {
+		_spec.ClearField(compressionjob.FieldPool, field.TypeString)
+	}
+	if value, ok := _u.mutation.WorkerID(); ok {
+		_spec.SetField(compressionjob.FieldWorkerID, field.TypeString, value)
+	}
+	if _u.mutation.WorkerIDCleared() {
+		_spec.ClearField(compressionjob.FieldWorkerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.LeaseExpiresAt(); ok {
+		_spec.SetField(compressionjob.FieldLeaseExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.LeaseExpiresAtCleared() {
+		_spec.ClearField(compressionjob.FieldLeaseExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CancelRequested(); ok {
+		_spec.SetField(compressionjob.FieldCancelRequested, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(compressionjob.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(compressionjob.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxAttempts(); ok {
+		_spec.SetField(compressionjob.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAttempts(); ok {
+		_spec.AddField(compressionjob.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(compressionjob.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextAttemptAtCleared() {
+		_spec.ClearField(compressionjob.FieldNextAttemptAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AttemptErrors(); ok {
+		_spec.SetField(compressionjob.FieldAttemptErrors, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAttemptErrors(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, compressionjob.FieldAttemptErrors, value)
+		})
+	}
+	if _u.mutation.AttemptErrorsCleared() {
+		_spec.ClearField(compressionjob.FieldAttemptErrors, field.TypeJSON)
+	}
+	if _u.mutation.AssetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   compressionjob.AssetTable,
+			Columns: []string{compressionjob.AssetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AssetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   compressionjob.AssetTable,
+			Columns: []string{compressionjob.AssetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{compressionjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CompressionJobUpdateOne is the builder for updating a single CompressionJob entity.
+type CompressionJobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CompressionJobMutation
+}
+
+// SetKind sets the "kind" field.
+func (_u *CompressionJobUpdateOne) SetKind(v string) *CompressionJobUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *CompressionJobUpdateOne) SetNillableKind(v *string) *CompressionJobUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CompressionJobUpdateOne) SetStatus(v string) *CompressionJobUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CompressionJobUpdateOne) SetNillableStatus(v *string) *CompressionJobUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetProgress sets the "progress" field.
+func (_u *CompressionJobUpdateOne) SetProgress(v int) *CompressionJobUpdateOne {
+	_u.mutation.ResetProgress()
+	_u.mutation.SetProgress(v)
+	return _u
+}
+
+// SetNillableProgress sets the "progress" field if the given value is not nil.
+func (_u *CompressionJobUpdateOne) SetNillableProgress(v *int) *CompressionJobUpdateOne {
+	if v != nil {
+		_u.SetProgress(*v)
+	}
+	return _u
+}
+
+// AddProgress adds value to the "progress" field.
+func (_u *CompressionJobUpdateOne) AddProgress(v int) *CompressionJobUpdateOne {
+	_u.mutation.AddProgress(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *CompressionJobUpdateOne) SetError(v string) *CompressionJobUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *CompressionJobUpdateOne) SetNillableError(v *string) *CompressionJobUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *CompressionJobUpdateOne) ClearError() *CompressionJobUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *CompressionJobUpdateOne) SetStartedAt(v time.Time) *CompressionJobUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *CompressionJobUpdateOne) SetNillableStartedAt(v *time.Time) *CompressionJobUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *CompressionJobUpdateOne) ClearStartedAt() *CompressionJobUpdateOne {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *CompressionJobUpdateOne) SetCompletedAt(v time.Time) *CompressionJobUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *CompressionJobUpdateOne) SetNillableCompletedAt(v *time.Time) *CompressionJobUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *CompressionJobUpdateOne) ClearCompletedAt() *CompressionJobUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetProfile sets the "profile" field.
+func (_u *CompressionJobUpdateOne) SetProfile(v string) *CompressionJobUpdateOne {
+	_u.mutation.SetProfile(v)
+	return _u
+}
+
+// SetNillableProfile sets the "profile" field if the given value is not nil.
+func (_u *CompressionJobUpdateOne) SetNillableProfile(v *string) *CompressionJobUpdateOne {
+	if v != nil {
+		_u.SetProfile(*v)
+	}
+	return _u
+}
+
+// ClearProfile clears the value of the "profile" field.
+func (_u *CompressionJobUpdateOne) ClearProfile() *CompressionJobUpdateOne {
+	_u.mutation.ClearProfile()
+	return _u
+}
+
+// SetTargetFormat sets the "target_format" field.
+func (_u *CompressionJobUpdateOne) SetTargetFormat(v string) *CompressionJobUpdateOne {
+	_u.mutation.SetTargetFormat(v)
+	return _u
+}
+
+// SetNillableTargetFormat sets the "target_format" field if the given value is not nil.
+func (_u *CompressionJobUpdateOne) SetNillableTargetFormat(v *string) *CompressionJobUpdateOne {
+	if v != nil {
+		_u.SetTargetFormat(*v)
+	}
+	return _u
+}
+
+// ClearTargetFormat clears the value of the "target_format" field.
+func (_u *CompressionJobUpdateOne) ClearTargetFormat() *CompressionJobUpdateOne {
+	_u.mutation.ClearTargetFormat()
+	return _u
+}
+
+// SetAudioBitrate sets the "audio_bitrate" field.
+func (_u *CompressionJobUpdateOne) SetAudioBitrate(v string) *CompressionJobUpdateOne {
+	_u.mutation.SetAudioBitrate(v)
+	return _u
+}
+
+// SetNillableAudioBitrate sets the "audio_bitrate" field if the given value is not nil.
+func (_u *CompressionJobUpdateOne) SetNillableAudioBitrate(v *string) *CompressionJobUpdateOne {
+	if v != nil {
+		_u.SetAudioBitrate(*v)
+	}
+	return _u
+}
+
+// ClearAudioBitrate clears the value of the "audio_bitrate" field.
+func (_u *CompressionJobUpdateOne) ClearAudioBitrate() *CompressionJobUpdateOne {
+	_u.mutation.ClearAudioBitrate()
+	return _u
+}
+
+// SetNormalize sets the "normalize" field.
+func (_u *CompressionJobUpdateOne) SetNormalize(v bool) *CompressionJobUpdateOne {
+	_u.mutation.SetNormalize(v)
+	return _u
+}
+
+// SetNillableNormalize sets the "normalize" field if the given value is not nil.
+func (_u *CompressionJobUpdateOne) SetNillableNormalize(v *bool) *CompressionJobUpdateOne {
+	if v != nil {
+		_u.SetNormalize(*v)
+	}
+	return _u
+}
+
+// SetOutcome sets the "outcome" field.
+func (_u *CompressionJobUpdateOne) SetOutcome(v string) *CompressionJobUpdateOne {
+	_u.mutation.SetOutcome(v)
+	return _u
+}
+
+// SetNillableOutcome sets the "outcome" field if the given value is not nil.
+func (_u *CompressionJobUpdateOne) SetNillableOutcome(v *string) *CompressionJobUpdateOne {
+	if v != nil {
+		_u.SetOutcome(*v)
+	}
+	return _u
+}
+
+// ClearOutcome clears the value of the "outcome" field.
+func (_u *CompressionJobUpdateOne) ClearOutcome() *CompressionJobUpdateOne {
+	_u.mutation.ClearOutcome()
+	return _u
+}
+
+// SetSteps sets the "steps" field.
+func (_u *CompressionJobUpdateOne) SetSteps(v []schema.PipelineStep) *CompressionJobUpdateOne {
+	_u.mutation.SetSteps(v)
+	return _u
+}
+
+// AppendSteps appends value to the "steps" field.
+func (_u *CompressionJobUpdateOne) AppendSteps(v []schema.PipelineStep) *CompressionJobUpdateOne {
+	_u.mutation.AppendSteps(v)
+	return _u
+}
+
+// ClearSteps clears the value of the "steps" field.
+func (_u *CompressionJobUpdateOne) ClearSteps() *CompressionJobUpdateOne {
+	_u.mutation.ClearSteps()
+	return _u
+}
+
+// SetTargetBytes sets the "target_bytes" field.
+func (_u *CompressionJobUpdateOne) SetTargetBytes(v int64) *CompressionJobUpdateOne {
+	_u.mutation.ResetTargetBytes()
+	_u.mutation.SetTargetBytes(v)
+	return _u
+}
+
+// SetNillableTargetBytes sets the "target_bytes" field if the given value is not nil.
+func (_u *CompressionJobUpdateOne) SetNillableTargetBytes(v *int64) *CompressionJobUpdateOne {
+	if v != nil {
+		_u.SetTargetBytes(*v)
+	}
+	return _u
+}
+
+// AddTargetBytes adds value to the "target_bytes" field.
+func (_u *CompressionJobUpdateOne) AddTargetBytes(v int64) *CompressionJobUpdateOne {
+	_u.mutation.AddTargetBytes(v)
+	return _u
+}
+
+// ClearTargetBytes clears the value of the "target_bytes" field.
+func (_u *CompressionJobUpdateOne) ClearTargetBytes() *CompressionJobUpdateOne {
+	_u.mutation.ClearTargetBytes()
+	return _u
+}
+
+// SetTargetParams sets the "target_params" field.
+func (_u *CompressionJobUpdateOne) SetTargetParams(v schema.TargetParams) *CompressionJobUpdateOne {
+	_u.mutation.SetTargetParams(v)
+	return _u
+}
+
+// SetNillableTargetParams sets the "target_params" field if the given value is not nil.
+func (_u *CompressionJobUpdateOne) SetNillableTargetParams(v *schema.TargetParams) *CompressionJobUpdateOne {
+	if v != nil {
+		_u.SetTargetParams(*v)
+	}
+	return _u
+}
+
+// ClearTargetParams clears the value of the "target_params" field.
+func (_u *CompressionJobUpdateOne) ClearTargetParams() *CompressionJobUpdateOne {
+	_u.mutation.ClearTargetParams()
+	return _u
+}
+
+// SetSsim sets the "ssim" field.
+func (_u *CompressionJobUpdateOne) SetSsim(v float64) *CompressionJobUpdateOne {
+	_u.mutation.ResetSsim()
+	_u.mutation.SetSsim(v)
+	return _u
+}
+
+// SetNillableSsim sets the "ssim" field if the given value is not nil.
+func (_u *CompressionJobUpdateOne) SetNillableSsim(v *float64) *CompressionJobUpdateOne {
+	if v != nil {
+		_u.SetSsim(*v)
+	}
+	return _u
+}
+
+// AddSsim adds value to the "ssim" field.
+func (_u *CompressionJobUpdateOne) AddSsim(v float64) *CompressionJobUpdateOne {
+	_u.mutation.AddSsim(v)
+	return _u
+}
+
+// ClearSsim clears the value of the "ssim" field.
+func (_u *CompressionJobUpdateOne) ClearSsim() *CompressionJobUpdateOne {
+	_u.mutation.ClearSsim()
+	return _u
+}
+
+// SetPsnr sets the "psnr" field.
+func (_u *CompressionJobUpdateOne) SetPsnr(v float64) *CompressionJobUpdateOne {
+	_u.mutation.ResetPsnr()
+	_u.mutation.SetPsnr(v)
+	return _u
+}
+
+// SetNillablePsnr sets the "psnr" field if the given value is not nil.
+func (_u *CompressionJobUpdateOne) SetNillablePsnr(v *float64) *CompressionJobUpdateOne {
+	if v != nil {
+		_u.SetPsnr(*v)
+	}
+	return _u
+}
+
+// AddPsnr adds value to the "psnr" field.
+func (_u *CompressionJobUpdateOne) AddPsnr(v float64) *CompressionJobUpdateOne {
+	_u.mutation.AddPsnr(v)
+	return _u
+}
+
+// ClearPsnr clears the value of the "psnr" field.
+func (_u *CompressionJobUpdateOne) ClearPsnr() *CompressionJobUpdateOne {
+	_u.mutation.ClearPsnr()
+	return _u
+}
+
+// SetVmaf sets the "vmaf" field.
+func (_u *CompressionJobUpdateOne) SetVmaf(v float64) *CompressionJobUpdateOne {
+	_u.mutation.ResetVmaf()
+	_u.mutation.SetVmaf(v)
+	return _u
+}
+
+// SetNillableVmaf sets the "vmaf" field if the given value is not nil.
+func (_u *CompressionJobUpdateOne) SetNillableVmaf(v *float64) *CompressionJobUpdateOne {
+	if v != nil {
+		_u.SetVmaf(*v)
+	}
+	return _u
+}
+
+// AddVmaf adds value to the "vmaf" field.
+func (_u *CompressionJobUpdateOne) AddVmaf(v float64) *CompressionJobUpdateOne {
+	_u.mutation.AddVmaf(v)
+	return _u
+}
+
+// ClearVmaf clears the value of the "vmaf" field.
+func (_u *CompressionJobUpdateOne) ClearVmaf() *CompressionJobUpdateOne {
+	_u.mutation.ClearVmaf()
+	return _u
+}
+
+// SetEncoderParams sets the "encoder_params" field.
+func (_u *CompressionJobUpdateOne) SetEncoderParams(v schema.EncoderParams) *CompressionJobUpdateOne {
+	_u.mutation.SetEncoderParams(v)
+	return _u
+}
+
+// SetNillableEncoderParams sets the "encoder_params" field if the given value is not nil.
+func (_u *CompressionJobUpdateOne) SetNillableEncoderParams(v *schema.EncoderParams) *CompressionJobUpdateOne {
+	if v != nil {
+		_u.SetEncoderParams(*v)
+	}
+	return _u
+}
+
+// ClearEncoderParams clears the value of the "encoder_params" field.
+func (_u *CompressionJobUpdateOne) ClearEncoderParams() *CompressionJobUpdateOne {
+	_u.mutation.ClearEncoderParams()
+	return _u
+}
+
+// SetFfmpegVersion sets the "ffmpeg_version" field.
+func (_u *CompressionJobUpdateOne) SetFfmpegVersion(v string) *CompressionJobUpdateOne {
+	_u.mutation.SetFfmpegVersion(v)
+	return _u
+}
+
+// SetNillableFfmpegVersion sets the "ffmpeg_version" field if the given value is not nil.
+func (_u *CompressionJobUpdateOne) SetNillableFfmpegVersion(v *string) *CompressionJobUpdateOne {
+	if v != nil {
+		_u.SetFfmpegVersion(*v)
+	}
+	return _u
+}
+
+// ClearFfmpegVersion clears the value of the "ffmpeg_version" field.
+func (_u *CompressionJobUpdateOne) ClearFfmpegVersion() *CompressionJobUpdateOne {
+	_u.mutation.ClearFfmpegVersion()
+	return _u
+}
+
+// SetCommands sets the "commands" field.
+func (_u *CompressionJobUpdateOne) SetCommands(v []string) *CompressionJobUpdateOne {
+	_u.mutation.SetCommands(v)
+	return _u
+}
+
+// AppendCommands appends value to the "commands" field.
+func (_u *CompressionJobUpdateOne) AppendCommands(v []string) *CompressionJobUpdateOne {
+	_u.mutation.AppendCommands(v)
+	return _u
+}
+
+// ClearCommands clears the value of the "commands" field.
+func (_u *CompressionJobUpdateOne) ClearCommands() *CompressionJobUpdateOne {
+	_u.mutation.ClearCommands()
+	return _u
+}
+
+// SetLog sets the "log" field.
+func (_u *CompressionJobUpdateOne) SetLog(v string) *CompressionJobUpdateOne {
+	_u.mutation.SetLog(v)
 	return _u
 }
 
-// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
-func (_u *CompressionJobUpdate) SetNillableStartedAt(v *time.Time) *CompressionJobUpdate {
+// SetNillableLog sets the "log" field if the given value is not nil.
+func (_u *CompressionJobUpdateOne) SetNillableLog(v *string) *CompressionJobUpdateOne {
 	if v != nil {
-		_u.SetStartedAt(*v)
+		_u.SetLog(*v)
 	}
 	return _u
 }
 
-// ClearStartedAt clears the value of the "started_at" field.
-func (_u *CompressionJobUpdate) ClearStartedAt() *CompressionJobUpdate {
-	_u.mutation.ClearStartedAt()
+// ClearLog clears the value of the "log" field.
+func (_u *CompressionJobUpdateOne) ClearLog() *CompressionJobUpdateOne {
+	_u.mutation.ClearLog()
 	return _u
 }
 
-// SetCompletedAt sets the "completed_at" field.
-func (_u *CompressionJobUpdate) SetCompletedAt(v time.Time) *CompressionJobUpdate {
-	_u.mutation.SetCompletedAt(v)
+// SetPriority sets the "priority" field.
+func (_u *CompressionJobUpdateOne) SetPriority(v int) *CompressionJobUpdateOne {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
 	return _u
 }
 
-// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
-func (_u *CompressionJobUpdate) SetNillableCompletedAt(v *time.Time) *CompressionJobUpdate {
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *CompressionJobUpdateOne) SetNillablePriority(v *int) *CompressionJobUpdateOne {
 	if v != nil {
-		_u.SetCompletedAt(*v)
+		_u.SetPriority(*v)
 	}
 	return _u
 }
 
-// ClearCompletedAt clears the value of the "completed_at" field.
-func (_u *CompressionJobUpdate) ClearCompletedAt() *CompressionJobUpdate {
-	_u.mutation.ClearCompletedAt()
+// AddPriority adds value to the "priority" field.
+func (_u *CompressionJobUpdateOne) AddPriority(v int) *CompressionJobUpdateOne {
+	_u.mutation.AddPriority(v)
 	return _u
 }
 
-// SetAssetID sets the "asset" edge to the Asset entity by ID.
-func (_u *CompressionJobUpdate) SetAssetID(id string) *CompressionJobUpdate {
-	_u.mutation.SetAssetID(id)
+// SetSubmitter sets the "submitter" field.
+func (_u *CompressionJobUpdateOne) SetSubmitter(v string) *CompressionJobUpdateOne {
+	_u.mutation.SetSubmitter(v)
 	return _u
 }
 
-// SetAsset sets the "asset" edge to the Asset entity.
-func (_u *CompressionJobUpdate) SetAsset(v *Asset) *CompressionJobUpdate {
-	return _u.SetAssetID(v.ID)
+// SetNillableSubmitter sets the "submitter" field if the given value is not nil.
+func (_u *CompressionJobUpdateOne) SetNillableSubmitter(v *string) *CompressionJobUpdateOne {
+	if v != nil {
+		_u.SetSubmitter(*v)
+	}
+	return _u
 }
 
-// Mutation returns the CompressionJobMutation object of the builder.
-func (_u *CompressionJobUpdate) Mutation() *CompressionJobMutation {
-	return _u.mutation
+// SetPool sets the "pool" field.
+func (_u *CompressionJobUpdateOne) SetPool(v string) *CompressionJobUpdateOne {
+	_u.mutation.SetPool(v)
+	return _u
 }
 
-// ClearAsset clears the "asset" edge to the Asset entity.
-func (_u *CompressionJobUpdate) ClearAsset() *CompressionJobUpdate {
-	_u.mutation.ClearAsset()
+// SetNillablePool sets the "pool" field if the given value is not nil.
+func (_u *CompressionJobUpdateOne) SetNillablePool(v *string) *CompressionJobUpdateOne {
+	if v != nil {
+		_u.SetPool(*v)
+	}
 	return _u
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (_u *CompressionJobUpdate) Save(ctx context.Context) (int, error) {
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+// ClearPool clears the value of the "pool" field.
+func (_u *CompressionJobUpdateOne) ClearPool() *CompressionJobUpdateOne {
+	_u.mutation.ClearPool()
+	return _u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_u *CompressionJobUpdate) SaveX(ctx context.Context) int {
-	affected, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return affected
+// SetWorkerID sets the "worker_id" field.
+func (_u *CompressionJobUpdateOne) SetWorkerID(v string) *CompressionJobUpdateOne {
+	_u.mutation.SetWorkerID(v)
+	return _u
 }
 
-// Exec executes the query.
-func (_u *CompressionJobUpdate) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
+// SetNillableWorkerID sets the "worker_id" field if the given value is not nil.
+func (_u *CompressionJobUpdateOne) SetNillableWorkerID(v *string) *CompressionJobUpdateOne {
+	if v != nil {
+		_u.SetWorkerID(*v)
+	}
+	return _u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *CompressionJobUpdate) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
-	}
+// ClearWorkerID clears the value of the "worker_id" field.
+func (_u *CompressionJobUpdateOne) ClearWorkerID() *CompressionJobUpdateOne {
+	_u.mutation.ClearWorkerID()
+	return _u
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (_u *CompressionJobUpdate) check() error {
-	if _u.mutation.AssetCleared() && len(_u.mutation.AssetIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "CompressionJob.asset"`)
-	}
-	return nil
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (_u *CompressionJobUpdateOne) SetLeaseExpiresAt(v time.Time) *CompressionJobUpdateOne {
+	_u.mutation.SetLeaseExpiresAt(v)
+	return _u
 }
 
-func (_u *CompressionJobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
-	if err := _u.check(); err != nil {
-		return _node, err
-	}
-	_spec := sqlgraph.NewUpdateSpec(compressionjob.Table, compressionjob.Columns, sqlgraph.NewFieldSpec(compressionjob.FieldID, field.TypeString))
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
-		}
-	}
-	if value, ok := _u.mutation.Status(); ok {
-		_spec.SetField(compressionjob.FieldStatus, field.TypeString, value)
-	}
-	if value, ok := _u.mutation.Progress(); ok {
-		_spec.SetField(compressionjob.FieldProgress, field.TypeInt, value)
-	}
-	if value, ok := _u.mutation.AddedProgress(); ok {
-		_spec.AddField(compressionjob.FieldProgress, field.TypeInt, value)
-	}
-	if value, ok := _u.mutation.Error(); ok {
-		_spec.SetField(compressionjob.FieldError, field.TypeString, value)
-	}
-	if _u.mutation.ErrorCleared() {
-		_spec.ClearField(compressionjob.FieldError, field.TypeString)
-	}
-	if value, ok := _u.mutation.StartedAt(); ok {
-		_spec.SetField(compressionjob.FieldStartedAt, field.TypeTime, value)
-	}
-	if _u.mutation.StartedAtCleared() {
-		_spec.ClearField(compressionjob.FieldStartedAt, field.TypeTime)
-	}
-	if value, ok := _u.mutation.CompletedAt(); ok {
-		_spec.SetField(compressionjob.FieldCompletedAt, field.TypeTime, value)
-	}
-	if _u.mutation.CompletedAtCleared() {
-		_spec.ClearField(compressionjob.FieldCompletedAt, field.TypeTime)
-	}
-	if _u.mutation.AssetCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   compressionjob.AssetTable,
-			Columns: []string{compressionjob.AssetColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := _u.mutation.AssetIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   compressionjob.AssetTable,
-			Columns: []string{compressionjob.AssetColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
-	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{compressionjob.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
+// SetNillableLeaseExpiresAt sets the "lease_expires_at" field if the given value is not nil.
+func (_u *CompressionJobUpdateOne) SetNillableLeaseExpiresAt(v *time.Time) *CompressionJobUpdateOne {
+	if v != nil {
+		_u.SetLeaseExpiresAt(*v)
 	}
-	_u.mutation.done = true
-	return _node, nil
+	return _u
 }
 
-// CompressionJobUpdateOne is the builder for updating a single CompressionJob entity.
-type CompressionJobUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *CompressionJobMutation
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (_u *CompressionJobUpdateOne) ClearLeaseExpiresAt() *CompressionJobUpdateOne {
+	_u.mutation.ClearLeaseExpiresAt()
+	return _u
 }
 
-// SetStatus sets the "status" field.
-func (_u *CompressionJobUpdateOne) SetStatus(v string) *CompressionJobUpdateOne {
-	_u.mutation.SetStatus(v)
+// SetCancelRequested sets the "cancel_requested" field.
+func (_u *CompressionJobUpdateOne) SetCancelRequested(v bool) *CompressionJobUpdateOne {
+	_u.mutation.SetCancelRequested(v)
 	return _u
 }
 
-// SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *CompressionJobUpdateOne) SetNillableStatus(v *string) *CompressionJobUpdateOne {
+// SetNillableCancelRequested sets the "cancel_requested" field if the given value is not nil.
+func (_u *CompressionJobUpdateOne) SetNillableCancelRequested(v *bool) *CompressionJobUpdateOne {
 	if v != nil {
-		_u.SetStatus(*v)
+		_u.SetCancelRequested(*v)
 	}
 	return _u
 }
 
-// SetProgress sets the "progress" field.
-func (_u *CompressionJobUpdateOne) SetProgress(v int) *CompressionJobUpdateOne {
-	_u.mutation.ResetProgress()
-	_u.mutation.SetProgress(v)
+// SetAttempts sets the "attempts" field.
+func (_u *CompressionJobUpdateOne) SetAttempts(v int) *CompressionJobUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
 	return _u
 }
 
-// SetNillableProgress sets the "progress" field if the given value is not nil.
-func (_u *CompressionJobUpdateOne) SetNillableProgress(v *int) *CompressionJobUpdateOne {
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *CompressionJobUpdateOne) SetNillableAttempts(v *int) *CompressionJobUpdateOne {
 	if v != nil {
-		_u.SetProgress(*v)
+		_u.SetAttempts(*v)
 	}
 	return _u
 }
 
-// AddProgress adds value to the "progress" field.
-func (_u *CompressionJobUpdateOne) AddProgress(v int) *CompressionJobUpdateOne {
-	_u.mutation.AddProgress(v)
+// AddAttempts adds value to the "attempts" field.
+func (_u *CompressionJobUpdateOne) AddAttempts(v int) *CompressionJobUpdateOne {
+	_u.mutation.AddAttempts(v)
 	return _u
 }
 
-// SetError sets the "error" field.
-func (_u *CompressionJobUpdateOne) SetError(v string) *CompressionJobUpdateOne {
-	_u.mutation.SetError(v)
+// SetMaxAttempts sets the "max_attempts" field.
+func (_u *CompressionJobUpdateOne) SetMaxAttempts(v int) *CompressionJobUpdateOne {
+	_u.mutation.ResetMaxAttempts()
+	_u.mutation.SetMaxAttempts(v)
 	return _u
 }
 
-// SetNillableError sets the "error" field if the given value is not nil.
-func (_u *CompressionJobUpdateOne) SetNillableError(v *string) *CompressionJobUpdateOne {
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (_u *CompressionJobUpdateOne) SetNillableMaxAttempts(v *int) *CompressionJobUpdateOne {
 	if v != nil {
-		_u.SetError(*v)
+		_u.SetMaxAttempts(*v)
 	}
 	return _u
 }
 
-// ClearError clears the value of the "error" field.
-func (_u *CompressionJobUpdateOne) ClearError() *CompressionJobUpdateOne {
-	_u.mutation.ClearError()
+// AddMaxAttempts adds value to the "max_attempts" field.
+func (_u *CompressionJobUpdateOne) AddMaxAttempts(v int) *CompressionJobUpdateOne {
+	_u.mutation.AddMaxAttempts(v)
 	return _u
 }
 
-// SetStartedAt sets the "started_at" field.
-func (_u *CompressionJobUpdateOne) SetStartedAt(v time.Time) *CompressionJobUpdateOne {
-	_u.mutation.SetStartedAt(v)
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *CompressionJobUpdateOne) SetNextAttemptAt(v time.Time) *CompressionJobUpdateOne {
+	_u.mutation.SetNextAttemptAt(v)
 	return _u
 }
 
-// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
-func (_u *CompressionJobUpdateOne) SetNillableStartedAt(v *time.Time) *CompressionJobUpdateOne {
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *CompressionJobUpdateOne) SetNillableNextAttemptAt(v *time.Time) *CompressionJobUpdateOne {
 	if v != nil {
-		_u.SetStartedAt(*v)
+		_u.SetNextAttemptAt(*v)
 	}
 	return _u
 }
 
-// ClearStartedAt clears the value of the "started_at" field.
-func (_u *CompressionJobUpdateOne) ClearStartedAt() *CompressionJobUpdateOne {
-	_u.mutation.ClearStartedAt()
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (_u *CompressionJobUpdateOne) ClearNextAttemptAt() *CompressionJobUpdateOne {
+	_u.mutation.ClearNextAttemptAt()
 	return _u
 }
 
-// SetCompletedAt sets the "completed_at" field.
-func (_u *CompressionJobUpdateOne) SetCompletedAt(v time.Time) *CompressionJobUpdateOne {
-	_u.mutation.SetCompletedAt(v)
+// SetAttemptErrors sets the "attempt_errors" field.
+func (_u *CompressionJobUpdateOne) SetAttemptErrors(v []string) *CompressionJobUpdateOne {
+	_u.mutation.SetAttemptErrors(v)
 	return _u
 }
 
-// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
-func (_u *CompressionJobUpdateOne) SetNillableCompletedAt(v *time.Time) *CompressionJobUpdateOne {
-	if v != nil {
-		_u.SetCompletedAt(*v)
-	}
+// AppendAttemptErrors appends value to the "attempt_errors" field.
+func (_u *CompressionJobUpdateOne) AppendAttemptErrors(v []string) *CompressionJobUpdateOne {
+	_u.mutation.AppendAttemptErrors(v)
 	return _u
 }
 
-// ClearCompletedAt clears the value of the "completed_at" field.
-func (_u *CompressionJobUpdateOne) ClearCompletedAt() *CompressionJobUpdateOne {
-	_u.mutation.ClearCompletedAt()
+// ClearAttemptErrors clears the value of the "attempt_errors" field.
+func (_u *CompressionJobUpdateOne) ClearAttemptErrors() *CompressionJobUpdateOne {
+	_u.mutation.ClearAttemptErrors()
 	return _u
 }
 
@@ -463,6 +1681,9 @@ func (_u *CompressionJobUpdateOne) sqlSave(ctx context.Context) (_node *Compress
 			}
 		}
 	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(compressionjob.FieldKind, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(compressionjob.FieldStatus, field.TypeString, value)
 	}
@@ -478,6 +1699,9 @@ func (_u *CompressionJobUpdateOne) sqlSave(ctx context.Context) (_node *Compress
 	if _u.mutation.ErrorCleared() {
 		_spec.ClearField(compressionjob.FieldError, field.TypeString)
 	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(compressionjob.FieldCreatedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.StartedAt(); ok {
 		_spec.SetField(compressionjob.FieldStartedAt, field.TypeTime, value)
 	}
@@ -490,6 +1714,174 @@ func (_u *CompressionJobUpdateOne) sqlSave(ctx context.Context) (_node *Compress
 	if _u.mutation.CompletedAtCleared() {
 		_spec.ClearField(compressionjob.FieldCompletedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.Profile(); ok {
+		_spec.SetField(compressionjob.FieldProfile, field.TypeString, value)
+	}
+	if _u.mutation.ProfileCleared() {
+		_spec.ClearField(compressionjob.FieldProfile, field.TypeString)
+	}
+	if value, ok := _u.mutation.TargetFormat(); ok {
+		_spec.SetField(compressionjob.FieldTargetFormat, field.TypeString, value)
+	}
+	if _u.mutation.TargetFormatCleared() {
+		_spec.ClearField(compressionjob.FieldTargetFormat, field.TypeString)
+	}
+	if value, ok := _u.mutation.AudioBitrate(); ok {
+		_spec.SetField(compressionjob.FieldAudioBitrate, field.TypeString, value)
+	}
+	if _u.mutation.AudioBitrateCleared() {
+		_spec.ClearField(compressionjob.FieldAudioBitrate, field.TypeString)
+	}
+	if value, ok := _u.mutation.Normalize(); ok {
+		_spec.SetField(compressionjob.FieldNormalize, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Outcome(); ok {
+		_spec.SetField(compressionjob.FieldOutcome, field.TypeString, value)
+	}
+	if _u.mutation.OutcomeCleared() {
+		_spec.ClearField(compressionjob.FieldOutcome, field.TypeString)
+	}
+	if value, ok := _u.mutation.Steps(); ok {
+		_spec.SetField(compressionjob.FieldSteps, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSteps(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, compressionjob.FieldSteps, value)
+		})
+	}
+	if _u.mutation.StepsCleared() {
+		_spec.ClearField(compressionjob.FieldSteps, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TargetBytes(); ok {
+		_spec.SetField(compressionjob.FieldTargetBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTargetBytes(); ok {
+		_spec.AddField(compressionjob.FieldTargetBytes, field.TypeInt64, value)
+	}
+	if _u.mutation.TargetBytesCleared() {
+		_spec.ClearField(compressionjob.FieldTargetBytes, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.TargetParams(); ok {
+		_spec.SetField(compressionjob.FieldTargetParams, field.TypeJSON, value)
+	}
+	if _u.mutation.TargetParamsCleared() {
+		_spec.ClearField(compressionjob.FieldTargetParams, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Ssim(); ok {
+		_spec.SetField(compressionjob.FieldSsim, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedSsim(); ok {
+		_spec.AddField(compressionjob.FieldSsim, field.TypeFloat64, value)
+	}
+	if _u.mutation.SsimCleared() {
+		_spec.ClearField(compressionjob.FieldSsim, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Psnr(); ok {
+		_spec.SetField(compressionjob.FieldPsnr, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPsnr(); ok {
+		_spec.AddField(compressionjob.FieldPsnr, field.TypeFloat64, value)
+	}
+	if _u.mutation.PsnrCleared() {
+		_spec.ClearField(compressionjob.FieldPsnr, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Vmaf(); ok {
+		_spec.SetField(compressionjob.FieldVmaf, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedVmaf(); ok {
+		_spec.AddField(compressionjob.FieldVmaf, field.TypeFloat64, value)
+	}
+	if _u.mutation.VmafCleared() {
+		_spec.ClearField(compressionjob.FieldVmaf, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.EncoderParams(); ok {
+		_spec.SetField(compressionjob.FieldEncoderParams, field.TypeJSON, value)
+	}
+	if _u.mutation.EncoderParamsCleared() {
+		_spec.ClearField(compressionjob.FieldEncoderParams, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.FfmpegVersion(); ok {
+		_spec.SetField(compressionjob.FieldFfmpegVersion, field.TypeString, value)
+	}
+	if _u.mutation.FfmpegVersionCleared() {
+		_spec.ClearField(compressionjob.FieldFfmpegVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.Commands(); ok {
+		_spec.SetField(compressionjob.FieldCommands, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCommands(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, compressionjob.FieldCommands, value)
+		})
+	}
+	if _u.mutation.CommandsCleared() {
+		_spec.ClearField(compressionjob.FieldCommands, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Log(); ok {
+		_spec.SetField(compressionjob.FieldLog, field.TypeString, value)
+	}
+	if _u.mutation.LogCleared() {
+		_spec.ClearField(compressionjob.FieldLog, field.TypeString)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(compressionjob.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(compressionjob.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Submitter(); ok {
+		_spec.SetField(compressionjob.FieldSubmitter, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Pool(); ok {
+		_spec.SetField(compressionjob.FieldPool, field.TypeString, value)
+	}
+	if _u.mutation.PoolCleared() {
+		_spec.ClearField(compressionjob.FieldPool, field.TypeString)
+	}
+	if value, ok := _u.mutation.WorkerID(); ok {
+		_spec.SetField(compressionjob.FieldWorkerID, field.TypeString, value)
+	}
+	if _u.mutation.WorkerIDCleared() {
+		_spec.ClearField(compressionjob.FieldWorkerID, field.TypeString)
+	}
+	if value, ok := _u.mutation.LeaseExpiresAt(); ok {
+		_spec.SetField(compressionjob.FieldLeaseExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.LeaseExpiresAtCleared() {
+		_spec.ClearField(compressionjob.FieldLeaseExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CancelRequested(); ok {
+		_spec.SetField(compressionjob.FieldCancelRequested, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(compressionjob.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(compressionjob.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxAttempts(); ok {
+		_spec.SetField(compressionjob.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAttempts(); ok {
+		_spec.AddField(compressionjob.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(compressionjob.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextAttemptAtCleared() {
+		_spec.ClearField(compressionjob.FieldNextAttemptAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AttemptErrors(); ok {
+		_spec.SetField(compressionjob.FieldAttemptErrors, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAttemptErrors(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, compressionjob.FieldAttemptErrors, value)
+		})
+	}
+	if _u.mutation.AttemptErrorsCleared() {
+		_spec.ClearField(compressionjob.FieldAttemptErrors, field.TypeJSON)
+	}
 	if _u.mutation.AssetCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
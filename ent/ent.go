@@ -14,7 +14,9 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/adimail/asset-manager/ent/asset"
 	"github.com/adimail/asset-manager/ent/compressionjob"
+	"github.com/adimail/asset-manager/ent/rule"
 	"github.com/adimail/asset-manager/ent/tag"
+	"github.com/adimail/asset-manager/ent/variant"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -77,7 +79,9 @@ func checkColumn(t, c string) error {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			asset.Table:          asset.ValidColumn,
 			compressionjob.Table: compressionjob.ValidColumn,
+			rule.Table:           rule.ValidColumn,
 			tag.Table:            tag.ValidColumn,
+			variant.Table:        variant.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
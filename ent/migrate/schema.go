@@ -14,12 +14,19 @@ var (
 		{Name: "original_filename", Type: field.TypeString},
 		{Name: "file_type", Type: field.TypeString},
 		{Name: "extension", Type: field.TypeString},
+		{Name: "mime_type", Type: field.TypeString, Nullable: true},
 		{Name: "file_size_bytes", Type: field.TypeInt64},
 		{Name: "storage_path", Type: field.TypeString},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "is_compressed", Type: field.TypeBool, Default: false},
+		{Name: "compressed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "original_path", Type: field.TypeString, Nullable: true},
+		{Name: "original_kept_at", Type: field.TypeTime, Nullable: true},
 		{Name: "compression_ratio", Type: field.TypeFloat64, Nullable: true},
+		{Name: "already_optimal", Type: field.TypeBool, Default: false},
+		{Name: "metadata_stripped", Type: field.TypeBool, Default: false},
+		{Name: "has_location", Type: field.TypeBool, Default: false},
+		{Name: "hls_path", Type: field.TypeString, Nullable: true},
 	}
 	// AssetsTable holds the schema information for the "assets" table.
 	AssetsTable = &schema.Table{
@@ -30,11 +37,38 @@ var (
 	// CompressionJobsColumns holds the columns for the "compression_jobs" table.
 	CompressionJobsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeString, Default: "compress"},
 		{Name: "status", Type: field.TypeString, Default: "pending"},
 		{Name: "progress", Type: field.TypeInt, Default: 0},
 		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
 		{Name: "started_at", Type: field.TypeTime, Nullable: true},
 		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "profile", Type: field.TypeString, Nullable: true},
+		{Name: "target_format", Type: field.TypeString, Nullable: true},
+		{Name: "audio_bitrate", Type: field.TypeString, Nullable: true},
+		{Name: "normalize", Type: field.TypeBool, Default: false},
+		{Name: "outcome", Type: field.TypeString, Nullable: true},
+		{Name: "steps", Type: field.TypeJSON, Nullable: true},
+		{Name: "target_bytes", Type: field.TypeInt64, Nullable: true},
+		{Name: "target_params", Type: field.TypeJSON, Nullable: true},
+		{Name: "ssim", Type: field.TypeFloat64, Nullable: true},
+		{Name: "psnr", Type: field.TypeFloat64, Nullable: true},
+		{Name: "vmaf", Type: field.TypeFloat64, Nullable: true},
+		{Name: "encoder_params", Type: field.TypeJSON, Nullable: true},
+		{Name: "ffmpeg_version", Type: field.TypeString, Nullable: true},
+		{Name: "commands", Type: field.TypeJSON, Nullable: true},
+		{Name: "log", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "submitter", Type: field.TypeString, Default: ""},
+		{Name: "pool", Type: field.TypeString, Nullable: true},
+		{Name: "worker_id", Type: field.TypeString, Nullable: true},
+		{Name: "lease_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "cancel_requested", Type: field.TypeBool, Default: false},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "max_attempts", Type: field.TypeInt, Default: 1},
+		{Name: "next_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "attempt_errors", Type: field.TypeJSON, Nullable: true},
 		{Name: "asset_compression_jobs", Type: field.TypeString},
 	}
 	// CompressionJobsTable holds the schema information for the "compression_jobs" table.
@@ -45,11 +79,49 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "compression_jobs_assets_compression_jobs",
-				Columns:    []*schema.Column{CompressionJobsColumns[6]},
+				Columns:    []*schema.Column{CompressionJobsColumns[33]},
 				RefColumns: []*schema.Column{AssetsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "compressionjob_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{CompressionJobsColumns[2], CompressionJobsColumns[5]},
+			},
+			{
+				Name:    "compressionjob_status_pool_priority",
+				Unique:  false,
+				Columns: []*schema.Column{CompressionJobsColumns[2], CompressionJobsColumns[25], CompressionJobsColumns[23]},
+			},
+			{
+				Name:    "compressionjob_asset_compression_jobs",
+				Unique:  false,
+				Columns: []*schema.Column{CompressionJobsColumns[33]},
+			},
+		},
+	}
+	// RulesColumns holds the columns for the "rules" table.
+	RulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "file_type", Type: field.TypeString, Nullable: true},
+		{Name: "extension", Type: field.TypeString, Nullable: true},
+		{Name: "min_size_bytes", Type: field.TypeInt64, Nullable: true},
+		{Name: "tag_id", Type: field.TypeString, Nullable: true},
+		{Name: "kind", Type: field.TypeString, Default: "compress"},
+		{Name: "profile", Type: field.TypeString, Nullable: true},
+		{Name: "fire_count", Type: field.TypeInt, Default: 0},
+		{Name: "last_fired_at", Type: field.TypeTime, Nullable: true},
+	}
+	// RulesTable holds the schema information for the "rules" table.
+	RulesTable = &schema.Table{
+		Name:       "rules",
+		Columns:    RulesColumns,
+		PrimaryKey: []*schema.Column{RulesColumns[0]},
 	}
 	// TagsColumns holds the columns for the "tags" table.
 	TagsColumns = []*schema.Column{
@@ -64,6 +136,31 @@ var (
 		Columns:    TagsColumns,
 		PrimaryKey: []*schema.Column{TagsColumns[0]},
 	}
+	// VariantsColumns holds the columns for the "variants" table.
+	VariantsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "extension", Type: field.TypeString},
+		{Name: "mime_type", Type: field.TypeString, Nullable: true},
+		{Name: "file_size_bytes", Type: field.TypeInt64},
+		{Name: "storage_path", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "asset_variants", Type: field.TypeString},
+	}
+	// VariantsTable holds the schema information for the "variants" table.
+	VariantsTable = &schema.Table{
+		Name:       "variants",
+		Columns:    VariantsColumns,
+		PrimaryKey: []*schema.Column{VariantsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "variants_assets_variants",
+				Columns:    []*schema.Column{VariantsColumns[7]},
+				RefColumns: []*schema.Column{AssetsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// AssetTagsColumns holds the columns for the "asset_tags" table.
 	AssetTagsColumns = []*schema.Column{
 		{Name: "asset_id", Type: field.TypeString},
@@ -93,13 +190,16 @@ var (
 	Tables = []*schema.Table{
 		AssetsTable,
 		CompressionJobsTable,
+		RulesTable,
 		TagsTable,
+		VariantsTable,
 		AssetTagsTable,
 	}
 )
 
 func init() {
 	CompressionJobsTable.ForeignKeys[0].RefTable = AssetsTable
+	VariantsTable.ForeignKeys[0].RefTable = AssetsTable
 	AssetTagsTable.ForeignKeys[0].RefTable = AssetsTable
 	AssetTagsTable.ForeignKeys[1].RefTable = TagsTable
 }
@@ -14,7 +14,10 @@ import (
 	"github.com/adimail/asset-manager/ent/asset"
 	"github.com/adimail/asset-manager/ent/compressionjob"
 	"github.com/adimail/asset-manager/ent/predicate"
+	"github.com/adimail/asset-manager/ent/rule"
+	"github.com/adimail/asset-manager/ent/schema"
 	"github.com/adimail/asset-manager/ent/tag"
+	"github.com/adimail/asset-manager/ent/variant"
 )
 
 const (
@@ -28,7 +31,9 @@ const (
 	// Node types.
 	TypeAsset          = "Asset"
 	TypeCompressionJob = "CompressionJob"
+	TypeRule           = "Rule"
 	TypeTag            = "Tag"
+	TypeVariant        = "Variant"
 )
 
 // AssetMutation represents an operation that mutates the Asset nodes in the graph.
@@ -40,14 +45,21 @@ type AssetMutation struct {
 	original_filename       *string
 	file_type               *string
 	extension               *string
+	mime_type               *string
 	file_size_bytes         *int64
 	addfile_size_bytes      *int64
 	storage_path            *string
 	created_at              *time.Time
 	is_compressed           *bool
+	compressed_at           *time.Time
 	original_path           *string
+	original_kept_at        *time.Time
 	compression_ratio       *float64
 	addcompression_ratio    *float64
+	already_optimal         *bool
+	metadata_stripped       *bool
+	has_location            *bool
+	hls_path                *string
 	clearedFields           map[string]struct{}
 	tags                    map[string]struct{}
 	removedtags             map[string]struct{}
@@ -55,6 +67,9 @@ type AssetMutation struct {
 	compression_jobs        map[string]struct{}
 	removedcompression_jobs map[string]struct{}
 	clearedcompression_jobs bool
+	variants                map[string]struct{}
+	removedvariants         map[string]struct{}
+	clearedvariants         bool
 	done                    bool
 	oldValue                func(context.Context) (*Asset, error)
 	predicates              []predicate.Asset
@@ -272,6 +287,55 @@ func (m *AssetMutation) ResetExtension() {
 	m.extension = nil
 }
 
+// SetMimeType sets the "mime_type" field.
+func (m *AssetMutation) SetMimeType(s string) {
+	m.mime_type = &s
+}
+
+// MimeType returns the value of the "mime_type" field in the mutation.
+func (m *AssetMutation) MimeType() (r string, exists bool) {
+	v := m.mime_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMimeType returns the old "mime_type" field's value of the Asset entity.
+// If the Asset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AssetMutation) OldMimeType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMimeType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMimeType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMimeType: %w", err)
+	}
+	return oldValue.MimeType, nil
+}
+
+// ClearMimeType clears the value of the "mime_type" field.
+func (m *AssetMutation) ClearMimeType() {
+	m.mime_type = nil
+	m.clearedFields[asset.FieldMimeType] = struct{}{}
+}
+
+// MimeTypeCleared returns if the "mime_type" field was cleared in this mutation.
+func (m *AssetMutation) MimeTypeCleared() bool {
+	_, ok := m.clearedFields[asset.FieldMimeType]
+	return ok
+}
+
+// ResetMimeType resets all changes to the "mime_type" field.
+func (m *AssetMutation) ResetMimeType() {
+	m.mime_type = nil
+	delete(m.clearedFields, asset.FieldMimeType)
+}
+
 // SetFileSizeBytes sets the "file_size_bytes" field.
 func (m *AssetMutation) SetFileSizeBytes(i int64) {
 	m.file_size_bytes = &i
@@ -436,6 +500,55 @@ func (m *AssetMutation) ResetIsCompressed() {
 	m.is_compressed = nil
 }
 
+// SetCompressedAt sets the "compressed_at" field.
+func (m *AssetMutation) SetCompressedAt(t time.Time) {
+	m.compressed_at = &t
+}
+
+// CompressedAt returns the value of the "compressed_at" field in the mutation.
+func (m *AssetMutation) CompressedAt() (r time.Time, exists bool) {
+	v := m.compressed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompressedAt returns the old "compressed_at" field's value of the Asset entity.
+// If the Asset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AssetMutation) OldCompressedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompressedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompressedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompressedAt: %w", err)
+	}
+	return oldValue.CompressedAt, nil
+}
+
+// ClearCompressedAt clears the value of the "compressed_at" field.
+func (m *AssetMutation) ClearCompressedAt() {
+	m.compressed_at = nil
+	m.clearedFields[asset.FieldCompressedAt] = struct{}{}
+}
+
+// CompressedAtCleared returns if the "compressed_at" field was cleared in this mutation.
+func (m *AssetMutation) CompressedAtCleared() bool {
+	_, ok := m.clearedFields[asset.FieldCompressedAt]
+	return ok
+}
+
+// ResetCompressedAt resets all changes to the "compressed_at" field.
+func (m *AssetMutation) ResetCompressedAt() {
+	m.compressed_at = nil
+	delete(m.clearedFields, asset.FieldCompressedAt)
+}
+
 // SetOriginalPath sets the "original_path" field.
 func (m *AssetMutation) SetOriginalPath(s string) {
 	m.original_path = &s
@@ -485,6 +598,55 @@ func (m *AssetMutation) ResetOriginalPath() {
 	delete(m.clearedFields, asset.FieldOriginalPath)
 }
 
+// SetOriginalKeptAt sets the "original_kept_at" field.
+func (m *AssetMutation) SetOriginalKeptAt(t time.Time) {
+	m.original_kept_at = &t
+}
+
+// OriginalKeptAt returns the value of the "original_kept_at" field in the mutation.
+func (m *AssetMutation) OriginalKeptAt() (r time.Time, exists bool) {
+	v := m.original_kept_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOriginalKeptAt returns the old "original_kept_at" field's value of the Asset entity.
+// If the Asset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AssetMutation) OldOriginalKeptAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOriginalKeptAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOriginalKeptAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOriginalKeptAt: %w", err)
+	}
+	return oldValue.OriginalKeptAt, nil
+}
+
+// ClearOriginalKeptAt clears the value of the "original_kept_at" field.
+func (m *AssetMutation) ClearOriginalKeptAt() {
+	m.original_kept_at = nil
+	m.clearedFields[asset.FieldOriginalKeptAt] = struct{}{}
+}
+
+// OriginalKeptAtCleared returns if the "original_kept_at" field was cleared in this mutation.
+func (m *AssetMutation) OriginalKeptAtCleared() bool {
+	_, ok := m.clearedFields[asset.FieldOriginalKeptAt]
+	return ok
+}
+
+// ResetOriginalKeptAt resets all changes to the "original_kept_at" field.
+func (m *AssetMutation) ResetOriginalKeptAt() {
+	m.original_kept_at = nil
+	delete(m.clearedFields, asset.FieldOriginalKeptAt)
+}
+
 // SetCompressionRatio sets the "compression_ratio" field.
 func (m *AssetMutation) SetCompressionRatio(f float64) {
 	m.compression_ratio = &f
@@ -555,6 +717,163 @@ func (m *AssetMutation) ResetCompressionRatio() {
 	delete(m.clearedFields, asset.FieldCompressionRatio)
 }
 
+// SetAlreadyOptimal sets the "already_optimal" field.
+func (m *AssetMutation) SetAlreadyOptimal(b bool) {
+	m.already_optimal = &b
+}
+
+// AlreadyOptimal returns the value of the "already_optimal" field in the mutation.
+func (m *AssetMutation) AlreadyOptimal() (r bool, exists bool) {
+	v := m.already_optimal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlreadyOptimal returns the old "already_optimal" field's value of the Asset entity.
+// If the Asset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AssetMutation) OldAlreadyOptimal(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlreadyOptimal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlreadyOptimal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlreadyOptimal: %w", err)
+	}
+	return oldValue.AlreadyOptimal, nil
+}
+
+// ResetAlreadyOptimal resets all changes to the "already_optimal" field.
+func (m *AssetMutation) ResetAlreadyOptimal() {
+	m.already_optimal = nil
+}
+
+// SetMetadataStripped sets the "metadata_stripped" field.
+func (m *AssetMutation) SetMetadataStripped(b bool) {
+	m.metadata_stripped = &b
+}
+
+// MetadataStripped returns the value of the "metadata_stripped" field in the mutation.
+func (m *AssetMutation) MetadataStripped() (r bool, exists bool) {
+	v := m.metadata_stripped
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadataStripped returns the old "metadata_stripped" field's value of the Asset entity.
+// If the Asset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AssetMutation) OldMetadataStripped(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadataStripped is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadataStripped requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadataStripped: %w", err)
+	}
+	return oldValue.MetadataStripped, nil
+}
+
+// ResetMetadataStripped resets all changes to the "metadata_stripped" field.
+func (m *AssetMutation) ResetMetadataStripped() {
+	m.metadata_stripped = nil
+}
+
+// SetHasLocation sets the "has_location" field.
+func (m *AssetMutation) SetHasLocation(b bool) {
+	m.has_location = &b
+}
+
+// HasLocation returns the value of the "has_location" field in the mutation.
+func (m *AssetMutation) HasLocation() (r bool, exists bool) {
+	v := m.has_location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHasLocation returns the old "has_location" field's value of the Asset entity.
+// If the Asset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AssetMutation) OldHasLocation(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHasLocation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHasLocation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHasLocation: %w", err)
+	}
+	return oldValue.HasLocation, nil
+}
+
+// ResetHasLocation resets all changes to the "has_location" field.
+func (m *AssetMutation) ResetHasLocation() {
+	m.has_location = nil
+}
+
+// SetHlsPath sets the "hls_path" field.
+func (m *AssetMutation) SetHlsPath(s string) {
+	m.hls_path = &s
+}
+
+// HlsPath returns the value of the "hls_path" field in the mutation.
+func (m *AssetMutation) HlsPath() (r string, exists bool) {
+	v := m.hls_path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHlsPath returns the old "hls_path" field's value of the Asset entity.
+// If the Asset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AssetMutation) OldHlsPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHlsPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHlsPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHlsPath: %w", err)
+	}
+	return oldValue.HlsPath, nil
+}
+
+// ClearHlsPath clears the value of the "hls_path" field.
+func (m *AssetMutation) ClearHlsPath() {
+	m.hls_path = nil
+	m.clearedFields[asset.FieldHlsPath] = struct{}{}
+}
+
+// HlsPathCleared returns if the "hls_path" field was cleared in this mutation.
+func (m *AssetMutation) HlsPathCleared() bool {
+	_, ok := m.clearedFields[asset.FieldHlsPath]
+	return ok
+}
+
+// ResetHlsPath resets all changes to the "hls_path" field.
+func (m *AssetMutation) ResetHlsPath() {
+	m.hls_path = nil
+	delete(m.clearedFields, asset.FieldHlsPath)
+}
+
 // AddTagIDs adds the "tags" edge to the Tag entity by ids.
 func (m *AssetMutation) AddTagIDs(ids ...string) {
 	if m.tags == nil {
@@ -663,6 +982,60 @@ func (m *AssetMutation) ResetCompressionJobs() {
 	m.removedcompression_jobs = nil
 }
 
+// AddVariantIDs adds the "variants" edge to the Variant entity by ids.
+func (m *AssetMutation) AddVariantIDs(ids ...string) {
+	if m.variants == nil {
+		m.variants = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.variants[ids[i]] = struct{}{}
+	}
+}
+
+// ClearVariants clears the "variants" edge to the Variant entity.
+func (m *AssetMutation) ClearVariants() {
+	m.clearedvariants = true
+}
+
+// VariantsCleared reports if the "variants" edge to the Variant entity was cleared.
+func (m *AssetMutation) VariantsCleared() bool {
+	return m.clearedvariants
+}
+
+// RemoveVariantIDs removes the "variants" edge to the Variant entity by IDs.
+func (m *AssetMutation) RemoveVariantIDs(ids ...string) {
+	if m.removedvariants == nil {
+		m.removedvariants = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.variants, ids[i])
+		m.removedvariants[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedVariants returns the removed IDs of the "variants" edge to the Variant entity.
+func (m *AssetMutation) RemovedVariantsIDs() (ids []string) {
+	for id := range m.removedvariants {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// VariantsIDs returns the "variants" edge IDs in the mutation.
+func (m *AssetMutation) VariantsIDs() (ids []string) {
+	for id := range m.variants {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetVariants resets all changes to the "variants" edge.
+func (m *AssetMutation) ResetVariants() {
+	m.variants = nil
+	m.clearedvariants = false
+	m.removedvariants = nil
+}
+
 // Where appends a list predicates to the AssetMutation builder.
 func (m *AssetMutation) Where(ps ...predicate.Asset) {
 	m.predicates = append(m.predicates, ps...)
@@ -697,7 +1070,7 @@ func (m *AssetMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *AssetMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+	fields := make([]string, 0, 16)
 	if m.original_filename != nil {
 		fields = append(fields, asset.FieldOriginalFilename)
 	}
@@ -707,6 +1080,9 @@ func (m *AssetMutation) Fields() []string {
 	if m.extension != nil {
 		fields = append(fields, asset.FieldExtension)
 	}
+	if m.mime_type != nil {
+		fields = append(fields, asset.FieldMimeType)
+	}
 	if m.file_size_bytes != nil {
 		fields = append(fields, asset.FieldFileSizeBytes)
 	}
@@ -719,12 +1095,30 @@ func (m *AssetMutation) Fields() []string {
 	if m.is_compressed != nil {
 		fields = append(fields, asset.FieldIsCompressed)
 	}
+	if m.compressed_at != nil {
+		fields = append(fields, asset.FieldCompressedAt)
+	}
 	if m.original_path != nil {
 		fields = append(fields, asset.FieldOriginalPath)
 	}
+	if m.original_kept_at != nil {
+		fields = append(fields, asset.FieldOriginalKeptAt)
+	}
 	if m.compression_ratio != nil {
 		fields = append(fields, asset.FieldCompressionRatio)
 	}
+	if m.already_optimal != nil {
+		fields = append(fields, asset.FieldAlreadyOptimal)
+	}
+	if m.metadata_stripped != nil {
+		fields = append(fields, asset.FieldMetadataStripped)
+	}
+	if m.has_location != nil {
+		fields = append(fields, asset.FieldHasLocation)
+	}
+	if m.hls_path != nil {
+		fields = append(fields, asset.FieldHlsPath)
+	}
 	return fields
 }
 
@@ -739,6 +1133,8 @@ func (m *AssetMutation) Field(name string) (ent.Value, bool) {
 		return m.FileType()
 	case asset.FieldExtension:
 		return m.Extension()
+	case asset.FieldMimeType:
+		return m.MimeType()
 	case asset.FieldFileSizeBytes:
 		return m.FileSizeBytes()
 	case asset.FieldStoragePath:
@@ -747,10 +1143,22 @@ func (m *AssetMutation) Field(name string) (ent.Value, bool) {
 		return m.CreatedAt()
 	case asset.FieldIsCompressed:
 		return m.IsCompressed()
+	case asset.FieldCompressedAt:
+		return m.CompressedAt()
 	case asset.FieldOriginalPath:
 		return m.OriginalPath()
+	case asset.FieldOriginalKeptAt:
+		return m.OriginalKeptAt()
 	case asset.FieldCompressionRatio:
 		return m.CompressionRatio()
+	case asset.FieldAlreadyOptimal:
+		return m.AlreadyOptimal()
+	case asset.FieldMetadataStripped:
+		return m.MetadataStripped()
+	case asset.FieldHasLocation:
+		return m.HasLocation()
+	case asset.FieldHlsPath:
+		return m.HlsPath()
 	}
 	return nil, false
 }
@@ -766,6 +1174,8 @@ func (m *AssetMutation) OldField(ctx context.Context, name string) (ent.Value, e
 		return m.OldFileType(ctx)
 	case asset.FieldExtension:
 		return m.OldExtension(ctx)
+	case asset.FieldMimeType:
+		return m.OldMimeType(ctx)
 	case asset.FieldFileSizeBytes:
 		return m.OldFileSizeBytes(ctx)
 	case asset.FieldStoragePath:
@@ -774,10 +1184,22 @@ func (m *AssetMutation) OldField(ctx context.Context, name string) (ent.Value, e
 		return m.OldCreatedAt(ctx)
 	case asset.FieldIsCompressed:
 		return m.OldIsCompressed(ctx)
+	case asset.FieldCompressedAt:
+		return m.OldCompressedAt(ctx)
 	case asset.FieldOriginalPath:
 		return m.OldOriginalPath(ctx)
+	case asset.FieldOriginalKeptAt:
+		return m.OldOriginalKeptAt(ctx)
 	case asset.FieldCompressionRatio:
 		return m.OldCompressionRatio(ctx)
+	case asset.FieldAlreadyOptimal:
+		return m.OldAlreadyOptimal(ctx)
+	case asset.FieldMetadataStripped:
+		return m.OldMetadataStripped(ctx)
+	case asset.FieldHasLocation:
+		return m.OldHasLocation(ctx)
+	case asset.FieldHlsPath:
+		return m.OldHlsPath(ctx)
 	}
 	return nil, fmt.Errorf("unknown Asset field %s", name)
 }
@@ -808,6 +1230,13 @@ func (m *AssetMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetExtension(v)
 		return nil
+	case asset.FieldMimeType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMimeType(v)
+		return nil
 	case asset.FieldFileSizeBytes:
 		v, ok := value.(int64)
 		if !ok {
@@ -836,6 +1265,13 @@ func (m *AssetMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetIsCompressed(v)
 		return nil
+	case asset.FieldCompressedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompressedAt(v)
+		return nil
 	case asset.FieldOriginalPath:
 		v, ok := value.(string)
 		if !ok {
@@ -843,6 +1279,13 @@ func (m *AssetMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetOriginalPath(v)
 		return nil
+	case asset.FieldOriginalKeptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOriginalKeptAt(v)
+		return nil
 	case asset.FieldCompressionRatio:
 		v, ok := value.(float64)
 		if !ok {
@@ -850,6 +1293,34 @@ func (m *AssetMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetCompressionRatio(v)
 		return nil
+	case asset.FieldAlreadyOptimal:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlreadyOptimal(v)
+		return nil
+	case asset.FieldMetadataStripped:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadataStripped(v)
+		return nil
+	case asset.FieldHasLocation:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHasLocation(v)
+		return nil
+	case asset.FieldHlsPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHlsPath(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Asset field %s", name)
 }
@@ -907,12 +1378,24 @@ func (m *AssetMutation) AddField(name string, value ent.Value) error {
 // mutation.
 func (m *AssetMutation) ClearedFields() []string {
 	var fields []string
+	if m.FieldCleared(asset.FieldMimeType) {
+		fields = append(fields, asset.FieldMimeType)
+	}
+	if m.FieldCleared(asset.FieldCompressedAt) {
+		fields = append(fields, asset.FieldCompressedAt)
+	}
 	if m.FieldCleared(asset.FieldOriginalPath) {
 		fields = append(fields, asset.FieldOriginalPath)
 	}
+	if m.FieldCleared(asset.FieldOriginalKeptAt) {
+		fields = append(fields, asset.FieldOriginalKeptAt)
+	}
 	if m.FieldCleared(asset.FieldCompressionRatio) {
 		fields = append(fields, asset.FieldCompressionRatio)
 	}
+	if m.FieldCleared(asset.FieldHlsPath) {
+		fields = append(fields, asset.FieldHlsPath)
+	}
 	return fields
 }
 
@@ -927,12 +1410,24 @@ func (m *AssetMutation) FieldCleared(name string) bool {
 // error if the field is not defined in the schema.
 func (m *AssetMutation) ClearField(name string) error {
 	switch name {
+	case asset.FieldMimeType:
+		m.ClearMimeType()
+		return nil
+	case asset.FieldCompressedAt:
+		m.ClearCompressedAt()
+		return nil
 	case asset.FieldOriginalPath:
 		m.ClearOriginalPath()
 		return nil
+	case asset.FieldOriginalKeptAt:
+		m.ClearOriginalKeptAt()
+		return nil
 	case asset.FieldCompressionRatio:
 		m.ClearCompressionRatio()
 		return nil
+	case asset.FieldHlsPath:
+		m.ClearHlsPath()
+		return nil
 	}
 	return fmt.Errorf("unknown Asset nullable field %s", name)
 }
@@ -950,6 +1445,9 @@ func (m *AssetMutation) ResetField(name string) error {
 	case asset.FieldExtension:
 		m.ResetExtension()
 		return nil
+	case asset.FieldMimeType:
+		m.ResetMimeType()
+		return nil
 	case asset.FieldFileSizeBytes:
 		m.ResetFileSizeBytes()
 		return nil
@@ -962,25 +1460,46 @@ func (m *AssetMutation) ResetField(name string) error {
 	case asset.FieldIsCompressed:
 		m.ResetIsCompressed()
 		return nil
+	case asset.FieldCompressedAt:
+		m.ResetCompressedAt()
+		return nil
 	case asset.FieldOriginalPath:
 		m.ResetOriginalPath()
 		return nil
+	case asset.FieldOriginalKeptAt:
+		m.ResetOriginalKeptAt()
+		return nil
 	case asset.FieldCompressionRatio:
 		m.ResetCompressionRatio()
 		return nil
+	case asset.FieldAlreadyOptimal:
+		m.ResetAlreadyOptimal()
+		return nil
+	case asset.FieldMetadataStripped:
+		m.ResetMetadataStripped()
+		return nil
+	case asset.FieldHasLocation:
+		m.ResetHasLocation()
+		return nil
+	case asset.FieldHlsPath:
+		m.ResetHlsPath()
+		return nil
 	}
 	return fmt.Errorf("unknown Asset field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *AssetMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.tags != nil {
 		edges = append(edges, asset.EdgeTags)
 	}
 	if m.compression_jobs != nil {
 		edges = append(edges, asset.EdgeCompressionJobs)
 	}
+	if m.variants != nil {
+		edges = append(edges, asset.EdgeVariants)
+	}
 	return edges
 }
 
@@ -1000,19 +1519,28 @@ func (m *AssetMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case asset.EdgeVariants:
+		ids := make([]ent.Value, 0, len(m.variants))
+		for id := range m.variants {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *AssetMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.removedtags != nil {
 		edges = append(edges, asset.EdgeTags)
 	}
 	if m.removedcompression_jobs != nil {
 		edges = append(edges, asset.EdgeCompressionJobs)
 	}
+	if m.removedvariants != nil {
+		edges = append(edges, asset.EdgeVariants)
+	}
 	return edges
 }
 
@@ -1032,19 +1560,28 @@ func (m *AssetMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
-	}
+	case asset.EdgeVariants:
+		ids := make([]ent.Value, 0, len(m.removedvariants))
+		for id := range m.removedvariants {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *AssetMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.clearedtags {
 		edges = append(edges, asset.EdgeTags)
 	}
 	if m.clearedcompression_jobs {
 		edges = append(edges, asset.EdgeCompressionJobs)
 	}
+	if m.clearedvariants {
+		edges = append(edges, asset.EdgeVariants)
+	}
 	return edges
 }
 
@@ -1056,6 +1593,8 @@ func (m *AssetMutation) EdgeCleared(name string) bool {
 		return m.clearedtags
 	case asset.EdgeCompressionJobs:
 		return m.clearedcompression_jobs
+	case asset.EdgeVariants:
+		return m.clearedvariants
 	}
 	return false
 }
@@ -1078,6 +1617,9 @@ func (m *AssetMutation) ResetEdge(name string) error {
 	case asset.EdgeCompressionJobs:
 		m.ResetCompressionJobs()
 		return nil
+	case asset.EdgeVariants:
+		m.ResetVariants()
+		return nil
 	}
 	return fmt.Errorf("unknown Asset edge %s", name)
 }
@@ -1085,21 +1627,58 @@ func (m *AssetMutation) ResetEdge(name string) error {
 // CompressionJobMutation represents an operation that mutates the CompressionJob nodes in the graph.
 type CompressionJobMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *string
-	status        *string
-	progress      *int
-	addprogress   *int
-	error         *string
-	started_at    *time.Time
-	completed_at  *time.Time
-	clearedFields map[string]struct{}
-	asset         *string
-	clearedasset  bool
-	done          bool
-	oldValue      func(context.Context) (*CompressionJob, error)
-	predicates    []predicate.CompressionJob
+	op                   Op
+	typ                  string
+	id                   *string
+	kind                 *string
+	status               *string
+	progress             *int
+	addprogress          *int
+	error                *string
+	created_at           *time.Time
+	started_at           *time.Time
+	completed_at         *time.Time
+	profile              *string
+	target_format        *string
+	audio_bitrate        *string
+	normalize            *bool
+	outcome              *string
+	steps                *[]schema.PipelineStep
+	appendsteps          []schema.PipelineStep
+	target_bytes         *int64
+	addtarget_bytes      *int64
+	target_params        *schema.TargetParams
+	ssim                 *float64
+	addssim              *float64
+	psnr                 *float64
+	addpsnr              *float64
+	vmaf                 *float64
+	addvmaf              *float64
+	encoder_params       *schema.EncoderParams
+	ffmpeg_version       *string
+	commands             *[]string
+	appendcommands       []string
+	log                  *string
+	priority             *int
+	addpriority          *int
+	submitter            *string
+	pool                 *string
+	worker_id            *string
+	lease_expires_at     *time.Time
+	cancel_requested     *bool
+	attempts             *int
+	addattempts          *int
+	max_attempts         *int
+	addmax_attempts      *int
+	next_attempt_at      *time.Time
+	attempt_errors       *[]string
+	appendattempt_errors []string
+	clearedFields        map[string]struct{}
+	asset                *string
+	clearedasset         bool
+	done                 bool
+	oldValue             func(context.Context) (*CompressionJob, error)
+	predicates           []predicate.CompressionJob
 }
 
 var _ ent.Mutation = (*CompressionJobMutation)(nil)
@@ -1206,6 +1785,42 @@ func (m *CompressionJobMutation) IDs(ctx context.Context) ([]string, error) {
 	}
 }
 
+// SetKind sets the "kind" field.
+func (m *CompressionJobMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *CompressionJobMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the CompressionJob entity.
+// If the CompressionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompressionJobMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *CompressionJobMutation) ResetKind() {
+	m.kind = nil
+}
+
 // SetStatus sets the "status" field.
 func (m *CompressionJobMutation) SetStatus(s string) {
 	m.status = &s
@@ -1347,6 +1962,55 @@ func (m *CompressionJobMutation) ResetError() {
 	delete(m.clearedFields, compressionjob.FieldError)
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (m *CompressionJobMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CompressionJobMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the CompressionJob entity.
+// If the CompressionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompressionJobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *CompressionJobMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[compressionjob.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *CompressionJobMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[compressionjob.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CompressionJobMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, compressionjob.FieldCreatedAt)
+}
+
 // SetStartedAt sets the "started_at" field.
 func (m *CompressionJobMutation) SetStartedAt(t time.Time) {
 	m.started_at = &t
@@ -1445,383 +2109,3980 @@ func (m *CompressionJobMutation) ResetCompletedAt() {
 	delete(m.clearedFields, compressionjob.FieldCompletedAt)
 }
 
-// SetAssetID sets the "asset" edge to the Asset entity by id.
-func (m *CompressionJobMutation) SetAssetID(id string) {
-	m.asset = &id
+// SetProfile sets the "profile" field.
+func (m *CompressionJobMutation) SetProfile(s string) {
+	m.profile = &s
 }
 
-// ClearAsset clears the "asset" edge to the Asset entity.
-func (m *CompressionJobMutation) ClearAsset() {
-	m.clearedasset = true
+// Profile returns the value of the "profile" field in the mutation.
+func (m *CompressionJobMutation) Profile() (r string, exists bool) {
+	v := m.profile
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AssetCleared reports if the "asset" edge to the Asset entity was cleared.
-func (m *CompressionJobMutation) AssetCleared() bool {
-	return m.clearedasset
+// OldProfile returns the old "profile" field's value of the CompressionJob entity.
+// If the CompressionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompressionJobMutation) OldProfile(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProfile is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProfile requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProfile: %w", err)
+	}
+	return oldValue.Profile, nil
 }
 
-// AssetID returns the "asset" edge ID in the mutation.
-func (m *CompressionJobMutation) AssetID() (id string, exists bool) {
-	if m.asset != nil {
-		return *m.asset, true
+// ClearProfile clears the value of the "profile" field.
+func (m *CompressionJobMutation) ClearProfile() {
+	m.profile = nil
+	m.clearedFields[compressionjob.FieldProfile] = struct{}{}
+}
+
+// ProfileCleared returns if the "profile" field was cleared in this mutation.
+func (m *CompressionJobMutation) ProfileCleared() bool {
+	_, ok := m.clearedFields[compressionjob.FieldProfile]
+	return ok
+}
+
+// ResetProfile resets all changes to the "profile" field.
+func (m *CompressionJobMutation) ResetProfile() {
+	m.profile = nil
+	delete(m.clearedFields, compressionjob.FieldProfile)
+}
+
+// SetTargetFormat sets the "target_format" field.
+func (m *CompressionJobMutation) SetTargetFormat(s string) {
+	m.target_format = &s
+}
+
+// TargetFormat returns the value of the "target_format" field in the mutation.
+func (m *CompressionJobMutation) TargetFormat() (r string, exists bool) {
+	v := m.target_format
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// AssetIDs returns the "asset" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// AssetID instead. It exists only for internal usage by the builders.
-func (m *CompressionJobMutation) AssetIDs() (ids []string) {
-	if id := m.asset; id != nil {
-		ids = append(ids, *id)
+// OldTargetFormat returns the old "target_format" field's value of the CompressionJob entity.
+// If the CompressionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompressionJobMutation) OldTargetFormat(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetFormat is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetFormat: %w", err)
+	}
+	return oldValue.TargetFormat, nil
 }
 
-// ResetAsset resets all changes to the "asset" edge.
-func (m *CompressionJobMutation) ResetAsset() {
-	m.asset = nil
-	m.clearedasset = false
+// ClearTargetFormat clears the value of the "target_format" field.
+func (m *CompressionJobMutation) ClearTargetFormat() {
+	m.target_format = nil
+	m.clearedFields[compressionjob.FieldTargetFormat] = struct{}{}
 }
 
-// Where appends a list predicates to the CompressionJobMutation builder.
-func (m *CompressionJobMutation) Where(ps ...predicate.CompressionJob) {
-	m.predicates = append(m.predicates, ps...)
+// TargetFormatCleared returns if the "target_format" field was cleared in this mutation.
+func (m *CompressionJobMutation) TargetFormatCleared() bool {
+	_, ok := m.clearedFields[compressionjob.FieldTargetFormat]
+	return ok
 }
 
-// WhereP appends storage-level predicates to the CompressionJobMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CompressionJobMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.CompressionJob, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// ResetTargetFormat resets all changes to the "target_format" field.
+func (m *CompressionJobMutation) ResetTargetFormat() {
+	m.target_format = nil
+	delete(m.clearedFields, compressionjob.FieldTargetFormat)
+}
+
+// SetAudioBitrate sets the "audio_bitrate" field.
+func (m *CompressionJobMutation) SetAudioBitrate(s string) {
+	m.audio_bitrate = &s
+}
+
+// AudioBitrate returns the value of the "audio_bitrate" field in the mutation.
+func (m *CompressionJobMutation) AudioBitrate() (r string, exists bool) {
+	v := m.audio_bitrate
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *CompressionJobMutation) Op() Op {
-	return m.op
+// OldAudioBitrate returns the old "audio_bitrate" field's value of the CompressionJob entity.
+// If the CompressionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompressionJobMutation) OldAudioBitrate(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAudioBitrate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAudioBitrate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAudioBitrate: %w", err)
+	}
+	return oldValue.AudioBitrate, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *CompressionJobMutation) SetOp(op Op) {
-	m.op = op
+// ClearAudioBitrate clears the value of the "audio_bitrate" field.
+func (m *CompressionJobMutation) ClearAudioBitrate() {
+	m.audio_bitrate = nil
+	m.clearedFields[compressionjob.FieldAudioBitrate] = struct{}{}
 }
 
-// Type returns the node type of this mutation (CompressionJob).
-func (m *CompressionJobMutation) Type() string {
-	return m.typ
+// AudioBitrateCleared returns if the "audio_bitrate" field was cleared in this mutation.
+func (m *CompressionJobMutation) AudioBitrateCleared() bool {
+	_, ok := m.clearedFields[compressionjob.FieldAudioBitrate]
+	return ok
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *CompressionJobMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.status != nil {
-		fields = append(fields, compressionjob.FieldStatus)
+// ResetAudioBitrate resets all changes to the "audio_bitrate" field.
+func (m *CompressionJobMutation) ResetAudioBitrate() {
+	m.audio_bitrate = nil
+	delete(m.clearedFields, compressionjob.FieldAudioBitrate)
+}
+
+// SetNormalize sets the "normalize" field.
+func (m *CompressionJobMutation) SetNormalize(b bool) {
+	m.normalize = &b
+}
+
+// Normalize returns the value of the "normalize" field in the mutation.
+func (m *CompressionJobMutation) Normalize() (r bool, exists bool) {
+	v := m.normalize
+	if v == nil {
+		return
 	}
-	if m.progress != nil {
-		fields = append(fields, compressionjob.FieldProgress)
+	return *v, true
+}
+
+// OldNormalize returns the old "normalize" field's value of the CompressionJob entity.
+// If the CompressionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompressionJobMutation) OldNormalize(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNormalize is only allowed on UpdateOne operations")
 	}
-	if m.error != nil {
-		fields = append(fields, compressionjob.FieldError)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNormalize requires an ID field in the mutation")
 	}
-	if m.started_at != nil {
-		fields = append(fields, compressionjob.FieldStartedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNormalize: %w", err)
 	}
-	if m.completed_at != nil {
-		fields = append(fields, compressionjob.FieldCompletedAt)
+	return oldValue.Normalize, nil
+}
+
+// ResetNormalize resets all changes to the "normalize" field.
+func (m *CompressionJobMutation) ResetNormalize() {
+	m.normalize = nil
+}
+
+// SetOutcome sets the "outcome" field.
+func (m *CompressionJobMutation) SetOutcome(s string) {
+	m.outcome = &s
+}
+
+// Outcome returns the value of the "outcome" field in the mutation.
+func (m *CompressionJobMutation) Outcome() (r string, exists bool) {
+	v := m.outcome
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *CompressionJobMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case compressionjob.FieldStatus:
-		return m.Status()
-	case compressionjob.FieldProgress:
-		return m.Progress()
-	case compressionjob.FieldError:
-		return m.Error()
-	case compressionjob.FieldStartedAt:
-		return m.StartedAt()
-	case compressionjob.FieldCompletedAt:
-		return m.CompletedAt()
+// OldOutcome returns the old "outcome" field's value of the CompressionJob entity.
+// If the CompressionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompressionJobMutation) OldOutcome(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOutcome is only allowed on UpdateOne operations")
 	}
-	return nil, false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOutcome requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOutcome: %w", err)
+	}
+	return oldValue.Outcome, nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *CompressionJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case compressionjob.FieldStatus:
-		return m.OldStatus(ctx)
-	case compressionjob.FieldProgress:
-		return m.OldProgress(ctx)
-	case compressionjob.FieldError:
-		return m.OldError(ctx)
-	case compressionjob.FieldStartedAt:
-		return m.OldStartedAt(ctx)
-	case compressionjob.FieldCompletedAt:
-		return m.OldCompletedAt(ctx)
+// ClearOutcome clears the value of the "outcome" field.
+func (m *CompressionJobMutation) ClearOutcome() {
+	m.outcome = nil
+	m.clearedFields[compressionjob.FieldOutcome] = struct{}{}
+}
+
+// OutcomeCleared returns if the "outcome" field was cleared in this mutation.
+func (m *CompressionJobMutation) OutcomeCleared() bool {
+	_, ok := m.clearedFields[compressionjob.FieldOutcome]
+	return ok
+}
+
+// ResetOutcome resets all changes to the "outcome" field.
+func (m *CompressionJobMutation) ResetOutcome() {
+	m.outcome = nil
+	delete(m.clearedFields, compressionjob.FieldOutcome)
+}
+
+// SetSteps sets the "steps" field.
+func (m *CompressionJobMutation) SetSteps(ss []schema.PipelineStep) {
+	m.steps = &ss
+	m.appendsteps = nil
+}
+
+// Steps returns the value of the "steps" field in the mutation.
+func (m *CompressionJobMutation) Steps() (r []schema.PipelineStep, exists bool) {
+	v := m.steps
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown CompressionJob field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
+// OldSteps returns the old "steps" field's value of the CompressionJob entity.
+// If the CompressionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompressionJobMutation) OldSteps(ctx context.Context) (v []schema.PipelineStep, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSteps is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSteps requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSteps: %w", err)
+	}
+	return oldValue.Steps, nil
+}
+
+// AppendSteps adds ss to the "steps" field.
+func (m *CompressionJobMutation) AppendSteps(ss []schema.PipelineStep) {
+	m.appendsteps = append(m.appendsteps, ss...)
+}
+
+// AppendedSteps returns the list of values that were appended to the "steps" field in this mutation.
+func (m *CompressionJobMutation) AppendedSteps() ([]schema.PipelineStep, bool) {
+	if len(m.appendsteps) == 0 {
+		return nil, false
+	}
+	return m.appendsteps, true
+}
+
+// ClearSteps clears the value of the "steps" field.
+func (m *CompressionJobMutation) ClearSteps() {
+	m.steps = nil
+	m.appendsteps = nil
+	m.clearedFields[compressionjob.FieldSteps] = struct{}{}
+}
+
+// StepsCleared returns if the "steps" field was cleared in this mutation.
+func (m *CompressionJobMutation) StepsCleared() bool {
+	_, ok := m.clearedFields[compressionjob.FieldSteps]
+	return ok
+}
+
+// ResetSteps resets all changes to the "steps" field.
+func (m *CompressionJobMutation) ResetSteps() {
+	m.steps = nil
+	m.appendsteps = nil
+	delete(m.clearedFields, compressionjob.FieldSteps)
+}
+
+// SetTargetBytes sets the "target_bytes" field.
+func (m *CompressionJobMutation) SetTargetBytes(i int64) {
+	m.target_bytes = &i
+	m.addtarget_bytes = nil
+}
+
+// TargetBytes returns the value of the "target_bytes" field in the mutation.
+func (m *CompressionJobMutation) TargetBytes() (r int64, exists bool) {
+	v := m.target_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetBytes returns the old "target_bytes" field's value of the CompressionJob entity.
+// If the CompressionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompressionJobMutation) OldTargetBytes(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetBytes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetBytes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetBytes: %w", err)
+	}
+	return oldValue.TargetBytes, nil
+}
+
+// AddTargetBytes adds i to the "target_bytes" field.
+func (m *CompressionJobMutation) AddTargetBytes(i int64) {
+	if m.addtarget_bytes != nil {
+		*m.addtarget_bytes += i
+	} else {
+		m.addtarget_bytes = &i
+	}
+}
+
+// AddedTargetBytes returns the value that was added to the "target_bytes" field in this mutation.
+func (m *CompressionJobMutation) AddedTargetBytes() (r int64, exists bool) {
+	v := m.addtarget_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTargetBytes clears the value of the "target_bytes" field.
+func (m *CompressionJobMutation) ClearTargetBytes() {
+	m.target_bytes = nil
+	m.addtarget_bytes = nil
+	m.clearedFields[compressionjob.FieldTargetBytes] = struct{}{}
+}
+
+// TargetBytesCleared returns if the "target_bytes" field was cleared in this mutation.
+func (m *CompressionJobMutation) TargetBytesCleared() bool {
+	_, ok := m.clearedFields[compressionjob.FieldTargetBytes]
+	return ok
+}
+
+// ResetTargetBytes resets all changes to the "target_bytes" field.
+func (m *CompressionJobMutation) ResetTargetBytes() {
+	m.target_bytes = nil
+	m.addtarget_bytes = nil
+	delete(m.clearedFields, compressionjob.FieldTargetBytes)
+}
+
+// SetTargetParams sets the "target_params" field.
+func (m *CompressionJobMutation) SetTargetParams(sp schema.TargetParams) {
+	m.target_params = &sp
+}
+
+// TargetParams returns the value of the "target_params" field in the mutation.
+func (m *CompressionJobMutation) TargetParams() (r schema.TargetParams, exists bool) {
+	v := m.target_params
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetParams returns the old "target_params" field's value of the CompressionJob entity.
+// If the CompressionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompressionJobMutation) OldTargetParams(ctx context.Context) (v schema.TargetParams, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetParams is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetParams requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetParams: %w", err)
+	}
+	return oldValue.TargetParams, nil
+}
+
+// ClearTargetParams clears the value of the "target_params" field.
+func (m *CompressionJobMutation) ClearTargetParams() {
+	m.target_params = nil
+	m.clearedFields[compressionjob.FieldTargetParams] = struct{}{}
+}
+
+// TargetParamsCleared returns if the "target_params" field was cleared in this mutation.
+func (m *CompressionJobMutation) TargetParamsCleared() bool {
+	_, ok := m.clearedFields[compressionjob.FieldTargetParams]
+	return ok
+}
+
+// ResetTargetParams resets all changes to the "target_params" field.
+func (m *CompressionJobMutation) ResetTargetParams() {
+	m.target_params = nil
+	delete(m.clearedFields, compressionjob.FieldTargetParams)
+}
+
+// SetSsim sets the "ssim" field.
+func (m *CompressionJobMutation) SetSsim(f float64) {
+	m.ssim = &f
+	m.addssim = nil
+}
+
+// Ssim returns the value of the "ssim" field in the mutation.
+func (m *CompressionJobMutation) Ssim() (r float64, exists bool) {
+	v := m.ssim
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSsim returns the old "ssim" field's value of the CompressionJob entity.
+// If the CompressionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompressionJobMutation) OldSsim(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSsim is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSsim requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSsim: %w", err)
+	}
+	return oldValue.Ssim, nil
+}
+
+// AddSsim adds f to the "ssim" field.
+func (m *CompressionJobMutation) AddSsim(f float64) {
+	if m.addssim != nil {
+		*m.addssim += f
+	} else {
+		m.addssim = &f
+	}
+}
+
+// AddedSsim returns the value that was added to the "ssim" field in this mutation.
+func (m *CompressionJobMutation) AddedSsim() (r float64, exists bool) {
+	v := m.addssim
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSsim clears the value of the "ssim" field.
+func (m *CompressionJobMutation) ClearSsim() {
+	m.ssim = nil
+	m.addssim = nil
+	m.clearedFields[compressionjob.FieldSsim] = struct{}{}
+}
+
+// SsimCleared returns if the "ssim" field was cleared in this mutation.
+func (m *CompressionJobMutation) SsimCleared() bool {
+	_, ok := m.clearedFields[compressionjob.FieldSsim]
+	return ok
+}
+
+// ResetSsim resets all changes to the "ssim" field.
+func (m *CompressionJobMutation) ResetSsim() {
+	m.ssim = nil
+	m.addssim = nil
+	delete(m.clearedFields, compressionjob.FieldSsim)
+}
+
+// SetPsnr sets the "psnr" field.
+func (m *CompressionJobMutation) SetPsnr(f float64) {
+	m.psnr = &f
+	m.addpsnr = nil
+}
+
+// Psnr returns the value of the "psnr" field in the mutation.
+func (m *CompressionJobMutation) Psnr() (r float64, exists bool) {
+	v := m.psnr
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPsnr returns the old "psnr" field's value of the CompressionJob entity.
+// If the CompressionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompressionJobMutation) OldPsnr(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPsnr is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPsnr requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPsnr: %w", err)
+	}
+	return oldValue.Psnr, nil
+}
+
+// AddPsnr adds f to the "psnr" field.
+func (m *CompressionJobMutation) AddPsnr(f float64) {
+	if m.addpsnr != nil {
+		*m.addpsnr += f
+	} else {
+		m.addpsnr = &f
+	}
+}
+
+// AddedPsnr returns the value that was added to the "psnr" field in this mutation.
+func (m *CompressionJobMutation) AddedPsnr() (r float64, exists bool) {
+	v := m.addpsnr
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPsnr clears the value of the "psnr" field.
+func (m *CompressionJobMutation) ClearPsnr() {
+	m.psnr = nil
+	m.addpsnr = nil
+	m.clearedFields[compressionjob.FieldPsnr] = struct{}{}
+}
+
+// PsnrCleared returns if the "psnr" field was cleared in this mutation.
+func (m *CompressionJobMutation) PsnrCleared() bool {
+	_, ok := m.clearedFields[compressionjob.FieldPsnr]
+	return ok
+}
+
+// ResetPsnr resets all changes to the "psnr" field.
+func (m *CompressionJobMutation) ResetPsnr() {
+	m.psnr = nil
+	m.addpsnr = nil
+	delete(m.clearedFields, compressionjob.FieldPsnr)
+}
+
+// SetVmaf sets the "vmaf" field.
+func (m *CompressionJobMutation) SetVmaf(f float64) {
+	m.vmaf = &f
+	m.addvmaf = nil
+}
+
+// Vmaf returns the value of the "vmaf" field in the mutation.
+func (m *CompressionJobMutation) Vmaf() (r float64, exists bool) {
+	v := m.vmaf
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVmaf returns the old "vmaf" field's value of the CompressionJob entity.
+// If the CompressionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompressionJobMutation) OldVmaf(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVmaf is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVmaf requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVmaf: %w", err)
+	}
+	return oldValue.Vmaf, nil
+}
+
+// AddVmaf adds f to the "vmaf" field.
+func (m *CompressionJobMutation) AddVmaf(f float64) {
+	if m.addvmaf != nil {
+		*m.addvmaf += f
+	} else {
+		m.addvmaf = &f
+	}
+}
+
+// AddedVmaf returns the value that was added to the "vmaf" field in this mutation.
+func (m *CompressionJobMutation) AddedVmaf() (r float64, exists bool) {
+	v := m.addvmaf
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearVmaf clears the value of the "vmaf" field.
+func (m *CompressionJobMutation) ClearVmaf() {
+	m.vmaf = nil
+	m.addvmaf = nil
+	m.clearedFields[compressionjob.FieldVmaf] = struct{}{}
+}
+
+// VmafCleared returns if the "vmaf" field was cleared in this mutation.
+func (m *CompressionJobMutation) VmafCleared() bool {
+	_, ok := m.clearedFields[compressionjob.FieldVmaf]
+	return ok
+}
+
+// ResetVmaf resets all changes to the "vmaf" field.
+func (m *CompressionJobMutation) ResetVmaf() {
+	m.vmaf = nil
+	m.addvmaf = nil
+	delete(m.clearedFields, compressionjob.FieldVmaf)
+}
+
+// SetEncoderParams sets the "encoder_params" field.
+func (m *CompressionJobMutation) SetEncoderParams(sp schema.EncoderParams) {
+	m.encoder_params = &sp
+}
+
+// EncoderParams returns the value of the "encoder_params" field in the mutation.
+func (m *CompressionJobMutation) EncoderParams() (r schema.EncoderParams, exists bool) {
+	v := m.encoder_params
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEncoderParams returns the old "encoder_params" field's value of the CompressionJob entity.
+// If the CompressionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompressionJobMutation) OldEncoderParams(ctx context.Context) (v schema.EncoderParams, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEncoderParams is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEncoderParams requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEncoderParams: %w", err)
+	}
+	return oldValue.EncoderParams, nil
+}
+
+// ClearEncoderParams clears the value of the "encoder_params" field.
+func (m *CompressionJobMutation) ClearEncoderParams() {
+	m.encoder_params = nil
+	m.clearedFields[compressionjob.FieldEncoderParams] = struct{}{}
+}
+
+// EncoderParamsCleared returns if the "encoder_params" field was cleared in this mutation.
+func (m *CompressionJobMutation) EncoderParamsCleared() bool {
+	_, ok := m.clearedFields[compressionjob.FieldEncoderParams]
+	return ok
+}
+
+// ResetEncoderParams resets all changes to the "encoder_params" field.
+func (m *CompressionJobMutation) ResetEncoderParams() {
+	m.encoder_params = nil
+	delete(m.clearedFields, compressionjob.FieldEncoderParams)
+}
+
+// SetFfmpegVersion sets the "ffmpeg_version" field.
+func (m *CompressionJobMutation) SetFfmpegVersion(s string) {
+	m.ffmpeg_version = &s
+}
+
+// FfmpegVersion returns the value of the "ffmpeg_version" field in the mutation.
+func (m *CompressionJobMutation) FfmpegVersion() (r string, exists bool) {
+	v := m.ffmpeg_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFfmpegVersion returns the old "ffmpeg_version" field's value of the CompressionJob entity.
+// If the CompressionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompressionJobMutation) OldFfmpegVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFfmpegVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFfmpegVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFfmpegVersion: %w", err)
+	}
+	return oldValue.FfmpegVersion, nil
+}
+
+// ClearFfmpegVersion clears the value of the "ffmpeg_version" field.
+func (m *CompressionJobMutation) ClearFfmpegVersion() {
+	m.ffmpeg_version = nil
+	m.clearedFields[compressionjob.FieldFfmpegVersion] = struct{}{}
+}
+
+// FfmpegVersionCleared returns if the "ffmpeg_version" field was cleared in this mutation.
+func (m *CompressionJobMutation) FfmpegVersionCleared() bool {
+	_, ok := m.clearedFields[compressionjob.FieldFfmpegVersion]
+	return ok
+}
+
+// ResetFfmpegVersion resets all changes to the "ffmpeg_version" field.
+func (m *CompressionJobMutation) ResetFfmpegVersion() {
+	m.ffmpeg_version = nil
+	delete(m.clearedFields, compressionjob.FieldFfmpegVersion)
+}
+
+// SetCommands sets the "commands" field.
+func (m *CompressionJobMutation) SetCommands(s []string) {
+	m.commands = &s
+	m.appendcommands = nil
+}
+
+// Commands returns the value of the "commands" field in the mutation.
+func (m *CompressionJobMutation) Commands() (r []string, exists bool) {
+	v := m.commands
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommands returns the old "commands" field's value of the CompressionJob entity.
+// If the CompressionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompressionJobMutation) OldCommands(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommands is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommands requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommands: %w", err)
+	}
+	return oldValue.Commands, nil
+}
+
+// AppendCommands adds s to the "commands" field.
+func (m *CompressionJobMutation) AppendCommands(s []string) {
+	m.appendcommands = append(m.appendcommands, s...)
+}
+
+// AppendedCommands returns the list of values that were appended to the "commands" field in this mutation.
+func (m *CompressionJobMutation) AppendedCommands() ([]string, bool) {
+	if len(m.appendcommands) == 0 {
+		return nil, false
+	}
+	return m.appendcommands, true
+}
+
+// ClearCommands clears the value of the "commands" field.
+func (m *CompressionJobMutation) ClearCommands() {
+	m.commands = nil
+	m.appendcommands = nil
+	m.clearedFields[compressionjob.FieldCommands] = struct{}{}
+}
+
+// CommandsCleared returns if the "commands" field was cleared in this mutation.
+func (m *CompressionJobMutation) CommandsCleared() bool {
+	_, ok := m.clearedFields[compressionjob.FieldCommands]
+	return ok
+}
+
+// ResetCommands resets all changes to the "commands" field.
+func (m *CompressionJobMutation) ResetCommands() {
+	m.commands = nil
+	m.appendcommands = nil
+	delete(m.clearedFields, compressionjob.FieldCommands)
+}
+
+// SetLog sets the "log" field.
+func (m *CompressionJobMutation) SetLog(s string) {
+	m.log = &s
+}
+
+// Log returns the value of the "log" field in the mutation.
+func (m *CompressionJobMutation) Log() (r string, exists bool) {
+	v := m.log
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLog returns the old "log" field's value of the CompressionJob entity.
+// If the CompressionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompressionJobMutation) OldLog(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLog is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLog requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLog: %w", err)
+	}
+	return oldValue.Log, nil
+}
+
+// ClearLog clears the value of the "log" field.
+func (m *CompressionJobMutation) ClearLog() {
+	m.log = nil
+	m.clearedFields[compressionjob.FieldLog] = struct{}{}
+}
+
+// LogCleared returns if the "log" field was cleared in this mutation.
+func (m *CompressionJobMutation) LogCleared() bool {
+	_, ok := m.clearedFields[compressionjob.FieldLog]
+	return ok
+}
+
+// ResetLog resets all changes to the "log" field.
+func (m *CompressionJobMutation) ResetLog() {
+	m.log = nil
+	delete(m.clearedFields, compressionjob.FieldLog)
+}
+
+// SetPriority sets the "priority" field.
+func (m *CompressionJobMutation) SetPriority(i int) {
+	m.priority = &i
+	m.addpriority = nil
+}
+
+// Priority returns the value of the "priority" field in the mutation.
+func (m *CompressionJobMutation) Priority() (r int, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriority returns the old "priority" field's value of the CompressionJob entity.
+// If the CompressionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompressionJobMutation) OldPriority(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	}
+	return oldValue.Priority, nil
+}
+
+// AddPriority adds i to the "priority" field.
+func (m *CompressionJobMutation) AddPriority(i int) {
+	if m.addpriority != nil {
+		*m.addpriority += i
+	} else {
+		m.addpriority = &i
+	}
+}
+
+// AddedPriority returns the value that was added to the "priority" field in this mutation.
+func (m *CompressionJobMutation) AddedPriority() (r int, exists bool) {
+	v := m.addpriority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPriority resets all changes to the "priority" field.
+func (m *CompressionJobMutation) ResetPriority() {
+	m.priority = nil
+	m.addpriority = nil
+}
+
+// SetSubmitter sets the "submitter" field.
+func (m *CompressionJobMutation) SetSubmitter(s string) {
+	m.submitter = &s
+}
+
+// Submitter returns the value of the "submitter" field in the mutation.
+func (m *CompressionJobMutation) Submitter() (r string, exists bool) {
+	v := m.submitter
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubmitter returns the old "submitter" field's value of the CompressionJob entity.
+// If the CompressionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompressionJobMutation) OldSubmitter(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubmitter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubmitter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubmitter: %w", err)
+	}
+	return oldValue.Submitter, nil
+}
+
+// ResetSubmitter resets all changes to the "submitter" field.
+func (m *CompressionJobMutation) ResetSubmitter() {
+	m.submitter = nil
+}
+
+// SetPool sets the "pool" field.
+func (m *CompressionJobMutation) SetPool(s string) {
+	m.pool = &s
+}
+
+// Pool returns the value of the "pool" field in the mutation.
+func (m *CompressionJobMutation) Pool() (r string, exists bool) {
+	v := m.pool
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPool returns the old "pool" field's value of the CompressionJob entity.
+// If the CompressionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompressionJobMutation) OldPool(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPool is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPool requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPool: %w", err)
+	}
+	return oldValue.Pool, nil
+}
+
+// ClearPool clears the value of the "pool" field.
+func (m *CompressionJobMutation) ClearPool() {
+	m.pool = nil
+	m.clearedFields[compressionjob.FieldPool] = struct{}{}
+}
+
+// PoolCleared returns if the "pool" field was cleared in this mutation.
+func (m *CompressionJobMutation) PoolCleared() bool {
+	_, ok := m.clearedFields[compressionjob.FieldPool]
+	return ok
+}
+
+// ResetPool resets all changes to the "pool" field.
+func (m *CompressionJobMutation) ResetPool() {
+	m.pool = nil
+	delete(m.clearedFields, compressionjob.FieldPool)
+}
+
+// SetWorkerID sets the "worker_id" field.
+func (m *CompressionJobMutation) SetWorkerID(s string) {
+	m.worker_id = &s
+}
+
+// WorkerID returns the value of the "worker_id" field in the mutation.
+func (m *CompressionJobMutation) WorkerID() (r string, exists bool) {
+	v := m.worker_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkerID returns the old "worker_id" field's value of the CompressionJob entity.
+// If the CompressionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompressionJobMutation) OldWorkerID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkerID: %w", err)
+	}
+	return oldValue.WorkerID, nil
+}
+
+// ClearWorkerID clears the value of the "worker_id" field.
+func (m *CompressionJobMutation) ClearWorkerID() {
+	m.worker_id = nil
+	m.clearedFields[compressionjob.FieldWorkerID] = struct{}{}
+}
+
+// WorkerIDCleared returns if the "worker_id" field was cleared in this mutation.
+func (m *CompressionJobMutation) WorkerIDCleared() bool {
+	_, ok := m.clearedFields[compressionjob.FieldWorkerID]
+	return ok
+}
+
+// ResetWorkerID resets all changes to the "worker_id" field.
+func (m *CompressionJobMutation) ResetWorkerID() {
+	m.worker_id = nil
+	delete(m.clearedFields, compressionjob.FieldWorkerID)
+}
+
+// SetLeaseExpiresAt sets the "lease_expires_at" field.
+func (m *CompressionJobMutation) SetLeaseExpiresAt(t time.Time) {
+	m.lease_expires_at = &t
+}
+
+// LeaseExpiresAt returns the value of the "lease_expires_at" field in the mutation.
+func (m *CompressionJobMutation) LeaseExpiresAt() (r time.Time, exists bool) {
+	v := m.lease_expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLeaseExpiresAt returns the old "lease_expires_at" field's value of the CompressionJob entity.
+// If the CompressionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompressionJobMutation) OldLeaseExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeaseExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeaseExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeaseExpiresAt: %w", err)
+	}
+	return oldValue.LeaseExpiresAt, nil
+}
+
+// ClearLeaseExpiresAt clears the value of the "lease_expires_at" field.
+func (m *CompressionJobMutation) ClearLeaseExpiresAt() {
+	m.lease_expires_at = nil
+	m.clearedFields[compressionjob.FieldLeaseExpiresAt] = struct{}{}
+}
+
+// LeaseExpiresAtCleared returns if the "lease_expires_at" field was cleared in this mutation.
+func (m *CompressionJobMutation) LeaseExpiresAtCleared() bool {
+	_, ok := m.clearedFields[compressionjob.FieldLeaseExpiresAt]
+	return ok
+}
+
+// ResetLeaseExpiresAt resets all changes to the "lease_expires_at" field.
+func (m *CompressionJobMutation) ResetLeaseExpiresAt() {
+	m.lease_expires_at = nil
+	delete(m.clearedFields, compressionjob.FieldLeaseExpiresAt)
+}
+
+// SetCancelRequested sets the "cancel_requested" field.
+func (m *CompressionJobMutation) SetCancelRequested(b bool) {
+	m.cancel_requested = &b
+}
+
+// CancelRequested returns the value of the "cancel_requested" field in the mutation.
+func (m *CompressionJobMutation) CancelRequested() (r bool, exists bool) {
+	v := m.cancel_requested
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCancelRequested returns the old "cancel_requested" field's value of the CompressionJob entity.
+// If the CompressionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompressionJobMutation) OldCancelRequested(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCancelRequested is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCancelRequested requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCancelRequested: %w", err)
+	}
+	return oldValue.CancelRequested, nil
+}
+
+// ResetCancelRequested resets all changes to the "cancel_requested" field.
+func (m *CompressionJobMutation) ResetCancelRequested() {
+	m.cancel_requested = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *CompressionJobMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *CompressionJobMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the CompressionJob entity.
+// If the CompressionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompressionJobMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *CompressionJobMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *CompressionJobMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *CompressionJobMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (m *CompressionJobMutation) SetMaxAttempts(i int) {
+	m.max_attempts = &i
+	m.addmax_attempts = nil
+}
+
+// MaxAttempts returns the value of the "max_attempts" field in the mutation.
+func (m *CompressionJobMutation) MaxAttempts() (r int, exists bool) {
+	v := m.max_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxAttempts returns the old "max_attempts" field's value of the CompressionJob entity.
+// If the CompressionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompressionJobMutation) OldMaxAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxAttempts: %w", err)
+	}
+	return oldValue.MaxAttempts, nil
+}
+
+// AddMaxAttempts adds i to the "max_attempts" field.
+func (m *CompressionJobMutation) AddMaxAttempts(i int) {
+	if m.addmax_attempts != nil {
+		*m.addmax_attempts += i
+	} else {
+		m.addmax_attempts = &i
+	}
+}
+
+// AddedMaxAttempts returns the value that was added to the "max_attempts" field in this mutation.
+func (m *CompressionJobMutation) AddedMaxAttempts() (r int, exists bool) {
+	v := m.addmax_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxAttempts resets all changes to the "max_attempts" field.
+func (m *CompressionJobMutation) ResetMaxAttempts() {
+	m.max_attempts = nil
+	m.addmax_attempts = nil
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *CompressionJobMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
+}
+
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *CompressionJobMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the CompressionJob entity.
+// If the CompressionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompressionJobMutation) OldNextAttemptAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
+	}
+	return oldValue.NextAttemptAt, nil
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (m *CompressionJobMutation) ClearNextAttemptAt() {
+	m.next_attempt_at = nil
+	m.clearedFields[compressionjob.FieldNextAttemptAt] = struct{}{}
+}
+
+// NextAttemptAtCleared returns if the "next_attempt_at" field was cleared in this mutation.
+func (m *CompressionJobMutation) NextAttemptAtCleared() bool {
+	_, ok := m.clearedFields[compressionjob.FieldNextAttemptAt]
+	return ok
+}
+
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *CompressionJobMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
+	delete(m.clearedFields, compressionjob.FieldNextAttemptAt)
+}
+
+// SetAttemptErrors sets the "attempt_errors" field.
+func (m *CompressionJobMutation) SetAttemptErrors(s []string) {
+	m.attempt_errors = &s
+	m.appendattempt_errors = nil
+}
+
+// AttemptErrors returns the value of the "attempt_errors" field in the mutation.
+func (m *CompressionJobMutation) AttemptErrors() (r []string, exists bool) {
+	v := m.attempt_errors
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttemptErrors returns the old "attempt_errors" field's value of the CompressionJob entity.
+// If the CompressionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompressionJobMutation) OldAttemptErrors(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttemptErrors is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttemptErrors requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttemptErrors: %w", err)
+	}
+	return oldValue.AttemptErrors, nil
+}
+
+// AppendAttemptErrors adds s to the "attempt_errors" field.
+func (m *CompressionJobMutation) AppendAttemptErrors(s []string) {
+	m.appendattempt_errors = append(m.appendattempt_errors, s...)
+}
+
+// AppendedAttemptErrors returns the list of values that were appended to the "attempt_errors" field in this mutation.
+func (m *CompressionJobMutation) AppendedAttemptErrors() ([]string, bool) {
+	if len(m.appendattempt_errors) == 0 {
+		return nil, false
+	}
+	return m.appendattempt_errors, true
+}
+
+// ClearAttemptErrors clears the value of the "attempt_errors" field.
+func (m *CompressionJobMutation) ClearAttemptErrors() {
+	m.attempt_errors = nil
+	m.appendattempt_errors = nil
+	m.clearedFields[compressionjob.FieldAttemptErrors] = struct{}{}
+}
+
+// AttemptErrorsCleared returns if the "attempt_errors" field was cleared in this mutation.
+func (m *CompressionJobMutation) AttemptErrorsCleared() bool {
+	_, ok := m.clearedFields[compressionjob.FieldAttemptErrors]
+	return ok
+}
+
+// ResetAttemptErrors resets all changes to the "attempt_errors" field.
+func (m *CompressionJobMutation) ResetAttemptErrors() {
+	m.attempt_errors = nil
+	m.appendattempt_errors = nil
+	delete(m.clearedFields, compressionjob.FieldAttemptErrors)
+}
+
+// SetAssetID sets the "asset" edge to the Asset entity by id.
+func (m *CompressionJobMutation) SetAssetID(id string) {
+	m.asset = &id
+}
+
+// ClearAsset clears the "asset" edge to the Asset entity.
+func (m *CompressionJobMutation) ClearAsset() {
+	m.clearedasset = true
+}
+
+// AssetCleared reports if the "asset" edge to the Asset entity was cleared.
+func (m *CompressionJobMutation) AssetCleared() bool {
+	return m.clearedasset
+}
+
+// AssetID returns the "asset" edge ID in the mutation.
+func (m *CompressionJobMutation) AssetID() (id string, exists bool) {
+	if m.asset != nil {
+		return *m.asset, true
+	}
+	return
+}
+
+// AssetIDs returns the "asset" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AssetID instead. It exists only for internal usage by the builders.
+func (m *CompressionJobMutation) AssetIDs() (ids []string) {
+	if id := m.asset; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAsset resets all changes to the "asset" edge.
+func (m *CompressionJobMutation) ResetAsset() {
+	m.asset = nil
+	m.clearedasset = false
+}
+
+// Where appends a list predicates to the CompressionJobMutation builder.
+func (m *CompressionJobMutation) Where(ps ...predicate.CompressionJob) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CompressionJobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CompressionJobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CompressionJob, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CompressionJobMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CompressionJobMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CompressionJob).
+func (m *CompressionJobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CompressionJobMutation) Fields() []string {
+	fields := make([]string, 0, 32)
+	if m.kind != nil {
+		fields = append(fields, compressionjob.FieldKind)
+	}
+	if m.status != nil {
+		fields = append(fields, compressionjob.FieldStatus)
+	}
+	if m.progress != nil {
+		fields = append(fields, compressionjob.FieldProgress)
+	}
+	if m.error != nil {
+		fields = append(fields, compressionjob.FieldError)
+	}
+	if m.created_at != nil {
+		fields = append(fields, compressionjob.FieldCreatedAt)
+	}
+	if m.started_at != nil {
+		fields = append(fields, compressionjob.FieldStartedAt)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, compressionjob.FieldCompletedAt)
+	}
+	if m.profile != nil {
+		fields = append(fields, compressionjob.FieldProfile)
+	}
+	if m.target_format != nil {
+		fields = append(fields, compressionjob.FieldTargetFormat)
+	}
+	if m.audio_bitrate != nil {
+		fields = append(fields, compressionjob.FieldAudioBitrate)
+	}
+	if m.normalize != nil {
+		fields = append(fields, compressionjob.FieldNormalize)
+	}
+	if m.outcome != nil {
+		fields = append(fields, compressionjob.FieldOutcome)
+	}
+	if m.steps != nil {
+		fields = append(fields, compressionjob.FieldSteps)
+	}
+	if m.target_bytes != nil {
+		fields = append(fields, compressionjob.FieldTargetBytes)
+	}
+	if m.target_params != nil {
+		fields = append(fields, compressionjob.FieldTargetParams)
+	}
+	if m.ssim != nil {
+		fields = append(fields, compressionjob.FieldSsim)
+	}
+	if m.psnr != nil {
+		fields = append(fields, compressionjob.FieldPsnr)
+	}
+	if m.vmaf != nil {
+		fields = append(fields, compressionjob.FieldVmaf)
+	}
+	if m.encoder_params != nil {
+		fields = append(fields, compressionjob.FieldEncoderParams)
+	}
+	if m.ffmpeg_version != nil {
+		fields = append(fields, compressionjob.FieldFfmpegVersion)
+	}
+	if m.commands != nil {
+		fields = append(fields, compressionjob.FieldCommands)
+	}
+	if m.log != nil {
+		fields = append(fields, compressionjob.FieldLog)
+	}
+	if m.priority != nil {
+		fields = append(fields, compressionjob.FieldPriority)
+	}
+	if m.submitter != nil {
+		fields = append(fields, compressionjob.FieldSubmitter)
+	}
+	if m.pool != nil {
+		fields = append(fields, compressionjob.FieldPool)
+	}
+	if m.worker_id != nil {
+		fields = append(fields, compressionjob.FieldWorkerID)
+	}
+	if m.lease_expires_at != nil {
+		fields = append(fields, compressionjob.FieldLeaseExpiresAt)
+	}
+	if m.cancel_requested != nil {
+		fields = append(fields, compressionjob.FieldCancelRequested)
+	}
+	if m.attempts != nil {
+		fields = append(fields, compressionjob.FieldAttempts)
+	}
+	if m.max_attempts != nil {
+		fields = append(fields, compressionjob.FieldMaxAttempts)
+	}
+	if m.next_attempt_at != nil {
+		fields = append(fields, compressionjob.FieldNextAttemptAt)
+	}
+	if m.attempt_errors != nil {
+		fields = append(fields, compressionjob.FieldAttemptErrors)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CompressionJobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case compressionjob.FieldKind:
+		return m.Kind()
+	case compressionjob.FieldStatus:
+		return m.Status()
+	case compressionjob.FieldProgress:
+		return m.Progress()
+	case compressionjob.FieldError:
+		return m.Error()
+	case compressionjob.FieldCreatedAt:
+		return m.CreatedAt()
+	case compressionjob.FieldStartedAt:
+		return m.StartedAt()
+	case compressionjob.FieldCompletedAt:
+		return m.CompletedAt()
+	case compressionjob.FieldProfile:
+		return m.Profile()
+	case compressionjob.FieldTargetFormat:
+		return m.TargetFormat()
+	case compressionjob.FieldAudioBitrate:
+		return m.AudioBitrate()
+	case compressionjob.FieldNormalize:
+		return m.Normalize()
+	case compressionjob.FieldOutcome:
+		return m.Outcome()
+	case compressionjob.FieldSteps:
+		return m.Steps()
+	case compressionjob.FieldTargetBytes:
+		return m.TargetBytes()
+	case compressionjob.FieldTargetParams:
+		return m.TargetParams()
+	case compressionjob.FieldSsim:
+		return m.Ssim()
+	case compressionjob.FieldPsnr:
+		return m.Psnr()
+	case compressionjob.FieldVmaf:
+		return m.Vmaf()
+	case compressionjob.FieldEncoderParams:
+		return m.EncoderParams()
+	case compressionjob.FieldFfmpegVersion:
+		return m.FfmpegVersion()
+	case compressionjob.FieldCommands:
+		return m.Commands()
+	case compressionjob.FieldLog:
+		return m.Log()
+	case compressionjob.FieldPriority:
+		return m.Priority()
+	case compressionjob.FieldSubmitter:
+		return m.Submitter()
+	case compressionjob.FieldPool:
+		return m.Pool()
+	case compressionjob.FieldWorkerID:
+		return m.WorkerID()
+	case compressionjob.FieldLeaseExpiresAt:
+		return m.LeaseExpiresAt()
+	case compressionjob.FieldCancelRequested:
+		return m.CancelRequested()
+	case compressionjob.FieldAttempts:
+		return m.Attempts()
+	case compressionjob.FieldMaxAttempts:
+		return m.MaxAttempts()
+	case compressionjob.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case compressionjob.FieldAttemptErrors:
+		return m.AttemptErrors()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CompressionJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case compressionjob.FieldKind:
+		return m.OldKind(ctx)
+	case compressionjob.FieldStatus:
+		return m.OldStatus(ctx)
+	case compressionjob.FieldProgress:
+		return m.OldProgress(ctx)
+	case compressionjob.FieldError:
+		return m.OldError(ctx)
+	case compressionjob.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case compressionjob.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case compressionjob.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case compressionjob.FieldProfile:
+		return m.OldProfile(ctx)
+	case compressionjob.FieldTargetFormat:
+		return m.OldTargetFormat(ctx)
+	case compressionjob.FieldAudioBitrate:
+		return m.OldAudioBitrate(ctx)
+	case compressionjob.FieldNormalize:
+		return m.OldNormalize(ctx)
+	case compressionjob.FieldOutcome:
+		return m.OldOutcome(ctx)
+	case compressionjob.FieldSteps:
+		return m.OldSteps(ctx)
+	case compressionjob.FieldTargetBytes:
+		return m.OldTargetBytes(ctx)
+	case compressionjob.FieldTargetParams:
+		return m.OldTargetParams(ctx)
+	case compressionjob.FieldSsim:
+		return m.OldSsim(ctx)
+	case compressionjob.FieldPsnr:
+		return m.OldPsnr(ctx)
+	case compressionjob.FieldVmaf:
+		return m.OldVmaf(ctx)
+	case compressionjob.FieldEncoderParams:
+		return m.OldEncoderParams(ctx)
+	case compressionjob.FieldFfmpegVersion:
+		return m.OldFfmpegVersion(ctx)
+	case compressionjob.FieldCommands:
+		return m.OldCommands(ctx)
+	case compressionjob.FieldLog:
+		return m.OldLog(ctx)
+	case compressionjob.FieldPriority:
+		return m.OldPriority(ctx)
+	case compressionjob.FieldSubmitter:
+		return m.OldSubmitter(ctx)
+	case compressionjob.FieldPool:
+		return m.OldPool(ctx)
+	case compressionjob.FieldWorkerID:
+		return m.OldWorkerID(ctx)
+	case compressionjob.FieldLeaseExpiresAt:
+		return m.OldLeaseExpiresAt(ctx)
+	case compressionjob.FieldCancelRequested:
+		return m.OldCancelRequested(ctx)
+	case compressionjob.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case compressionjob.FieldMaxAttempts:
+		return m.OldMaxAttempts(ctx)
+	case compressionjob.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case compressionjob.FieldAttemptErrors:
+		return m.OldAttemptErrors(ctx)
+	}
+	return nil, fmt.Errorf("unknown CompressionJob field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CompressionJobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case compressionjob.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case compressionjob.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case compressionjob.FieldProgress:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProgress(v)
+		return nil
+	case compressionjob.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case compressionjob.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case compressionjob.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case compressionjob.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	case compressionjob.FieldProfile:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProfile(v)
+		return nil
+	case compressionjob.FieldTargetFormat:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetFormat(v)
+		return nil
+	case compressionjob.FieldAudioBitrate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAudioBitrate(v)
+		return nil
+	case compressionjob.FieldNormalize:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNormalize(v)
+		return nil
+	case compressionjob.FieldOutcome:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutcome(v)
+		return nil
+	case compressionjob.FieldSteps:
+		v, ok := value.([]schema.PipelineStep)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSteps(v)
+		return nil
+	case compressionjob.FieldTargetBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetBytes(v)
+		return nil
+	case compressionjob.FieldTargetParams:
+		v, ok := value.(schema.TargetParams)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetParams(v)
+		return nil
+	case compressionjob.FieldSsim:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSsim(v)
+		return nil
+	case compressionjob.FieldPsnr:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPsnr(v)
+		return nil
+	case compressionjob.FieldVmaf:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVmaf(v)
+		return nil
+	case compressionjob.FieldEncoderParams:
+		v, ok := value.(schema.EncoderParams)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEncoderParams(v)
+		return nil
+	case compressionjob.FieldFfmpegVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFfmpegVersion(v)
+		return nil
+	case compressionjob.FieldCommands:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommands(v)
+		return nil
+	case compressionjob.FieldLog:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLog(v)
+		return nil
+	case compressionjob.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case compressionjob.FieldSubmitter:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubmitter(v)
+		return nil
+	case compressionjob.FieldPool:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPool(v)
+		return nil
+	case compressionjob.FieldWorkerID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkerID(v)
+		return nil
+	case compressionjob.FieldLeaseExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeaseExpiresAt(v)
+		return nil
+	case compressionjob.FieldCancelRequested:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCancelRequested(v)
+		return nil
+	case compressionjob.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case compressionjob.FieldMaxAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxAttempts(v)
+		return nil
+	case compressionjob.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttemptAt(v)
+		return nil
+	case compressionjob.FieldAttemptErrors:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttemptErrors(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CompressionJob field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CompressionJobMutation) AddedFields() []string {
+	var fields []string
+	if m.addprogress != nil {
+		fields = append(fields, compressionjob.FieldProgress)
+	}
+	if m.addtarget_bytes != nil {
+		fields = append(fields, compressionjob.FieldTargetBytes)
+	}
+	if m.addssim != nil {
+		fields = append(fields, compressionjob.FieldSsim)
+	}
+	if m.addpsnr != nil {
+		fields = append(fields, compressionjob.FieldPsnr)
+	}
+	if m.addvmaf != nil {
+		fields = append(fields, compressionjob.FieldVmaf)
+	}
+	if m.addpriority != nil {
+		fields = append(fields, compressionjob.FieldPriority)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, compressionjob.FieldAttempts)
+	}
+	if m.addmax_attempts != nil {
+		fields = append(fields, compressionjob.FieldMaxAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CompressionJobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case compressionjob.FieldProgress:
+		return m.AddedProgress()
+	case compressionjob.FieldTargetBytes:
+		return m.AddedTargetBytes()
+	case compressionjob.FieldSsim:
+		return m.AddedSsim()
+	case compressionjob.FieldPsnr:
+		return m.AddedPsnr()
+	case compressionjob.FieldVmaf:
+		return m.AddedVmaf()
+	case compressionjob.FieldPriority:
+		return m.AddedPriority()
+	case compressionjob.FieldAttempts:
+		return m.AddedAttempts()
+	case compressionjob.FieldMaxAttempts:
+		return m.AddedMaxAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CompressionJobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case compressionjob.FieldProgress:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProgress(v)
+		return nil
+	case compressionjob.FieldTargetBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTargetBytes(v)
+		return nil
+	case compressionjob.FieldSsim:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSsim(v)
+		return nil
+	case compressionjob.FieldPsnr:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPsnr(v)
+		return nil
+	case compressionjob.FieldVmaf:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVmaf(v)
+		return nil
+	case compressionjob.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriority(v)
+		return nil
+	case compressionjob.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case compressionjob.FieldMaxAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CompressionJob numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CompressionJobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(compressionjob.FieldError) {
+		fields = append(fields, compressionjob.FieldError)
+	}
+	if m.FieldCleared(compressionjob.FieldCreatedAt) {
+		fields = append(fields, compressionjob.FieldCreatedAt)
+	}
+	if m.FieldCleared(compressionjob.FieldStartedAt) {
+		fields = append(fields, compressionjob.FieldStartedAt)
+	}
+	if m.FieldCleared(compressionjob.FieldCompletedAt) {
+		fields = append(fields, compressionjob.FieldCompletedAt)
+	}
+	if m.FieldCleared(compressionjob.FieldProfile) {
+		fields = append(fields, compressionjob.FieldProfile)
+	}
+	if m.FieldCleared(compressionjob.FieldTargetFormat) {
+		fields = append(fields, compressionjob.FieldTargetFormat)
+	}
+	if m.FieldCleared(compressionjob.FieldAudioBitrate) {
+		fields = append(fields, compressionjob.FieldAudioBitrate)
+	}
+	if m.FieldCleared(compressionjob.FieldOutcome) {
+		fields = append(fields, compressionjob.FieldOutcome)
+	}
+	if m.FieldCleared(compressionjob.FieldSteps) {
+		fields = append(fields, compressionjob.FieldSteps)
+	}
+	if m.FieldCleared(compressionjob.FieldTargetBytes) {
+		fields = append(fields, compressionjob.FieldTargetBytes)
+	}
+	if m.FieldCleared(compressionjob.FieldTargetParams) {
+		fields = append(fields, compressionjob.FieldTargetParams)
+	}
+	if m.FieldCleared(compressionjob.FieldSsim) {
+		fields = append(fields, compressionjob.FieldSsim)
+	}
+	if m.FieldCleared(compressionjob.FieldPsnr) {
+		fields = append(fields, compressionjob.FieldPsnr)
+	}
+	if m.FieldCleared(compressionjob.FieldVmaf) {
+		fields = append(fields, compressionjob.FieldVmaf)
+	}
+	if m.FieldCleared(compressionjob.FieldEncoderParams) {
+		fields = append(fields, compressionjob.FieldEncoderParams)
+	}
+	if m.FieldCleared(compressionjob.FieldFfmpegVersion) {
+		fields = append(fields, compressionjob.FieldFfmpegVersion)
+	}
+	if m.FieldCleared(compressionjob.FieldCommands) {
+		fields = append(fields, compressionjob.FieldCommands)
+	}
+	if m.FieldCleared(compressionjob.FieldLog) {
+		fields = append(fields, compressionjob.FieldLog)
+	}
+	if m.FieldCleared(compressionjob.FieldPool) {
+		fields = append(fields, compressionjob.FieldPool)
+	}
+	if m.FieldCleared(compressionjob.FieldWorkerID) {
+		fields = append(fields, compressionjob.FieldWorkerID)
+	}
+	if m.FieldCleared(compressionjob.FieldLeaseExpiresAt) {
+		fields = append(fields, compressionjob.FieldLeaseExpiresAt)
+	}
+	if m.FieldCleared(compressionjob.FieldNextAttemptAt) {
+		fields = append(fields, compressionjob.FieldNextAttemptAt)
+	}
+	if m.FieldCleared(compressionjob.FieldAttemptErrors) {
+		fields = append(fields, compressionjob.FieldAttemptErrors)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CompressionJobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CompressionJobMutation) ClearField(name string) error {
+	switch name {
+	case compressionjob.FieldError:
+		m.ClearError()
+		return nil
+	case compressionjob.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case compressionjob.FieldStartedAt:
+		m.ClearStartedAt()
+		return nil
+	case compressionjob.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	case compressionjob.FieldProfile:
+		m.ClearProfile()
+		return nil
+	case compressionjob.FieldTargetFormat:
+		m.ClearTargetFormat()
+		return nil
+	case compressionjob.FieldAudioBitrate:
+		m.ClearAudioBitrate()
+		return nil
+	case compressionjob.FieldOutcome:
+		m.ClearOutcome()
+		return nil
+	case compressionjob.FieldSteps:
+		m.ClearSteps()
+		return nil
+	case compressionjob.FieldTargetBytes:
+		m.ClearTargetBytes()
+		return nil
+	case compressionjob.FieldTargetParams:
+		m.ClearTargetParams()
+		return nil
+	case compressionjob.FieldSsim:
+		m.ClearSsim()
+		return nil
+	case compressionjob.FieldPsnr:
+		m.ClearPsnr()
+		return nil
+	case compressionjob.FieldVmaf:
+		m.ClearVmaf()
+		return nil
+	case compressionjob.FieldEncoderParams:
+		m.ClearEncoderParams()
+		return nil
+	case compressionjob.FieldFfmpegVersion:
+		m.ClearFfmpegVersion()
+		return nil
+	case compressionjob.FieldCommands:
+		m.ClearCommands()
+		return nil
+	case compressionjob.FieldLog:
+		m.ClearLog()
+		return nil
+	case compressionjob.FieldPool:
+		m.ClearPool()
+		return nil
+	case compressionjob.FieldWorkerID:
+		m.ClearWorkerID()
+		return nil
+	case compressionjob.FieldLeaseExpiresAt:
+		m.ClearLeaseExpiresAt()
+		return nil
+	case compressionjob.FieldNextAttemptAt:
+		m.ClearNextAttemptAt()
+		return nil
+	case compressionjob.FieldAttemptErrors:
+		m.ClearAttemptErrors()
+		return nil
+	}
+	return fmt.Errorf("unknown CompressionJob nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CompressionJobMutation) ResetField(name string) error {
+	switch name {
+	case compressionjob.FieldKind:
+		m.ResetKind()
+		return nil
+	case compressionjob.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case compressionjob.FieldProgress:
+		m.ResetProgress()
+		return nil
+	case compressionjob.FieldError:
+		m.ResetError()
+		return nil
+	case compressionjob.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case compressionjob.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case compressionjob.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	case compressionjob.FieldProfile:
+		m.ResetProfile()
+		return nil
+	case compressionjob.FieldTargetFormat:
+		m.ResetTargetFormat()
+		return nil
+	case compressionjob.FieldAudioBitrate:
+		m.ResetAudioBitrate()
+		return nil
+	case compressionjob.FieldNormalize:
+		m.ResetNormalize()
+		return nil
+	case compressionjob.FieldOutcome:
+		m.ResetOutcome()
+		return nil
+	case compressionjob.FieldSteps:
+		m.ResetSteps()
+		return nil
+	case compressionjob.FieldTargetBytes:
+		m.ResetTargetBytes()
+		return nil
+	case compressionjob.FieldTargetParams:
+		m.ResetTargetParams()
+		return nil
+	case compressionjob.FieldSsim:
+		m.ResetSsim()
+		return nil
+	case compressionjob.FieldPsnr:
+		m.ResetPsnr()
+		return nil
+	case compressionjob.FieldVmaf:
+		m.ResetVmaf()
+		return nil
+	case compressionjob.FieldEncoderParams:
+		m.ResetEncoderParams()
+		return nil
+	case compressionjob.FieldFfmpegVersion:
+		m.ResetFfmpegVersion()
+		return nil
+	case compressionjob.FieldCommands:
+		m.ResetCommands()
+		return nil
+	case compressionjob.FieldLog:
+		m.ResetLog()
+		return nil
+	case compressionjob.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case compressionjob.FieldSubmitter:
+		m.ResetSubmitter()
+		return nil
+	case compressionjob.FieldPool:
+		m.ResetPool()
+		return nil
+	case compressionjob.FieldWorkerID:
+		m.ResetWorkerID()
+		return nil
+	case compressionjob.FieldLeaseExpiresAt:
+		m.ResetLeaseExpiresAt()
+		return nil
+	case compressionjob.FieldCancelRequested:
+		m.ResetCancelRequested()
+		return nil
+	case compressionjob.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case compressionjob.FieldMaxAttempts:
+		m.ResetMaxAttempts()
+		return nil
+	case compressionjob.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	case compressionjob.FieldAttemptErrors:
+		m.ResetAttemptErrors()
+		return nil
+	}
+	return fmt.Errorf("unknown CompressionJob field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CompressionJobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.asset != nil {
+		edges = append(edges, compressionjob.EdgeAsset)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CompressionJobMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case compressionjob.EdgeAsset:
+		if id := m.asset; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CompressionJobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CompressionJobMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CompressionJobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedasset {
+		edges = append(edges, compressionjob.EdgeAsset)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CompressionJobMutation) EdgeCleared(name string) bool {
+	switch name {
+	case compressionjob.EdgeAsset:
+		return m.clearedasset
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CompressionJobMutation) ClearEdge(name string) error {
+	switch name {
+	case compressionjob.EdgeAsset:
+		m.ClearAsset()
+		return nil
+	}
+	return fmt.Errorf("unknown CompressionJob unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CompressionJobMutation) ResetEdge(name string) error {
+	switch name {
+	case compressionjob.EdgeAsset:
+		m.ResetAsset()
+		return nil
+	}
+	return fmt.Errorf("unknown CompressionJob edge %s", name)
+}
+
+// RuleMutation represents an operation that mutates the Rule nodes in the graph.
+type RuleMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *string
+	name              *string
+	enabled           *bool
+	created_at        *time.Time
+	file_type         *string
+	extension         *string
+	min_size_bytes    *int64
+	addmin_size_bytes *int64
+	tag_id            *string
+	kind              *string
+	profile           *string
+	fire_count        *int
+	addfire_count     *int
+	last_fired_at     *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*Rule, error)
+	predicates        []predicate.Rule
+}
+
+var _ ent.Mutation = (*RuleMutation)(nil)
+
+// ruleOption allows management of the mutation configuration using functional options.
+type ruleOption func(*RuleMutation)
+
+// newRuleMutation creates new mutation for the Rule entity.
+func newRuleMutation(c config, op Op, opts ...ruleOption) *RuleMutation {
+	m := &RuleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRule,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRuleID sets the ID field of the mutation.
+func withRuleID(id string) ruleOption {
+	return func(m *RuleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Rule
+		)
+		m.oldValue = func(ctx context.Context) (*Rule, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Rule.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRule sets the old Rule of the mutation.
+func withRule(node *Rule) ruleOption {
+	return func(m *RuleMutation) {
+		m.oldValue = func(context.Context) (*Rule, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RuleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RuleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Rule entities.
+func (m *RuleMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RuleMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RuleMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Rule.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *RuleMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *RuleMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Rule entity.
+// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *RuleMutation) ResetName() {
+	m.name = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *RuleMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *RuleMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the Rule entity.
+// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *RuleMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RuleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RuleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Rule entity.
+// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RuleMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetFileType sets the "file_type" field.
+func (m *RuleMutation) SetFileType(s string) {
+	m.file_type = &s
+}
+
+// FileType returns the value of the "file_type" field in the mutation.
+func (m *RuleMutation) FileType() (r string, exists bool) {
+	v := m.file_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileType returns the old "file_type" field's value of the Rule entity.
+// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleMutation) OldFileType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileType: %w", err)
+	}
+	return oldValue.FileType, nil
+}
+
+// ClearFileType clears the value of the "file_type" field.
+func (m *RuleMutation) ClearFileType() {
+	m.file_type = nil
+	m.clearedFields[rule.FieldFileType] = struct{}{}
+}
+
+// FileTypeCleared returns if the "file_type" field was cleared in this mutation.
+func (m *RuleMutation) FileTypeCleared() bool {
+	_, ok := m.clearedFields[rule.FieldFileType]
+	return ok
+}
+
+// ResetFileType resets all changes to the "file_type" field.
+func (m *RuleMutation) ResetFileType() {
+	m.file_type = nil
+	delete(m.clearedFields, rule.FieldFileType)
+}
+
+// SetExtension sets the "extension" field.
+func (m *RuleMutation) SetExtension(s string) {
+	m.extension = &s
+}
+
+// Extension returns the value of the "extension" field in the mutation.
+func (m *RuleMutation) Extension() (r string, exists bool) {
+	v := m.extension
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExtension returns the old "extension" field's value of the Rule entity.
+// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleMutation) OldExtension(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExtension is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExtension requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExtension: %w", err)
+	}
+	return oldValue.Extension, nil
+}
+
+// ClearExtension clears the value of the "extension" field.
+func (m *RuleMutation) ClearExtension() {
+	m.extension = nil
+	m.clearedFields[rule.FieldExtension] = struct{}{}
+}
+
+// ExtensionCleared returns if the "extension" field was cleared in this mutation.
+func (m *RuleMutation) ExtensionCleared() bool {
+	_, ok := m.clearedFields[rule.FieldExtension]
+	return ok
+}
+
+// ResetExtension resets all changes to the "extension" field.
+func (m *RuleMutation) ResetExtension() {
+	m.extension = nil
+	delete(m.clearedFields, rule.FieldExtension)
+}
+
+// SetMinSizeBytes sets the "min_size_bytes" field.
+func (m *RuleMutation) SetMinSizeBytes(i int64) {
+	m.min_size_bytes = &i
+	m.addmin_size_bytes = nil
+}
+
+// MinSizeBytes returns the value of the "min_size_bytes" field in the mutation.
+func (m *RuleMutation) MinSizeBytes() (r int64, exists bool) {
+	v := m.min_size_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMinSizeBytes returns the old "min_size_bytes" field's value of the Rule entity.
+// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleMutation) OldMinSizeBytes(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMinSizeBytes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMinSizeBytes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMinSizeBytes: %w", err)
+	}
+	return oldValue.MinSizeBytes, nil
+}
+
+// AddMinSizeBytes adds i to the "min_size_bytes" field.
+func (m *RuleMutation) AddMinSizeBytes(i int64) {
+	if m.addmin_size_bytes != nil {
+		*m.addmin_size_bytes += i
+	} else {
+		m.addmin_size_bytes = &i
+	}
+}
+
+// AddedMinSizeBytes returns the value that was added to the "min_size_bytes" field in this mutation.
+func (m *RuleMutation) AddedMinSizeBytes() (r int64, exists bool) {
+	v := m.addmin_size_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMinSizeBytes clears the value of the "min_size_bytes" field.
+func (m *RuleMutation) ClearMinSizeBytes() {
+	m.min_size_bytes = nil
+	m.addmin_size_bytes = nil
+	m.clearedFields[rule.FieldMinSizeBytes] = struct{}{}
+}
+
+// MinSizeBytesCleared returns if the "min_size_bytes" field was cleared in this mutation.
+func (m *RuleMutation) MinSizeBytesCleared() bool {
+	_, ok := m.clearedFields[rule.FieldMinSizeBytes]
+	return ok
+}
+
+// ResetMinSizeBytes resets all changes to the "min_size_bytes" field.
+func (m *RuleMutation) ResetMinSizeBytes() {
+	m.min_size_bytes = nil
+	m.addmin_size_bytes = nil
+	delete(m.clearedFields, rule.FieldMinSizeBytes)
+}
+
+// SetTagID sets the "tag_id" field.
+func (m *RuleMutation) SetTagID(s string) {
+	m.tag_id = &s
+}
+
+// TagID returns the value of the "tag_id" field in the mutation.
+func (m *RuleMutation) TagID() (r string, exists bool) {
+	v := m.tag_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTagID returns the old "tag_id" field's value of the Rule entity.
+// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleMutation) OldTagID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTagID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTagID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTagID: %w", err)
+	}
+	return oldValue.TagID, nil
+}
+
+// ClearTagID clears the value of the "tag_id" field.
+func (m *RuleMutation) ClearTagID() {
+	m.tag_id = nil
+	m.clearedFields[rule.FieldTagID] = struct{}{}
+}
+
+// TagIDCleared returns if the "tag_id" field was cleared in this mutation.
+func (m *RuleMutation) TagIDCleared() bool {
+	_, ok := m.clearedFields[rule.FieldTagID]
+	return ok
+}
+
+// ResetTagID resets all changes to the "tag_id" field.
+func (m *RuleMutation) ResetTagID() {
+	m.tag_id = nil
+	delete(m.clearedFields, rule.FieldTagID)
+}
+
+// SetKind sets the "kind" field.
+func (m *RuleMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *RuleMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the Rule entity.
+// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *RuleMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetProfile sets the "profile" field.
+func (m *RuleMutation) SetProfile(s string) {
+	m.profile = &s
+}
+
+// Profile returns the value of the "profile" field in the mutation.
+func (m *RuleMutation) Profile() (r string, exists bool) {
+	v := m.profile
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProfile returns the old "profile" field's value of the Rule entity.
+// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleMutation) OldProfile(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProfile is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProfile requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProfile: %w", err)
+	}
+	return oldValue.Profile, nil
+}
+
+// ClearProfile clears the value of the "profile" field.
+func (m *RuleMutation) ClearProfile() {
+	m.profile = nil
+	m.clearedFields[rule.FieldProfile] = struct{}{}
+}
+
+// ProfileCleared returns if the "profile" field was cleared in this mutation.
+func (m *RuleMutation) ProfileCleared() bool {
+	_, ok := m.clearedFields[rule.FieldProfile]
+	return ok
+}
+
+// ResetProfile resets all changes to the "profile" field.
+func (m *RuleMutation) ResetProfile() {
+	m.profile = nil
+	delete(m.clearedFields, rule.FieldProfile)
+}
+
+// SetFireCount sets the "fire_count" field.
+func (m *RuleMutation) SetFireCount(i int) {
+	m.fire_count = &i
+	m.addfire_count = nil
+}
+
+// FireCount returns the value of the "fire_count" field in the mutation.
+func (m *RuleMutation) FireCount() (r int, exists bool) {
+	v := m.fire_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFireCount returns the old "fire_count" field's value of the Rule entity.
+// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleMutation) OldFireCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFireCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFireCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFireCount: %w", err)
+	}
+	return oldValue.FireCount, nil
+}
+
+// AddFireCount adds i to the "fire_count" field.
+func (m *RuleMutation) AddFireCount(i int) {
+	if m.addfire_count != nil {
+		*m.addfire_count += i
+	} else {
+		m.addfire_count = &i
+	}
+}
+
+// AddedFireCount returns the value that was added to the "fire_count" field in this mutation.
+func (m *RuleMutation) AddedFireCount() (r int, exists bool) {
+	v := m.addfire_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFireCount resets all changes to the "fire_count" field.
+func (m *RuleMutation) ResetFireCount() {
+	m.fire_count = nil
+	m.addfire_count = nil
+}
+
+// SetLastFiredAt sets the "last_fired_at" field.
+func (m *RuleMutation) SetLastFiredAt(t time.Time) {
+	m.last_fired_at = &t
+}
+
+// LastFiredAt returns the value of the "last_fired_at" field in the mutation.
+func (m *RuleMutation) LastFiredAt() (r time.Time, exists bool) {
+	v := m.last_fired_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastFiredAt returns the old "last_fired_at" field's value of the Rule entity.
+// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleMutation) OldLastFiredAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastFiredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastFiredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastFiredAt: %w", err)
+	}
+	return oldValue.LastFiredAt, nil
+}
+
+// ClearLastFiredAt clears the value of the "last_fired_at" field.
+func (m *RuleMutation) ClearLastFiredAt() {
+	m.last_fired_at = nil
+	m.clearedFields[rule.FieldLastFiredAt] = struct{}{}
+}
+
+// LastFiredAtCleared returns if the "last_fired_at" field was cleared in this mutation.
+func (m *RuleMutation) LastFiredAtCleared() bool {
+	_, ok := m.clearedFields[rule.FieldLastFiredAt]
+	return ok
+}
+
+// ResetLastFiredAt resets all changes to the "last_fired_at" field.
+func (m *RuleMutation) ResetLastFiredAt() {
+	m.last_fired_at = nil
+	delete(m.clearedFields, rule.FieldLastFiredAt)
+}
+
+// Where appends a list predicates to the RuleMutation builder.
+func (m *RuleMutation) Where(ps ...predicate.Rule) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RuleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Rule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RuleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RuleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Rule).
+func (m *RuleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RuleMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.name != nil {
+		fields = append(fields, rule.FieldName)
+	}
+	if m.enabled != nil {
+		fields = append(fields, rule.FieldEnabled)
+	}
+	if m.created_at != nil {
+		fields = append(fields, rule.FieldCreatedAt)
+	}
+	if m.file_type != nil {
+		fields = append(fields, rule.FieldFileType)
+	}
+	if m.extension != nil {
+		fields = append(fields, rule.FieldExtension)
+	}
+	if m.min_size_bytes != nil {
+		fields = append(fields, rule.FieldMinSizeBytes)
+	}
+	if m.tag_id != nil {
+		fields = append(fields, rule.FieldTagID)
+	}
+	if m.kind != nil {
+		fields = append(fields, rule.FieldKind)
+	}
+	if m.profile != nil {
+		fields = append(fields, rule.FieldProfile)
+	}
+	if m.fire_count != nil {
+		fields = append(fields, rule.FieldFireCount)
+	}
+	if m.last_fired_at != nil {
+		fields = append(fields, rule.FieldLastFiredAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RuleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case rule.FieldName:
+		return m.Name()
+	case rule.FieldEnabled:
+		return m.Enabled()
+	case rule.FieldCreatedAt:
+		return m.CreatedAt()
+	case rule.FieldFileType:
+		return m.FileType()
+	case rule.FieldExtension:
+		return m.Extension()
+	case rule.FieldMinSizeBytes:
+		return m.MinSizeBytes()
+	case rule.FieldTagID:
+		return m.TagID()
+	case rule.FieldKind:
+		return m.Kind()
+	case rule.FieldProfile:
+		return m.Profile()
+	case rule.FieldFireCount:
+		return m.FireCount()
+	case rule.FieldLastFiredAt:
+		return m.LastFiredAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case rule.FieldName:
+		return m.OldName(ctx)
+	case rule.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case rule.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case rule.FieldFileType:
+		return m.OldFileType(ctx)
+	case rule.FieldExtension:
+		return m.OldExtension(ctx)
+	case rule.FieldMinSizeBytes:
+		return m.OldMinSizeBytes(ctx)
+	case rule.FieldTagID:
+		return m.OldTagID(ctx)
+	case rule.FieldKind:
+		return m.OldKind(ctx)
+	case rule.FieldProfile:
+		return m.OldProfile(ctx)
+	case rule.FieldFireCount:
+		return m.OldFireCount(ctx)
+	case rule.FieldLastFiredAt:
+		return m.OldLastFiredAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Rule field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RuleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case rule.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case rule.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case rule.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case rule.FieldFileType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileType(v)
+		return nil
+	case rule.FieldExtension:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExtension(v)
+		return nil
+	case rule.FieldMinSizeBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMinSizeBytes(v)
+		return nil
+	case rule.FieldTagID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagID(v)
+		return nil
+	case rule.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case rule.FieldProfile:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProfile(v)
+		return nil
+	case rule.FieldFireCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFireCount(v)
+		return nil
+	case rule.FieldLastFiredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastFiredAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Rule field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RuleMutation) AddedFields() []string {
+	var fields []string
+	if m.addmin_size_bytes != nil {
+		fields = append(fields, rule.FieldMinSizeBytes)
+	}
+	if m.addfire_count != nil {
+		fields = append(fields, rule.FieldFireCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RuleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case rule.FieldMinSizeBytes:
+		return m.AddedMinSizeBytes()
+	case rule.FieldFireCount:
+		return m.AddedFireCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RuleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case rule.FieldMinSizeBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMinSizeBytes(v)
+		return nil
+	case rule.FieldFireCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFireCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Rule numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RuleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(rule.FieldFileType) {
+		fields = append(fields, rule.FieldFileType)
+	}
+	if m.FieldCleared(rule.FieldExtension) {
+		fields = append(fields, rule.FieldExtension)
+	}
+	if m.FieldCleared(rule.FieldMinSizeBytes) {
+		fields = append(fields, rule.FieldMinSizeBytes)
+	}
+	if m.FieldCleared(rule.FieldTagID) {
+		fields = append(fields, rule.FieldTagID)
+	}
+	if m.FieldCleared(rule.FieldProfile) {
+		fields = append(fields, rule.FieldProfile)
+	}
+	if m.FieldCleared(rule.FieldLastFiredAt) {
+		fields = append(fields, rule.FieldLastFiredAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RuleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RuleMutation) ClearField(name string) error {
+	switch name {
+	case rule.FieldFileType:
+		m.ClearFileType()
+		return nil
+	case rule.FieldExtension:
+		m.ClearExtension()
+		return nil
+	case rule.FieldMinSizeBytes:
+		m.ClearMinSizeBytes()
+		return nil
+	case rule.FieldTagID:
+		m.ClearTagID()
+		return nil
+	case rule.FieldProfile:
+		m.ClearProfile()
+		return nil
+	case rule.FieldLastFiredAt:
+		m.ClearLastFiredAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Rule nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RuleMutation) ResetField(name string) error {
+	switch name {
+	case rule.FieldName:
+		m.ResetName()
+		return nil
+	case rule.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case rule.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case rule.FieldFileType:
+		m.ResetFileType()
+		return nil
+	case rule.FieldExtension:
+		m.ResetExtension()
+		return nil
+	case rule.FieldMinSizeBytes:
+		m.ResetMinSizeBytes()
+		return nil
+	case rule.FieldTagID:
+		m.ResetTagID()
+		return nil
+	case rule.FieldKind:
+		m.ResetKind()
+		return nil
+	case rule.FieldProfile:
+		m.ResetProfile()
+		return nil
+	case rule.FieldFireCount:
+		m.ResetFireCount()
+		return nil
+	case rule.FieldLastFiredAt:
+		m.ResetLastFiredAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Rule field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RuleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RuleMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RuleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RuleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RuleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RuleMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RuleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Rule unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RuleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Rule edge %s", name)
+}
+
+// TagMutation represents an operation that mutates the Tag nodes in the graph.
+type TagMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	name          *string
+	color         *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	assets        map[string]struct{}
+	removedassets map[string]struct{}
+	clearedassets bool
+	done          bool
+	oldValue      func(context.Context) (*Tag, error)
+	predicates    []predicate.Tag
+}
+
+var _ ent.Mutation = (*TagMutation)(nil)
+
+// tagOption allows management of the mutation configuration using functional options.
+type tagOption func(*TagMutation)
+
+// newTagMutation creates new mutation for the Tag entity.
+func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
+	m := &TagMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTag,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTagID sets the ID field of the mutation.
+func withTagID(id string) tagOption {
+	return func(m *TagMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Tag
+		)
+		m.oldValue = func(ctx context.Context) (*Tag, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Tag.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTag sets the old Tag of the mutation.
+func withTag(node *Tag) tagOption {
+	return func(m *TagMutation) {
+		m.oldValue = func(context.Context) (*Tag, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TagMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TagMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Tag entities.
+func (m *TagMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TagMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TagMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tag.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *TagMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TagMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TagMutation) ResetName() {
+	m.name = nil
+}
+
+// SetColor sets the "color" field.
+func (m *TagMutation) SetColor(s string) {
+	m.color = &s
+}
+
+// Color returns the value of the "color" field in the mutation.
+func (m *TagMutation) Color() (r string, exists bool) {
+	v := m.color
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColor returns the old "color" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldColor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColor: %w", err)
+	}
+	return oldValue.Color, nil
+}
+
+// ResetColor resets all changes to the "color" field.
+func (m *TagMutation) ResetColor() {
+	m.color = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TagMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TagMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TagMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddAssetIDs adds the "assets" edge to the Asset entity by ids.
+func (m *TagMutation) AddAssetIDs(ids ...string) {
+	if m.assets == nil {
+		m.assets = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.assets[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAssets clears the "assets" edge to the Asset entity.
+func (m *TagMutation) ClearAssets() {
+	m.clearedassets = true
+}
+
+// AssetsCleared reports if the "assets" edge to the Asset entity was cleared.
+func (m *TagMutation) AssetsCleared() bool {
+	return m.clearedassets
+}
+
+// RemoveAssetIDs removes the "assets" edge to the Asset entity by IDs.
+func (m *TagMutation) RemoveAssetIDs(ids ...string) {
+	if m.removedassets == nil {
+		m.removedassets = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.assets, ids[i])
+		m.removedassets[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAssets returns the removed IDs of the "assets" edge to the Asset entity.
+func (m *TagMutation) RemovedAssetsIDs() (ids []string) {
+	for id := range m.removedassets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AssetsIDs returns the "assets" edge IDs in the mutation.
+func (m *TagMutation) AssetsIDs() (ids []string) {
+	for id := range m.assets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAssets resets all changes to the "assets" edge.
+func (m *TagMutation) ResetAssets() {
+	m.assets = nil
+	m.clearedassets = false
+	m.removedassets = nil
+}
+
+// Where appends a list predicates to the TagMutation builder.
+func (m *TagMutation) Where(ps ...predicate.Tag) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TagMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tag, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TagMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TagMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Tag).
+func (m *TagMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TagMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.name != nil {
+		fields = append(fields, tag.FieldName)
+	}
+	if m.color != nil {
+		fields = append(fields, tag.FieldColor)
+	}
+	if m.created_at != nil {
+		fields = append(fields, tag.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TagMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tag.FieldName:
+		return m.Name()
+	case tag.FieldColor:
+		return m.Color()
+	case tag.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tag.FieldName:
+		return m.OldName(ctx)
+	case tag.FieldColor:
+		return m.OldColor(ctx)
+	case tag.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Tag field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CompressionJobMutation) SetField(name string, value ent.Value) error {
+func (m *TagMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case compressionjob.FieldStatus:
+	case tag.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
-		return nil
-	case compressionjob.FieldProgress:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProgress(v)
+		m.SetName(v)
 		return nil
-	case compressionjob.FieldError:
+	case tag.FieldColor:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetError(v)
-		return nil
-	case compressionjob.FieldStartedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStartedAt(v)
+		m.SetColor(v)
 		return nil
-	case compressionjob.FieldCompletedAt:
+	case tag.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCompletedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown CompressionJob field %s", name)
+	return fmt.Errorf("unknown Tag field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CompressionJobMutation) AddedFields() []string {
-	var fields []string
-	if m.addprogress != nil {
-		fields = append(fields, compressionjob.FieldProgress)
-	}
-	return fields
+func (m *TagMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CompressionJobMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case compressionjob.FieldProgress:
-		return m.AddedProgress()
-	}
+func (m *TagMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *CompressionJobMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case compressionjob.FieldProgress:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddProgress(v)
-		return nil
-	}
-	return fmt.Errorf("unknown CompressionJob numeric field %s", name)
-}
-
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *CompressionJobMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(compressionjob.FieldError) {
-		fields = append(fields, compressionjob.FieldError)
-	}
-	if m.FieldCleared(compressionjob.FieldStartedAt) {
-		fields = append(fields, compressionjob.FieldStartedAt)
-	}
-	if m.FieldCleared(compressionjob.FieldCompletedAt) {
-		fields = append(fields, compressionjob.FieldCompletedAt)
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TagMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return fields
+	return fmt.Errorf("unknown Tag numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TagMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CompressionJobMutation) FieldCleared(name string) bool {
+func (m *TagMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CompressionJobMutation) ClearField(name string) error {
-	switch name {
-	case compressionjob.FieldError:
-		m.ClearError()
-		return nil
-	case compressionjob.FieldStartedAt:
-		m.ClearStartedAt()
-		return nil
-	case compressionjob.FieldCompletedAt:
-		m.ClearCompletedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown CompressionJob nullable field %s", name)
+func (m *TagMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Tag nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CompressionJobMutation) ResetField(name string) error {
+func (m *TagMutation) ResetField(name string) error {
 	switch name {
-	case compressionjob.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case compressionjob.FieldProgress:
-		m.ResetProgress()
-		return nil
-	case compressionjob.FieldError:
-		m.ResetError()
+	case tag.FieldName:
+		m.ResetName()
 		return nil
-	case compressionjob.FieldStartedAt:
-		m.ResetStartedAt()
+	case tag.FieldColor:
+		m.ResetColor()
 		return nil
-	case compressionjob.FieldCompletedAt:
-		m.ResetCompletedAt()
+	case tag.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown CompressionJob field %s", name)
+	return fmt.Errorf("unknown Tag field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CompressionJobMutation) AddedEdges() []string {
+func (m *TagMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.asset != nil {
-		edges = append(edges, compressionjob.EdgeAsset)
+	if m.assets != nil {
+		edges = append(edges, tag.EdgeAssets)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CompressionJobMutation) AddedIDs(name string) []ent.Value {
+func (m *TagMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case compressionjob.EdgeAsset:
-		if id := m.asset; id != nil {
-			return []ent.Value{*id}
+	case tag.EdgeAssets:
+		ids := make([]ent.Value, 0, len(m.assets))
+		for id := range m.assets {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CompressionJobMutation) RemovedEdges() []string {
+func (m *TagMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
+	if m.removedassets != nil {
+		edges = append(edges, tag.EdgeAssets)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CompressionJobMutation) RemovedIDs(name string) []ent.Value {
+func (m *TagMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case tag.EdgeAssets:
+		ids := make([]ent.Value, 0, len(m.removedassets))
+		for id := range m.removedassets {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CompressionJobMutation) ClearedEdges() []string {
+func (m *TagMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedasset {
-		edges = append(edges, compressionjob.EdgeAsset)
+	if m.clearedassets {
+		edges = append(edges, tag.EdgeAssets)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CompressionJobMutation) EdgeCleared(name string) bool {
+func (m *TagMutation) EdgeCleared(name string) bool {
 	switch name {
-	case compressionjob.EdgeAsset:
-		return m.clearedasset
+	case tag.EdgeAssets:
+		return m.clearedassets
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CompressionJobMutation) ClearEdge(name string) error {
+func (m *TagMutation) ClearEdge(name string) error {
 	switch name {
-	case compressionjob.EdgeAsset:
-		m.ClearAsset()
-		return nil
 	}
-	return fmt.Errorf("unknown CompressionJob unique edge %s", name)
+	return fmt.Errorf("unknown Tag unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CompressionJobMutation) ResetEdge(name string) error {
+func (m *TagMutation) ResetEdge(name string) error {
 	switch name {
-	case compressionjob.EdgeAsset:
-		m.ResetAsset()
+	case tag.EdgeAssets:
+		m.ResetAssets()
 		return nil
 	}
-	return fmt.Errorf("unknown CompressionJob edge %s", name)
+	return fmt.Errorf("unknown Tag edge %s", name)
 }
 
-// TagMutation represents an operation that mutates the Tag nodes in the graph.
-type TagMutation struct {
+// VariantMutation represents an operation that mutates the Variant nodes in the graph.
+type VariantMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *string
-	name          *string
-	color         *string
-	created_at    *time.Time
-	clearedFields map[string]struct{}
-	assets        map[string]struct{}
-	removedassets map[string]struct{}
-	clearedassets bool
-	done          bool
-	oldValue      func(context.Context) (*Tag, error)
-	predicates    []predicate.Tag
-}
-
-var _ ent.Mutation = (*TagMutation)(nil)
-
-// tagOption allows management of the mutation configuration using functional options.
-type tagOption func(*TagMutation)
-
-// newTagMutation creates new mutation for the Tag entity.
-func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
-	m := &TagMutation{
+	op                 Op
+	typ                string
+	id                 *string
+	kind               *string
+	extension          *string
+	mime_type          *string
+	file_size_bytes    *int64
+	addfile_size_bytes *int64
+	storage_path       *string
+	created_at         *time.Time
+	clearedFields      map[string]struct{}
+	asset              *string
+	clearedasset       bool
+	done               bool
+	oldValue           func(context.Context) (*Variant, error)
+	predicates         []predicate.Variant
+}
+
+var _ ent.Mutation = (*VariantMutation)(nil)
+
+// variantOption allows management of the mutation configuration using functional options.
+type variantOption func(*VariantMutation)
+
+// newVariantMutation creates new mutation for the Variant entity.
+func newVariantMutation(c config, op Op, opts ...variantOption) *VariantMutation {
+	m := &VariantMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTag,
+		typ:           TypeVariant,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1830,20 +6091,20 @@ func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
 	return m
 }
 
-// withTagID sets the ID field of the mutation.
-func withTagID(id string) tagOption {
-	return func(m *TagMutation) {
+// withVariantID sets the ID field of the mutation.
+func withVariantID(id string) variantOption {
+	return func(m *VariantMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Tag
+			value *Variant
 		)
-		m.oldValue = func(ctx context.Context) (*Tag, error) {
+		m.oldValue = func(ctx context.Context) (*Variant, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Tag.Get(ctx, id)
+					value, err = m.Client().Variant.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1852,10 +6113,10 @@ func withTagID(id string) tagOption {
 	}
 }
 
-// withTag sets the old Tag of the mutation.
-func withTag(node *Tag) tagOption {
-	return func(m *TagMutation) {
-		m.oldValue = func(context.Context) (*Tag, error) {
+// withVariant sets the old Variant of the mutation.
+func withVariant(node *Variant) variantOption {
+	return func(m *VariantMutation) {
+		m.oldValue = func(context.Context) (*Variant, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1864,7 +6125,7 @@ func withTag(node *Tag) tagOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TagMutation) Client() *Client {
+func (m VariantMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1872,7 +6133,7 @@ func (m TagMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TagMutation) Tx() (*Tx, error) {
+func (m VariantMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1882,14 +6143,14 @@ func (m TagMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Tag entities.
-func (m *TagMutation) SetID(id string) {
+// operation is only accepted on creation of Variant entities.
+func (m *VariantMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TagMutation) ID() (id string, exists bool) {
+func (m *VariantMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1900,7 +6161,7 @@ func (m *TagMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TagMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *VariantMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1909,91 +6170,232 @@ func (m *TagMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Tag.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Variant.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *TagMutation) SetName(s string) {
-	m.name = &s
+// SetKind sets the "kind" field.
+func (m *VariantMutation) SetKind(s string) {
+	m.kind = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *TagMutation) Name() (r string, exists bool) {
-	v := m.name
+// Kind returns the value of the "kind" field in the mutation.
+func (m *VariantMutation) Kind() (r string, exists bool) {
+	v := m.kind
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Tag entity.
-// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// OldKind returns the old "kind" field's value of the Variant entity.
+// If the Variant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TagMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *VariantMutation) OldKind(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *VariantMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetExtension sets the "extension" field.
+func (m *VariantMutation) SetExtension(s string) {
+	m.extension = &s
+}
+
+// Extension returns the value of the "extension" field in the mutation.
+func (m *VariantMutation) Extension() (r string, exists bool) {
+	v := m.extension
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExtension returns the old "extension" field's value of the Variant entity.
+// If the Variant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VariantMutation) OldExtension(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExtension is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExtension requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExtension: %w", err)
+	}
+	return oldValue.Extension, nil
+}
+
+// ResetExtension resets all changes to the "extension" field.
+func (m *VariantMutation) ResetExtension() {
+	m.extension = nil
+}
+
+// SetMimeType sets the "mime_type" field.
+func (m *VariantMutation) SetMimeType(s string) {
+	m.mime_type = &s
+}
+
+// MimeType returns the value of the "mime_type" field in the mutation.
+func (m *VariantMutation) MimeType() (r string, exists bool) {
+	v := m.mime_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMimeType returns the old "mime_type" field's value of the Variant entity.
+// If the Variant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VariantMutation) OldMimeType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMimeType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMimeType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMimeType: %w", err)
+	}
+	return oldValue.MimeType, nil
+}
+
+// ClearMimeType clears the value of the "mime_type" field.
+func (m *VariantMutation) ClearMimeType() {
+	m.mime_type = nil
+	m.clearedFields[variant.FieldMimeType] = struct{}{}
+}
+
+// MimeTypeCleared returns if the "mime_type" field was cleared in this mutation.
+func (m *VariantMutation) MimeTypeCleared() bool {
+	_, ok := m.clearedFields[variant.FieldMimeType]
+	return ok
+}
+
+// ResetMimeType resets all changes to the "mime_type" field.
+func (m *VariantMutation) ResetMimeType() {
+	m.mime_type = nil
+	delete(m.clearedFields, variant.FieldMimeType)
+}
+
+// SetFileSizeBytes sets the "file_size_bytes" field.
+func (m *VariantMutation) SetFileSizeBytes(i int64) {
+	m.file_size_bytes = &i
+	m.addfile_size_bytes = nil
+}
+
+// FileSizeBytes returns the value of the "file_size_bytes" field in the mutation.
+func (m *VariantMutation) FileSizeBytes() (r int64, exists bool) {
+	v := m.file_size_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileSizeBytes returns the old "file_size_bytes" field's value of the Variant entity.
+// If the Variant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VariantMutation) OldFileSizeBytes(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileSizeBytes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileSizeBytes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileSizeBytes: %w", err)
+	}
+	return oldValue.FileSizeBytes, nil
+}
+
+// AddFileSizeBytes adds i to the "file_size_bytes" field.
+func (m *VariantMutation) AddFileSizeBytes(i int64) {
+	if m.addfile_size_bytes != nil {
+		*m.addfile_size_bytes += i
+	} else {
+		m.addfile_size_bytes = &i
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+}
+
+// AddedFileSizeBytes returns the value that was added to the "file_size_bytes" field in this mutation.
+func (m *VariantMutation) AddedFileSizeBytes() (r int64, exists bool) {
+	v := m.addfile_size_bytes
+	if v == nil {
+		return
 	}
-	return oldValue.Name, nil
+	return *v, true
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *TagMutation) ResetName() {
-	m.name = nil
+// ResetFileSizeBytes resets all changes to the "file_size_bytes" field.
+func (m *VariantMutation) ResetFileSizeBytes() {
+	m.file_size_bytes = nil
+	m.addfile_size_bytes = nil
 }
 
-// SetColor sets the "color" field.
-func (m *TagMutation) SetColor(s string) {
-	m.color = &s
+// SetStoragePath sets the "storage_path" field.
+func (m *VariantMutation) SetStoragePath(s string) {
+	m.storage_path = &s
 }
 
-// Color returns the value of the "color" field in the mutation.
-func (m *TagMutation) Color() (r string, exists bool) {
-	v := m.color
+// StoragePath returns the value of the "storage_path" field in the mutation.
+func (m *VariantMutation) StoragePath() (r string, exists bool) {
+	v := m.storage_path
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldColor returns the old "color" field's value of the Tag entity.
-// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// OldStoragePath returns the old "storage_path" field's value of the Variant entity.
+// If the Variant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TagMutation) OldColor(ctx context.Context) (v string, err error) {
+func (m *VariantMutation) OldStoragePath(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldColor is only allowed on UpdateOne operations")
+		return v, errors.New("OldStoragePath is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldColor requires an ID field in the mutation")
+		return v, errors.New("OldStoragePath requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldColor: %w", err)
+		return v, fmt.Errorf("querying old value for OldStoragePath: %w", err)
 	}
-	return oldValue.Color, nil
+	return oldValue.StoragePath, nil
 }
 
-// ResetColor resets all changes to the "color" field.
-func (m *TagMutation) ResetColor() {
-	m.color = nil
+// ResetStoragePath resets all changes to the "storage_path" field.
+func (m *VariantMutation) ResetStoragePath() {
+	m.storage_path = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *TagMutation) SetCreatedAt(t time.Time) {
+func (m *VariantMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TagMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *VariantMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2001,10 +6403,10 @@ func (m *TagMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Tag entity.
-// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Variant entity.
+// If the Variant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TagMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *VariantMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2019,73 +6421,58 @@ func (m *TagMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error)
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TagMutation) ResetCreatedAt() {
+func (m *VariantMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// AddAssetIDs adds the "assets" edge to the Asset entity by ids.
-func (m *TagMutation) AddAssetIDs(ids ...string) {
-	if m.assets == nil {
-		m.assets = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.assets[ids[i]] = struct{}{}
-	}
-}
-
-// ClearAssets clears the "assets" edge to the Asset entity.
-func (m *TagMutation) ClearAssets() {
-	m.clearedassets = true
+// SetAssetID sets the "asset" edge to the Asset entity by id.
+func (m *VariantMutation) SetAssetID(id string) {
+	m.asset = &id
 }
 
-// AssetsCleared reports if the "assets" edge to the Asset entity was cleared.
-func (m *TagMutation) AssetsCleared() bool {
-	return m.clearedassets
+// ClearAsset clears the "asset" edge to the Asset entity.
+func (m *VariantMutation) ClearAsset() {
+	m.clearedasset = true
 }
 
-// RemoveAssetIDs removes the "assets" edge to the Asset entity by IDs.
-func (m *TagMutation) RemoveAssetIDs(ids ...string) {
-	if m.removedassets == nil {
-		m.removedassets = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.assets, ids[i])
-		m.removedassets[ids[i]] = struct{}{}
-	}
+// AssetCleared reports if the "asset" edge to the Asset entity was cleared.
+func (m *VariantMutation) AssetCleared() bool {
+	return m.clearedasset
 }
 
-// RemovedAssets returns the removed IDs of the "assets" edge to the Asset entity.
-func (m *TagMutation) RemovedAssetsIDs() (ids []string) {
-	for id := range m.removedassets {
-		ids = append(ids, id)
+// AssetID returns the "asset" edge ID in the mutation.
+func (m *VariantMutation) AssetID() (id string, exists bool) {
+	if m.asset != nil {
+		return *m.asset, true
 	}
 	return
 }
 
-// AssetsIDs returns the "assets" edge IDs in the mutation.
-func (m *TagMutation) AssetsIDs() (ids []string) {
-	for id := range m.assets {
-		ids = append(ids, id)
+// AssetIDs returns the "asset" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AssetID instead. It exists only for internal usage by the builders.
+func (m *VariantMutation) AssetIDs() (ids []string) {
+	if id := m.asset; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetAssets resets all changes to the "assets" edge.
-func (m *TagMutation) ResetAssets() {
-	m.assets = nil
-	m.clearedassets = false
-	m.removedassets = nil
+// ResetAsset resets all changes to the "asset" edge.
+func (m *VariantMutation) ResetAsset() {
+	m.asset = nil
+	m.clearedasset = false
 }
 
-// Where appends a list predicates to the TagMutation builder.
-func (m *TagMutation) Where(ps ...predicate.Tag) {
+// Where appends a list predicates to the VariantMutation builder.
+func (m *VariantMutation) Where(ps ...predicate.Variant) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TagMutation builder. Using this method,
+// WhereP appends storage-level predicates to the VariantMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TagMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Tag, len(ps))
+func (m *VariantMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Variant, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2093,33 +6480,42 @@ func (m *TagMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TagMutation) Op() Op {
+func (m *VariantMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TagMutation) SetOp(op Op) {
+func (m *VariantMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Tag).
-func (m *TagMutation) Type() string {
+// Type returns the node type of this mutation (Variant).
+func (m *VariantMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TagMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.name != nil {
-		fields = append(fields, tag.FieldName)
+func (m *VariantMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.kind != nil {
+		fields = append(fields, variant.FieldKind)
 	}
-	if m.color != nil {
-		fields = append(fields, tag.FieldColor)
+	if m.extension != nil {
+		fields = append(fields, variant.FieldExtension)
+	}
+	if m.mime_type != nil {
+		fields = append(fields, variant.FieldMimeType)
+	}
+	if m.file_size_bytes != nil {
+		fields = append(fields, variant.FieldFileSizeBytes)
+	}
+	if m.storage_path != nil {
+		fields = append(fields, variant.FieldStoragePath)
 	}
 	if m.created_at != nil {
-		fields = append(fields, tag.FieldCreatedAt)
+		fields = append(fields, variant.FieldCreatedAt)
 	}
 	return fields
 }
@@ -2127,13 +6523,19 @@ func (m *TagMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TagMutation) Field(name string) (ent.Value, bool) {
+func (m *VariantMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case tag.FieldName:
-		return m.Name()
-	case tag.FieldColor:
-		return m.Color()
-	case tag.FieldCreatedAt:
+	case variant.FieldKind:
+		return m.Kind()
+	case variant.FieldExtension:
+		return m.Extension()
+	case variant.FieldMimeType:
+		return m.MimeType()
+	case variant.FieldFileSizeBytes:
+		return m.FileSizeBytes()
+	case variant.FieldStoragePath:
+		return m.StoragePath()
+	case variant.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -2142,38 +6544,65 @@ func (m *TagMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *VariantMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case tag.FieldName:
-		return m.OldName(ctx)
-	case tag.FieldColor:
-		return m.OldColor(ctx)
-	case tag.FieldCreatedAt:
+	case variant.FieldKind:
+		return m.OldKind(ctx)
+	case variant.FieldExtension:
+		return m.OldExtension(ctx)
+	case variant.FieldMimeType:
+		return m.OldMimeType(ctx)
+	case variant.FieldFileSizeBytes:
+		return m.OldFileSizeBytes(ctx)
+	case variant.FieldStoragePath:
+		return m.OldStoragePath(ctx)
+	case variant.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Tag field %s", name)
+	return nil, fmt.Errorf("unknown Variant field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TagMutation) SetField(name string, value ent.Value) error {
+func (m *VariantMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case tag.FieldName:
+	case variant.FieldKind:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetKind(v)
 		return nil
-	case tag.FieldColor:
+	case variant.FieldExtension:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetColor(v)
+		m.SetExtension(v)
 		return nil
-	case tag.FieldCreatedAt:
+	case variant.FieldMimeType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMimeType(v)
+		return nil
+	case variant.FieldFileSizeBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileSizeBytes(v)
+		return nil
+	case variant.FieldStoragePath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStoragePath(v)
+		return nil
+	case variant.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -2181,147 +6610,170 @@ func (m *TagMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Tag field %s", name)
+	return fmt.Errorf("unknown Variant field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TagMutation) AddedFields() []string {
-	return nil
+func (m *VariantMutation) AddedFields() []string {
+	var fields []string
+	if m.addfile_size_bytes != nil {
+		fields = append(fields, variant.FieldFileSizeBytes)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TagMutation) AddedField(name string) (ent.Value, bool) {
+func (m *VariantMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case variant.FieldFileSizeBytes:
+		return m.AddedFileSizeBytes()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TagMutation) AddField(name string, value ent.Value) error {
+func (m *VariantMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case variant.FieldFileSizeBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFileSizeBytes(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Tag numeric field %s", name)
+	return fmt.Errorf("unknown Variant numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TagMutation) ClearedFields() []string {
-	return nil
+func (m *VariantMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(variant.FieldMimeType) {
+		fields = append(fields, variant.FieldMimeType)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TagMutation) FieldCleared(name string) bool {
+func (m *VariantMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TagMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Tag nullable field %s", name)
+func (m *VariantMutation) ClearField(name string) error {
+	switch name {
+	case variant.FieldMimeType:
+		m.ClearMimeType()
+		return nil
+	}
+	return fmt.Errorf("unknown Variant nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TagMutation) ResetField(name string) error {
+func (m *VariantMutation) ResetField(name string) error {
 	switch name {
-	case tag.FieldName:
-		m.ResetName()
+	case variant.FieldKind:
+		m.ResetKind()
 		return nil
-	case tag.FieldColor:
-		m.ResetColor()
+	case variant.FieldExtension:
+		m.ResetExtension()
 		return nil
-	case tag.FieldCreatedAt:
+	case variant.FieldMimeType:
+		m.ResetMimeType()
+		return nil
+	case variant.FieldFileSizeBytes:
+		m.ResetFileSizeBytes()
+		return nil
+	case variant.FieldStoragePath:
+		m.ResetStoragePath()
+		return nil
+	case variant.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Tag field %s", name)
+	return fmt.Errorf("unknown Variant field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TagMutation) AddedEdges() []string {
+func (m *VariantMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.assets != nil {
-		edges = append(edges, tag.EdgeAssets)
+	if m.asset != nil {
+		edges = append(edges, variant.EdgeAsset)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TagMutation) AddedIDs(name string) []ent.Value {
+func (m *VariantMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case tag.EdgeAssets:
-		ids := make([]ent.Value, 0, len(m.assets))
-		for id := range m.assets {
-			ids = append(ids, id)
+	case variant.EdgeAsset:
+		if id := m.asset; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TagMutation) RemovedEdges() []string {
+func (m *VariantMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removedassets != nil {
-		edges = append(edges, tag.EdgeAssets)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TagMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case tag.EdgeAssets:
-		ids := make([]ent.Value, 0, len(m.removedassets))
-		for id := range m.removedassets {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *VariantMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TagMutation) ClearedEdges() []string {
+func (m *VariantMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedassets {
-		edges = append(edges, tag.EdgeAssets)
+	if m.clearedasset {
+		edges = append(edges, variant.EdgeAsset)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TagMutation) EdgeCleared(name string) bool {
+func (m *VariantMutation) EdgeCleared(name string) bool {
 	switch name {
-	case tag.EdgeAssets:
-		return m.clearedassets
+	case variant.EdgeAsset:
+		return m.clearedasset
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TagMutation) ClearEdge(name string) error {
+func (m *VariantMutation) ClearEdge(name string) error {
 	switch name {
+	case variant.EdgeAsset:
+		m.ClearAsset()
+		return nil
 	}
-	return fmt.Errorf("unknown Tag unique edge %s", name)
+	return fmt.Errorf("unknown Variant unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TagMutation) ResetEdge(name string) error {
+func (m *VariantMutation) ResetEdge(name string) error {
 	switch name {
-	case tag.EdgeAssets:
-		m.ResetAssets()
+	case variant.EdgeAsset:
+		m.ResetAsset()
 		return nil
 	}
-	return fmt.Errorf("unknown Tag edge %s", name)
+	return fmt.Errorf("unknown Variant edge %s", name)
 }
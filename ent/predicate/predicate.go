@@ -12,5 +12,11 @@ type Asset func(*sql.Selector)
 // CompressionJob is the predicate function for compressionjob builders.
 type CompressionJob func(*sql.Selector)
 
+// Rule is the predicate function for rule builders.
+type Rule func(*sql.Selector)
+
 // Tag is the predicate function for tag builders.
 type Tag func(*sql.Selector)
+
+// Variant is the predicate function for variant builders.
+type Variant func(*sql.Selector)
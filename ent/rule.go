@@ -0,0 +1,221 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/adimail/asset-manager/ent/rule"
+)
+
+// Rule is the model entity for the Rule schema.
+type Rule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// FileType holds the value of the "file_type" field.
+	FileType string `json:"file_type,omitempty"`
+	// Extension holds the value of the "extension" field.
+	Extension string `json:"extension,omitempty"`
+	// MinSizeBytes holds the value of the "min_size_bytes" field.
+	MinSizeBytes int64 `json:"min_size_bytes,omitempty"`
+	// TagID holds the value of the "tag_id" field.
+	TagID string `json:"tag_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// Profile holds the value of the "profile" field.
+	Profile string `json:"profile,omitempty"`
+	// FireCount holds the value of the "fire_count" field.
+	FireCount int `json:"fire_count,omitempty"`
+	// LastFiredAt holds the value of the "last_fired_at" field.
+	LastFiredAt  *time.Time `json:"last_fired_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Rule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case rule.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case rule.FieldMinSizeBytes, rule.FieldFireCount:
+			values[i] = new(sql.NullInt64)
+		case rule.FieldID, rule.FieldName, rule.FieldFileType, rule.FieldExtension, rule.FieldTagID, rule.FieldKind, rule.FieldProfile:
+			values[i] = new(sql.NullString)
+		case rule.FieldCreatedAt, rule.FieldLastFiredAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Rule fields.
+func (_m *Rule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case rule.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case rule.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case rule.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case rule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case rule.FieldFileType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field file_type", values[i])
+			} else if value.Valid {
+				_m.FileType = value.String
+			}
+		case rule.FieldExtension:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field extension", values[i])
+			} else if value.Valid {
+				_m.Extension = value.String
+			}
+		case rule.FieldMinSizeBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_size_bytes", values[i])
+			} else if value.Valid {
+				_m.MinSizeBytes = value.Int64
+			}
+		case rule.FieldTagID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tag_id", values[i])
+			} else if value.Valid {
+				_m.TagID = value.String
+			}
+		case rule.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = value.String
+			}
+		case rule.FieldProfile:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field profile", values[i])
+			} else if value.Valid {
+				_m.Profile = value.String
+			}
+		case rule.FieldFireCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field fire_count", values[i])
+			} else if value.Valid {
+				_m.FireCount = int(value.Int64)
+			}
+		case rule.FieldLastFiredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_fired_at", values[i])
+			} else if value.Valid {
+				_m.LastFiredAt = new(time.Time)
+				*_m.LastFiredAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Rule.
+// This includes values selected through modifiers, order, etc.
+func (_m *Rule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Rule.
+// Note that you need to call Rule.Unwrap() before calling this method if this Rule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Rule) Update() *RuleUpdateOne {
+	return NewRuleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Rule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Rule) Unwrap() *Rule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Rule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Rule) String() string {
+	var builder strings.Builder
+	builder.WriteString("Rule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("file_type=")
+	builder.WriteString(_m.FileType)
+	builder.WriteString(", ")
+	builder.WriteString("extension=")
+	builder.WriteString(_m.Extension)
+	builder.WriteString(", ")
+	builder.WriteString("min_size_bytes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MinSizeBytes))
+	builder.WriteString(", ")
+	builder.WriteString("tag_id=")
+	builder.WriteString(_m.TagID)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(_m.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("profile=")
+	builder.WriteString(_m.Profile)
+	builder.WriteString(", ")
+	builder.WriteString("fire_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FireCount))
+	builder.WriteString(", ")
+	if v := _m.LastFiredAt; v != nil {
+		builder.WriteString("last_fired_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Rules is a parsable slice of Rule.
+type Rules []*Rule
@@ -0,0 +1,144 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the rule type in the database.
+	Label = "rule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldFileType holds the string denoting the file_type field in the database.
+	FieldFileType = "file_type"
+	// FieldExtension holds the string denoting the extension field in the database.
+	FieldExtension = "extension"
+	// FieldMinSizeBytes holds the string denoting the min_size_bytes field in the database.
+	FieldMinSizeBytes = "min_size_bytes"
+	// FieldTagID holds the string denoting the tag_id field in the database.
+	FieldTagID = "tag_id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldProfile holds the string denoting the profile field in the database.
+	FieldProfile = "profile"
+	// FieldFireCount holds the string denoting the fire_count field in the database.
+	FieldFireCount = "fire_count"
+	// FieldLastFiredAt holds the string denoting the last_fired_at field in the database.
+	FieldLastFiredAt = "last_fired_at"
+	// Table holds the table name of the rule in the database.
+	Table = "rules"
+)
+
+// Columns holds all SQL columns for rule fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldEnabled,
+	FieldCreatedAt,
+	FieldFileType,
+	FieldExtension,
+	FieldMinSizeBytes,
+	FieldTagID,
+	FieldKind,
+	FieldProfile,
+	FieldFireCount,
+	FieldLastFiredAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultKind holds the default value on creation for the "kind" field.
+	DefaultKind string
+	// DefaultFireCount holds the default value on creation for the "fire_count" field.
+	DefaultFireCount int
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+)
+
+// OrderOption defines the ordering options for the Rule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByFileType orders the results by the file_type field.
+func ByFileType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFileType, opts...).ToFunc()
+}
+
+// ByExtension orders the results by the extension field.
+func ByExtension(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExtension, opts...).ToFunc()
+}
+
+// ByMinSizeBytes orders the results by the min_size_bytes field.
+func ByMinSizeBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinSizeBytes, opts...).ToFunc()
+}
+
+// ByTagID orders the results by the tag_id field.
+func ByTagID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTagID, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByProfile orders the results by the profile field.
+func ByProfile(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProfile, opts...).ToFunc()
+}
+
+// ByFireCount orders the results by the fire_count field.
+func ByFireCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFireCount, opts...).ToFunc()
+}
+
+// ByLastFiredAt orders the results by the last_fired_at field.
+func ByLastFiredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastFiredAt, opts...).ToFunc()
+}
@@ -0,0 +1,755 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/adimail/asset-manager/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Rule {
+	return predicate.Rule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Rule {
+	return predicate.Rule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Rule {
+	return predicate.Rule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Rule {
+	return predicate.Rule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Rule {
+	return predicate.Rule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Rule {
+	return predicate.Rule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Rule {
+	return predicate.Rule(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Rule {
+	return predicate.Rule(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Rule {
+	return predicate.Rule(sql.FieldContainsFold(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldName, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// FileType applies equality check predicate on the "file_type" field. It's identical to FileTypeEQ.
+func FileType(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldFileType, v))
+}
+
+// Extension applies equality check predicate on the "extension" field. It's identical to ExtensionEQ.
+func Extension(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldExtension, v))
+}
+
+// MinSizeBytes applies equality check predicate on the "min_size_bytes" field. It's identical to MinSizeBytesEQ.
+func MinSizeBytes(v int64) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldMinSizeBytes, v))
+}
+
+// TagID applies equality check predicate on the "tag_id" field. It's identical to TagIDEQ.
+func TagID(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldTagID, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldKind, v))
+}
+
+// Profile applies equality check predicate on the "profile" field. It's identical to ProfileEQ.
+func Profile(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldProfile, v))
+}
+
+// FireCount applies equality check predicate on the "fire_count" field. It's identical to FireCountEQ.
+func FireCount(v int) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldFireCount, v))
+}
+
+// LastFiredAt applies equality check predicate on the "last_fired_at" field. It's identical to LastFiredAtEQ.
+func LastFiredAt(v time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldLastFiredAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Rule {
+	return predicate.Rule(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Rule {
+	return predicate.Rule(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldContainsFold(FieldName, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.Rule {
+	return predicate.Rule(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// FileTypeEQ applies the EQ predicate on the "file_type" field.
+func FileTypeEQ(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldFileType, v))
+}
+
+// FileTypeNEQ applies the NEQ predicate on the "file_type" field.
+func FileTypeNEQ(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldNEQ(FieldFileType, v))
+}
+
+// FileTypeIn applies the In predicate on the "file_type" field.
+func FileTypeIn(vs ...string) predicate.Rule {
+	return predicate.Rule(sql.FieldIn(FieldFileType, vs...))
+}
+
+// FileTypeNotIn applies the NotIn predicate on the "file_type" field.
+func FileTypeNotIn(vs ...string) predicate.Rule {
+	return predicate.Rule(sql.FieldNotIn(FieldFileType, vs...))
+}
+
+// FileTypeGT applies the GT predicate on the "file_type" field.
+func FileTypeGT(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldGT(FieldFileType, v))
+}
+
+// FileTypeGTE applies the GTE predicate on the "file_type" field.
+func FileTypeGTE(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldGTE(FieldFileType, v))
+}
+
+// FileTypeLT applies the LT predicate on the "file_type" field.
+func FileTypeLT(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldLT(FieldFileType, v))
+}
+
+// FileTypeLTE applies the LTE predicate on the "file_type" field.
+func FileTypeLTE(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldLTE(FieldFileType, v))
+}
+
+// FileTypeContains applies the Contains predicate on the "file_type" field.
+func FileTypeContains(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldContains(FieldFileType, v))
+}
+
+// FileTypeHasPrefix applies the HasPrefix predicate on the "file_type" field.
+func FileTypeHasPrefix(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldHasPrefix(FieldFileType, v))
+}
+
+// FileTypeHasSuffix applies the HasSuffix predicate on the "file_type" field.
+func FileTypeHasSuffix(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldHasSuffix(FieldFileType, v))
+}
+
+// FileTypeIsNil applies the IsNil predicate on the "file_type" field.
+func FileTypeIsNil() predicate.Rule {
+	return predicate.Rule(sql.FieldIsNull(FieldFileType))
+}
+
+// FileTypeNotNil applies the NotNil predicate on the "file_type" field.
+func FileTypeNotNil() predicate.Rule {
+	return predicate.Rule(sql.FieldNotNull(FieldFileType))
+}
+
+// FileTypeEqualFold applies the EqualFold predicate on the "file_type" field.
+func FileTypeEqualFold(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEqualFold(FieldFileType, v))
+}
+
+// FileTypeContainsFold applies the ContainsFold predicate on the "file_type" field.
+func FileTypeContainsFold(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldContainsFold(FieldFileType, v))
+}
+
+// ExtensionEQ applies the EQ predicate on the "extension" field.
+func ExtensionEQ(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldExtension, v))
+}
+
+// ExtensionNEQ applies the NEQ predicate on the "extension" field.
+func ExtensionNEQ(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldNEQ(FieldExtension, v))
+}
+
+// ExtensionIn applies the In predicate on the "extension" field.
+func ExtensionIn(vs ...string) predicate.Rule {
+	return predicate.Rule(sql.FieldIn(FieldExtension, vs...))
+}
+
+// ExtensionNotIn applies the NotIn predicate on the "extension" field.
+func ExtensionNotIn(vs ...string) predicate.Rule {
+	return predicate.Rule(sql.FieldNotIn(FieldExtension, vs...))
+}
+
+// ExtensionGT applies the GT predicate on the "extension" field.
+func ExtensionGT(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldGT(FieldExtension, v))
+}
+
+// ExtensionGTE applies the GTE predicate on the "extension" field.
+func ExtensionGTE(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldGTE(FieldExtension, v))
+}
+
+// ExtensionLT applies the LT predicate on the "extension" field.
+func ExtensionLT(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldLT(FieldExtension, v))
+}
+
+// ExtensionLTE applies the LTE predicate on the "extension" field.
+func ExtensionLTE(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldLTE(FieldExtension, v))
+}
+
+// ExtensionContains applies the Contains predicate on the "extension" field.
+func ExtensionContains(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldContains(FieldExtension, v))
+}
+
+// ExtensionHasPrefix applies the HasPrefix predicate on the "extension" field.
+func ExtensionHasPrefix(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldHasPrefix(FieldExtension, v))
+}
+
+// ExtensionHasSuffix applies the HasSuffix predicate on the "extension" field.
+func ExtensionHasSuffix(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldHasSuffix(FieldExtension, v))
+}
+
+// ExtensionIsNil applies the IsNil predicate on the "extension" field.
+func ExtensionIsNil() predicate.Rule {
+	return predicate.Rule(sql.FieldIsNull(FieldExtension))
+}
+
+// ExtensionNotNil applies the NotNil predicate on the "extension" field.
+func ExtensionNotNil() predicate.Rule {
+	return predicate.Rule(sql.FieldNotNull(FieldExtension))
+}
+
+// ExtensionEqualFold applies the EqualFold predicate on the "extension" field.
+func ExtensionEqualFold(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEqualFold(FieldExtension, v))
+}
+
+// ExtensionContainsFold applies the ContainsFold predicate on the "extension" field.
+func ExtensionContainsFold(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldContainsFold(FieldExtension, v))
+}
+
+// MinSizeBytesEQ applies the EQ predicate on the "min_size_bytes" field.
+func MinSizeBytesEQ(v int64) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldMinSizeBytes, v))
+}
+
+// MinSizeBytesNEQ applies the NEQ predicate on the "min_size_bytes" field.
+func MinSizeBytesNEQ(v int64) predicate.Rule {
+	return predicate.Rule(sql.FieldNEQ(FieldMinSizeBytes, v))
+}
+
+// MinSizeBytesIn applies the In predicate on the "min_size_bytes" field.
+func MinSizeBytesIn(vs ...int64) predicate.Rule {
+	return predicate.Rule(sql.FieldIn(FieldMinSizeBytes, vs...))
+}
+
+// MinSizeBytesNotIn applies the NotIn predicate on the "min_size_bytes" field.
+func MinSizeBytesNotIn(vs ...int64) predicate.Rule {
+	return predicate.Rule(sql.FieldNotIn(FieldMinSizeBytes, vs...))
+}
+
+// MinSizeBytesGT applies the GT predicate on the "min_size_bytes" field.
+func MinSizeBytesGT(v int64) predicate.Rule {
+	return predicate.Rule(sql.FieldGT(FieldMinSizeBytes, v))
+}
+
+// MinSizeBytesGTE applies the GTE predicate on the "min_size_bytes" field.
+func MinSizeBytesGTE(v int64) predicate.Rule {
+	return predicate.Rule(sql.FieldGTE(FieldMinSizeBytes, v))
+}
+
+// MinSizeBytesLT applies the LT predicate on the "min_size_bytes" field.
+func MinSizeBytesLT(v int64) predicate.Rule {
+	return predicate.Rule(sql.FieldLT(FieldMinSizeBytes, v))
+}
+
+// MinSizeBytesLTE applies the LTE predicate on the "min_size_bytes" field.
+func MinSizeBytesLTE(v int64) predicate.Rule {
+	return predicate.Rule(sql.FieldLTE(FieldMinSizeBytes, v))
+}
+
+// MinSizeBytesIsNil applies the IsNil predicate on the "min_size_bytes" field.
+func MinSizeBytesIsNil() predicate.Rule {
+	return predicate.Rule(sql.FieldIsNull(FieldMinSizeBytes))
+}
+
+// MinSizeBytesNotNil applies the NotNil predicate on the "min_size_bytes" field.
+func MinSizeBytesNotNil() predicate.Rule {
+	return predicate.Rule(sql.FieldNotNull(FieldMinSizeBytes))
+}
+
+// TagIDEQ applies the EQ predicate on the "tag_id" field.
+func TagIDEQ(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldTagID, v))
+}
+
+// TagIDNEQ applies the NEQ predicate on the "tag_id" field.
+func TagIDNEQ(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldNEQ(FieldTagID, v))
+}
+
+// TagIDIn applies the In predicate on the "tag_id" field.
+func TagIDIn(vs ...string) predicate.Rule {
+	return predicate.Rule(sql.FieldIn(FieldTagID, vs...))
+}
+
+// TagIDNotIn applies the NotIn predicate on the "tag_id" field.
+func TagIDNotIn(vs ...string) predicate.Rule {
+	return predicate.Rule(sql.FieldNotIn(FieldTagID, vs...))
+}
+
+// TagIDGT applies the GT predicate on the "tag_id" field.
+func TagIDGT(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldGT(FieldTagID, v))
+}
+
+// TagIDGTE applies the GTE predicate on the "tag_id" field.
+func TagIDGTE(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldGTE(FieldTagID, v))
+}
+
+// TagIDLT applies the LT predicate on the "tag_id" field.
+func TagIDLT(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldLT(FieldTagID, v))
+}
+
+// TagIDLTE applies the LTE predicate on the "tag_id" field.
+func TagIDLTE(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldLTE(FieldTagID, v))
+}
+
+// TagIDContains applies the Contains predicate on the "tag_id" field.
+func TagIDContains(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldContains(FieldTagID, v))
+}
+
+// TagIDHasPrefix applies the HasPrefix predicate on the "tag_id" field.
+func TagIDHasPrefix(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldHasPrefix(FieldTagID, v))
+}
+
+// TagIDHasSuffix applies the HasSuffix predicate on the "tag_id" field.
+func TagIDHasSuffix(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldHasSuffix(FieldTagID, v))
+}
+
+// TagIDIsNil applies the IsNil predicate on the "tag_id" field.
+func TagIDIsNil() predicate.Rule {
+	return predicate.Rule(sql.FieldIsNull(FieldTagID))
+}
+
+// TagIDNotNil applies the NotNil predicate on the "tag_id" field.
+func TagIDNotNil() predicate.Rule {
+	return predicate.Rule(sql.FieldNotNull(FieldTagID))
+}
+
+// TagIDEqualFold applies the EqualFold predicate on the "tag_id" field.
+func TagIDEqualFold(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEqualFold(FieldTagID, v))
+}
+
+// TagIDContainsFold applies the ContainsFold predicate on the "tag_id" field.
+func TagIDContainsFold(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldContainsFold(FieldTagID, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.Rule {
+	return predicate.Rule(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.Rule {
+	return predicate.Rule(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldContainsFold(FieldKind, v))
+}
+
+// ProfileEQ applies the EQ predicate on the "profile" field.
+func ProfileEQ(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldProfile, v))
+}
+
+// ProfileNEQ applies the NEQ predicate on the "profile" field.
+func ProfileNEQ(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldNEQ(FieldProfile, v))
+}
+
+// ProfileIn applies the In predicate on the "profile" field.
+func ProfileIn(vs ...string) predicate.Rule {
+	return predicate.Rule(sql.FieldIn(FieldProfile, vs...))
+}
+
+// ProfileNotIn applies the NotIn predicate on the "profile" field.
+func ProfileNotIn(vs ...string) predicate.Rule {
+	return predicate.Rule(sql.FieldNotIn(FieldProfile, vs...))
+}
+
+// ProfileGT applies the GT predicate on the "profile" field.
+func ProfileGT(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldGT(FieldProfile, v))
+}
+
+// ProfileGTE applies the GTE predicate on the "profile" field.
+func ProfileGTE(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldGTE(FieldProfile, v))
+}
+
+// ProfileLT applies the LT predicate on the "profile" field.
+func ProfileLT(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldLT(FieldProfile, v))
+}
+
+// ProfileLTE applies the LTE predicate on the "profile" field.
+func ProfileLTE(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldLTE(FieldProfile, v))
+}
+
+// ProfileContains applies the Contains predicate on the "profile" field.
+func ProfileContains(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldContains(FieldProfile, v))
+}
+
+// ProfileHasPrefix applies the HasPrefix predicate on the "profile" field.
+func ProfileHasPrefix(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldHasPrefix(FieldProfile, v))
+}
+
+// ProfileHasSuffix applies the HasSuffix predicate on the "profile" field.
+func ProfileHasSuffix(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldHasSuffix(FieldProfile, v))
+}
+
+// ProfileIsNil applies the IsNil predicate on the "profile" field.
+func ProfileIsNil() predicate.Rule {
+	return predicate.Rule(sql.FieldIsNull(FieldProfile))
+}
+
+// ProfileNotNil applies the NotNil predicate on the "profile" field.
+func ProfileNotNil() predicate.Rule {
+	return predicate.Rule(sql.FieldNotNull(FieldProfile))
+}
+
+// ProfileEqualFold applies the EqualFold predicate on the "profile" field.
+func ProfileEqualFold(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldEqualFold(FieldProfile, v))
+}
+
+// ProfileContainsFold applies the ContainsFold predicate on the "profile" field.
+func ProfileContainsFold(v string) predicate.Rule {
+	return predicate.Rule(sql.FieldContainsFold(FieldProfile, v))
+}
+
+// FireCountEQ applies the EQ predicate on the "fire_count" field.
+func FireCountEQ(v int) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldFireCount, v))
+}
+
+// FireCountNEQ applies the NEQ predicate on the "fire_count" field.
+func FireCountNEQ(v int) predicate.Rule {
+	return predicate.Rule(sql.FieldNEQ(FieldFireCount, v))
+}
+
+// FireCountIn applies the In predicate on the "fire_count" field.
+func FireCountIn(vs ...int) predicate.Rule {
+	return predicate.Rule(sql.FieldIn(FieldFireCount, vs...))
+}
+
+// FireCountNotIn applies the NotIn predicate on the "fire_count" field.
+func FireCountNotIn(vs ...int) predicate.Rule {
+	return predicate.Rule(sql.FieldNotIn(FieldFireCount, vs...))
+}
+
+// FireCountGT applies the GT predicate on the "fire_count" field.
+func FireCountGT(v int) predicate.Rule {
+	return predicate.Rule(sql.FieldGT(FieldFireCount, v))
+}
+
+// FireCountGTE applies the GTE predicate on the "fire_count" field.
+func FireCountGTE(v int) predicate.Rule {
+	return predicate.Rule(sql.FieldGTE(FieldFireCount, v))
+}
+
+// FireCountLT applies the LT predicate on the "fire_count" field.
+func FireCountLT(v int) predicate.Rule {
+	return predicate.Rule(sql.FieldLT(FieldFireCount, v))
+}
+
+// FireCountLTE applies the LTE predicate on the "fire_count" field.
+func FireCountLTE(v int) predicate.Rule {
+	return predicate.Rule(sql.FieldLTE(FieldFireCount, v))
+}
+
+// LastFiredAtEQ applies the EQ predicate on the "last_fired_at" field.
+func LastFiredAtEQ(v time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldLastFiredAt, v))
+}
+
+// LastFiredAtNEQ applies the NEQ predicate on the "last_fired_at" field.
+func LastFiredAtNEQ(v time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldNEQ(FieldLastFiredAt, v))
+}
+
+// LastFiredAtIn applies the In predicate on the "last_fired_at" field.
+func LastFiredAtIn(vs ...time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldIn(FieldLastFiredAt, vs...))
+}
+
+// LastFiredAtNotIn applies the NotIn predicate on the "last_fired_at" field.
+func LastFiredAtNotIn(vs ...time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldNotIn(FieldLastFiredAt, vs...))
+}
+
+// LastFiredAtGT applies the GT predicate on the "last_fired_at" field.
+func LastFiredAtGT(v time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldGT(FieldLastFiredAt, v))
+}
+
+// LastFiredAtGTE applies the GTE predicate on the "last_fired_at" field.
+func LastFiredAtGTE(v time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldGTE(FieldLastFiredAt, v))
+}
+
+// LastFiredAtLT applies the LT predicate on the "last_fired_at" field.
+func LastFiredAtLT(v time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldLT(FieldLastFiredAt, v))
+}
+
+// LastFiredAtLTE applies the LTE predicate on the "last_fired_at" field.
+func LastFiredAtLTE(v time.Time) predicate.Rule {
+	return predicate.Rule(sql.FieldLTE(FieldLastFiredAt, v))
+}
+
+// LastFiredAtIsNil applies the IsNil predicate on the "last_fired_at" field.
+func LastFiredAtIsNil() predicate.Rule {
+	return predicate.Rule(sql.FieldIsNull(FieldLastFiredAt))
+}
+
+// LastFiredAtNotNil applies the NotNil predicate on the "last_fired_at" field.
+func LastFiredAtNotNil() predicate.Rule {
+	return predicate.Rule(sql.FieldNotNull(FieldLastFiredAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Rule) predicate.Rule {
+	return predicate.Rule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Rule) predicate.Rule {
+	return predicate.Rule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Rule) predicate.Rule {
+	return predicate.Rule(sql.NotPredicates(p))
+}
@@ -0,0 +1,426 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adimail/asset-manager/ent/rule"
+)
+
+// RuleCreate is the builder for creating a Rule entity.
+type RuleCreate struct {
+	config
+	mutation *RuleMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *RuleCreate) SetName(v string) *RuleCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *RuleCreate) SetEnabled(v bool) *RuleCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *RuleCreate) SetNillableEnabled(v *bool) *RuleCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RuleCreate) SetCreatedAt(v time.Time) *RuleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RuleCreate) SetNillableCreatedAt(v *time.Time) *RuleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetFileType sets the "file_type" field.
+func (_c *RuleCreate) SetFileType(v string) *RuleCreate {
+	_c.mutation.SetFileType(v)
+	return _c
+}
+
+// SetNillableFileType sets the "file_type" field if the given value is not nil.
+func (_c *RuleCreate) SetNillableFileType(v *string) *RuleCreate {
+	if v != nil {
+		_c.SetFileType(*v)
+	}
+	return _c
+}
+
+// SetExtension sets the "extension" field.
+func (_c *RuleCreate) SetExtension(v string) *RuleCreate {
+	_c.mutation.SetExtension(v)
+	return _c
+}
+
+// SetNillableExtension sets the "extension" field if the given value is not nil.
+func (_c *RuleCreate) SetNillableExtension(v *string) *RuleCreate {
+	if v != nil {
+		_c.SetExtension(*v)
+	}
+	return _c
+}
+
+// SetMinSizeBytes sets the "min_size_bytes" field.
+func (_c *RuleCreate) SetMinSizeBytes(v int64) *RuleCreate {
+	_c.mutation.SetMinSizeBytes(v)
+	return _c
+}
+
+// SetNillableMinSizeBytes sets the "min_size_bytes" field if the given value is not nil.
+func (_c *RuleCreate) SetNillableMinSizeBytes(v *int64) *RuleCreate {
+	if v != nil {
+		_c.SetMinSizeBytes(*v)
+	}
+	return _c
+}
+
+// SetTagID sets the "tag_id" field.
+func (_c *RuleCreate) SetTagID(v string) *RuleCreate {
+	_c.mutation.SetTagID(v)
+	return _c
+}
+
+// SetNillableTagID sets the "tag_id" field if the given value is not nil.
+func (_c *RuleCreate) SetNillableTagID(v *string) *RuleCreate {
+	if v != nil {
+		_c.SetTagID(*v)
+	}
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *RuleCreate) SetKind(v string) *RuleCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_c *RuleCreate) SetNillableKind(v *string) *RuleCreate {
+	if v != nil {
+		_c.SetKind(*v)
+	}
+	return _c
+}
+
+// SetProfile sets the "profile" field.
+func (_c *RuleCreate) SetProfile(v string) *RuleCreate {
+	_c.mutation.SetProfile(v)
+	return _c
+}
+
+// SetNillableProfile sets the "profile" field if the given value is not nil.
+func (_c *RuleCreate) SetNillableProfile(v *string) *RuleCreate {
+	if v != nil {
+		_c.SetProfile(*v)
+	}
+	return _c
+}
+
+// SetFireCount sets the "fire_count" field.
+func (_c *RuleCreate) SetFireCount(v int) *RuleCreate {
+	_c.mutation.SetFireCount(v)
+	return _c
+}
+
+// SetNillableFireCount sets the "fire_count" field if the given value is not nil.
+func (_c *RuleCreate) SetNillableFireCount(v *int) *RuleCreate {
+	if v != nil {
+		_c.SetFireCount(*v)
+	}
+	return _c
+}
+
+// SetLastFiredAt sets the "last_fired_at" field.
+func (_c *RuleCreate) SetLastFiredAt(v time.Time) *RuleCreate {
+	_c.mutation.SetLastFiredAt(v)
+	return _c
+}
+
+// SetNillableLastFiredAt sets the "last_fired_at" field if the given value is not nil.
+func (_c *RuleCreate) SetNillableLastFiredAt(v *time.Time) *RuleCreate {
+	if v != nil {
+		_c.SetLastFiredAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RuleCreate) SetID(v string) *RuleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *RuleCreate) SetNillableID(v *string) *RuleCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the RuleMutation object of the builder.
+func (_c *RuleCreate) Mutation() *RuleMutation {
+	return _c.mutation
+}
+
+// Save creates the Rule in the database.
+func (_c *RuleCreate) Save(ctx context.Context) (*Rule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RuleCreate) SaveX(ctx context.Context) *Rule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RuleCreate) defaults() {
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := rule.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := rule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		v := rule.DefaultKind
+		_c.mutation.SetKind(v)
+	}
+	if _, ok := _c.mutation.FireCount(); !ok {
+		v := rule.DefaultFireCount
+		_c.mutation.SetFireCount(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := rule.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RuleCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Rule.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := rule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Rule.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "Rule.enabled"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Rule.created_at"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Rule.kind"`)}
+	}
+	if _, ok := _c.mutation.FireCount(); !ok {
+		return &ValidationError{Name: "fire_count", err: errors.New(`ent: missing required field "Rule.fire_count"`)}
+	}
+	return nil
+}
+
+func (_c *RuleCreate) sqlSave(ctx context.Context) (*Rule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Rule.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RuleCreate) createSpec() (*Rule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Rule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(rule.Table, sqlgraph.NewFieldSpec(rule.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(rule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(rule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(rule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.FileType(); ok {
+		_spec.SetField(rule.FieldFileType, field.TypeString, value)
+		_node.FileType = value
+	}
+	if value, ok := _c.mutation.Extension(); ok {
+		_spec.SetField(rule.FieldExtension, field.TypeString, value)
+		_node.Extension = value
+	}
+	if value, ok := _c.mutation.MinSizeBytes(); ok {
+		_spec.SetField(rule.FieldMinSizeBytes, field.TypeInt64, value)
+		_node.MinSizeBytes = value
+	}
+	if value, ok := _c.mutation.TagID(); ok {
+		_spec.SetField(rule.FieldTagID, field.TypeString, value)
+		_node.TagID = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(rule.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Profile(); ok {
+		_spec.SetField(rule.FieldProfile, field.TypeString, value)
+		_node.Profile = value
+	}
+	if value, ok := _c.mutation.FireCount(); ok {
+		_spec.SetField(rule.FieldFireCount, field.TypeInt, value)
+		_node.FireCount = value
+	}
+	if value, ok := _c.mutation.LastFiredAt(); ok {
+		_spec.SetField(rule.FieldLastFiredAt, field.TypeTime, value)
+		_node.LastFiredAt = &value
+	}
+	return _node, _spec
+}
+
+// RuleCreateBulk is the builder for creating many Rule entities in bulk.
+type RuleCreateBulk struct {
+	config
+	err      error
+	builders []*RuleCreate
+}
+
+// Save creates the Rule entities in the database.
+func (_c *RuleCreateBulk) Save(ctx context.Context) ([]*Rule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Rule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RuleCreateBulk) SaveX(ctx context.Context) []*Rule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
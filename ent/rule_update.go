@@ -0,0 +1,690 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adimail/asset-manager/ent/predicate"
+	"github.com/adimail/asset-manager/ent/rule"
+)
+
+// RuleUpdate is the builder for updating Rule entities.
+type RuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RuleMutation
+}
+
+// Where appends a list predicates to the RuleUpdate builder.
+func (_u *RuleUpdate) Where(ps ...predicate.Rule) *RuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *RuleUpdate) SetName(v string) *RuleUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *RuleUpdate) SetNillableName(v *string) *RuleUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *RuleUpdate) SetEnabled(v bool) *RuleUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *RuleUpdate) SetNillableEnabled(v *bool) *RuleUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetFileType sets the "file_type" field.
+func (_u *RuleUpdate) SetFileType(v string) *RuleUpdate {
+	_u.mutation.SetFileType(v)
+	return _u
+}
+
+// SetNillableFileType sets the "file_type" field if the given value is not nil.
+func (_u *RuleUpdate) SetNillableFileType(v *string) *RuleUpdate {
+	if v != nil {
+		_u.SetFileType(*v)
+	}
+	return _u
+}
+
+// ClearFileType clears the value of the "file_type" field.
+func (_u *RuleUpdate) ClearFileType() *RuleUpdate {
+	_u.mutation.ClearFileType()
+	return _u
+}
+
+// SetExtension sets the "extension" field.
+func (_u *RuleUpdate) SetExtension(v string) *RuleUpdate {
+	_u.mutation.SetExtension(v)
+	return _u
+}
+
+// SetNillableExtension sets the "extension" field if the given value is not nil.
+func (_u *RuleUpdate) SetNillableExtension(v *string) *RuleUpdate {
+	if v != nil {
+		_u.SetExtension(*v)
+	}
+	return _u
+}
+
+// ClearExtension clears the value of the "extension" field.
+func (_u *RuleUpdate) ClearExtension() *RuleUpdate {
+	_u.mutation.ClearExtension()
+	return _u
+}
+
+// SetMinSizeBytes sets the "min_size_bytes" field.
+func (_u *RuleUpdate) SetMinSizeBytes(v int64) *RuleUpdate {
+	_u.mutation.ResetMinSizeBytes()
+	_u.mutation.SetMinSizeBytes(v)
+	return _u
+}
+
+// SetNillableMinSizeBytes sets the "min_size_bytes" field if the given value is not nil.
+func (_u *RuleUpdate) SetNillableMinSizeBytes(v *int64) *RuleUpdate {
+	if v != nil {
+		_u.SetMinSizeBytes(*v)
+	}
+	return _u
+}
+
+// AddMinSizeBytes adds value to the "min_size_bytes" field.
+func (_u *RuleUpdate) AddMinSizeBytes(v int64) *RuleUpdate {
+	_u.mutation.AddMinSizeBytes(v)
+	return _u
+}
+
+// ClearMinSizeBytes clears the value of the "min_size_bytes" field.
+func (_u *RuleUpdate) ClearMinSizeBytes() *RuleUpdate {
+	_u.mutation.ClearMinSizeBytes()
+	return _u
+}
+
+// SetTagID sets the "tag_id" field.
+func (_u *RuleUpdate) SetTagID(v string) *RuleUpdate {
+	_u.mutation.SetTagID(v)
+	return _u
+}
+
+// SetNillableTagID sets the "tag_id" field if the given value is not nil.
+func (_u *RuleUpdate) SetNillableTagID(v *string) *RuleUpdate {
+	if v != nil {
+		_u.SetTagID(*v)
+	}
+	return _u
+}
+
+// ClearTagID clears the value of the "tag_id" field.
+func (_u *RuleUpdate) ClearTagID() *RuleUpdate {
+	_u.mutation.ClearTagID()
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *RuleUpdate) SetKind(v string) *RuleUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *RuleUpdate) SetNillableKind(v *string) *RuleUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetProfile sets the "profile" field.
+func (_u *RuleUpdate) SetProfile(v string) *RuleUpdate {
+	_u.mutation.SetProfile(v)
+	return _u
+}
+
+// SetNillableProfile sets the "profile" field if the given value is not nil.
+func (_u *RuleUpdate) SetNillableProfile(v *string) *RuleUpdate {
+	if v != nil {
+		_u.SetProfile(*v)
+	}
+	return _u
+}
+
+// ClearProfile clears the value of the "profile" field.
+func (_u *RuleUpdate) ClearProfile() *RuleUpdate {
+	_u.mutation.ClearProfile()
+	return _u
+}
+
+// SetFireCount sets the "fire_count" field.
+func (_u *RuleUpdate) SetFireCount(v int) *RuleUpdate {
+	_u.mutation.ResetFireCount()
+	_u.mutation.SetFireCount(v)
+	return _u
+}
+
+// SetNillableFireCount sets the "fire_count" field if the given value is not nil.
+func (_u *RuleUpdate) SetNillableFireCount(v *int) *RuleUpdate {
+	if v != nil {
+		_u.SetFireCount(*v)
+	}
+	return _u
+}
+
+// AddFireCount adds value to the "fire_count" field.
+func (_u *RuleUpdate) AddFireCount(v int) *RuleUpdate {
+	_u.mutation.AddFireCount(v)
+	return _u
+}
+
+// SetLastFiredAt sets the "last_fired_at" field.
+func (_u *RuleUpdate) SetLastFiredAt(v time.Time) *RuleUpdate {
+	_u.mutation.SetLastFiredAt(v)
+	return _u
+}
+
+// SetNillableLastFiredAt sets the "last_fired_at" field if the given value is not nil.
+func (_u *RuleUpdate) SetNillableLastFiredAt(v *time.Time) *RuleUpdate {
+	if v != nil {
+		_u.SetLastFiredAt(*v)
+	}
+	return _u
+}
+
+// ClearLastFiredAt clears the value of the "last_fired_at" field.
+func (_u *RuleUpdate) ClearLastFiredAt() *RuleUpdate {
+	_u.mutation.ClearLastFiredAt()
+	return _u
+}
+
+// Mutation returns the RuleMutation object of the builder.
+func (_u *RuleUpdate) Mutation() *RuleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RuleUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RuleUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := rule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Rule.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rule.Table, rule.Columns, sqlgraph.NewFieldSpec(rule.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(rule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(rule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.FileType(); ok {
+		_spec.SetField(rule.FieldFileType, field.TypeString, value)
+	}
+	if _u.mutation.FileTypeCleared() {
+		_spec.ClearField(rule.FieldFileType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Extension(); ok {
+		_spec.SetField(rule.FieldExtension, field.TypeString, value)
+	}
+	if _u.mutation.ExtensionCleared() {
+		_spec.ClearField(rule.FieldExtension, field.TypeString)
+	}
+	if value, ok := _u.mutation.MinSizeBytes(); ok {
+		_spec.SetField(rule.FieldMinSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMinSizeBytes(); ok {
+		_spec.AddField(rule.FieldMinSizeBytes, field.TypeInt64, value)
+	}
+	if _u.mutation.MinSizeBytesCleared() {
+		_spec.ClearField(rule.FieldMinSizeBytes, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.TagID(); ok {
+		_spec.SetField(rule.FieldTagID, field.TypeString, value)
+	}
+	if _u.mutation.TagIDCleared() {
+		_spec.ClearField(rule.FieldTagID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(rule.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Profile(); ok {
+		_spec.SetField(rule.FieldProfile, field.TypeString, value)
+	}
+	if _u.mutation.ProfileCleared() {
+		_spec.ClearField(rule.FieldProfile, field.TypeString)
+	}
+	if value, ok := _u.mutation.FireCount(); ok {
+		_spec.SetField(rule.FieldFireCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFireCount(); ok {
+		_spec.AddField(rule.FieldFireCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastFiredAt(); ok {
+		_spec.SetField(rule.FieldLastFiredAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastFiredAtCleared() {
+		_spec.ClearField(rule.FieldLastFiredAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RuleUpdateOne is the builder for updating a single Rule entity.
+type RuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RuleMutation
+}
+
+// SetName sets the "name" field.
+func (_u *RuleUpdateOne) SetName(v string) *RuleUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *RuleUpdateOne) SetNillableName(v *string) *RuleUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *RuleUpdateOne) SetEnabled(v bool) *RuleUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *RuleUpdateOne) SetNillableEnabled(v *bool) *RuleUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetFileType sets the "file_type" field.
+func (_u *RuleUpdateOne) SetFileType(v string) *RuleUpdateOne {
+	_u.mutation.SetFileType(v)
+	return _u
+}
+
+// SetNillableFileType sets the "file_type" field if the given value is not nil.
+func (_u *RuleUpdateOne) SetNillableFileType(v *string) *RuleUpdateOne {
+	if v != nil {
+		_u.SetFileType(*v)
+	}
+	return _u
+}
+
+// ClearFileType clears the value of the "file_type" field.
+func (_u *RuleUpdateOne) ClearFileType() *RuleUpdateOne {
+	_u.mutation.ClearFileType()
+	return _u
+}
+
+// SetExtension sets the "extension" field.
+func (_u *RuleUpdateOne) SetExtension(v string) *RuleUpdateOne {
+	_u.mutation.SetExtension(v)
+	return _u
+}
+
+// SetNillableExtension sets the "extension" field if the given value is not nil.
+func (_u *RuleUpdateOne) SetNillableExtension(v *string) *RuleUpdateOne {
+	if v != nil {
+		_u.SetExtension(*v)
+	}
+	return _u
+}
+
+// ClearExtension clears the value of the "extension" field.
+func (_u *RuleUpdateOne) ClearExtension() *RuleUpdateOne {
+	_u.mutation.ClearExtension()
+	return _u
+}
+
+// SetMinSizeBytes sets the "min_size_bytes" field.
+func (_u *RuleUpdateOne) SetMinSizeBytes(v int64) *RuleUpdateOne {
+	_u.mutation.ResetMinSizeBytes()
+	_u.mutation.SetMinSizeBytes(v)
+	return _u
+}
+
+// SetNillableMinSizeBytes sets the "min_size_bytes" field if the given value is not nil.
+func (_u *RuleUpdateOne) SetNillableMinSizeBytes(v *int64) *RuleUpdateOne {
+	if v != nil {
+		_u.SetMinSizeBytes(*v)
+	}
+	return _u
+}
+
+// AddMinSizeBytes adds value to the "min_size_bytes" field.
+func (_u *RuleUpdateOne) AddMinSizeBytes(v int64) *RuleUpdateOne {
+	_u.mutation.AddMinSizeBytes(v)
+	return _u
+}
+
+// ClearMinSizeBytes clears the value of the "min_size_bytes" field.
+func (_u *RuleUpdateOne) ClearMinSizeBytes() *RuleUpdateOne {
+	_u.mutation.ClearMinSizeBytes()
+	return _u
+}
+
+// SetTagID sets the "tag_id" field.
+func (_u *RuleUpdateOne) SetTagID(v string) *RuleUpdateOne {
+	_u.mutation.SetTagID(v)
+	return _u
+}
+
+// SetNillableTagID sets the "tag_id" field if the given value is not nil.
+func (_u *RuleUpdateOne) SetNillableTagID(v *string) *RuleUpdateOne {
+	if v != nil {
+		_u.SetTagID(*v)
+	}
+	return _u
+}
+
+// ClearTagID clears the value of the "tag_id" field.
+func (_u *RuleUpdateOne) ClearTagID() *RuleUpdateOne {
+	_u.mutation.ClearTagID()
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *RuleUpdateOne) SetKind(v string) *RuleUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *RuleUpdateOne) SetNillableKind(v *string) *RuleUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetProfile sets the "profile" field.
+func (_u *RuleUpdateOne) SetProfile(v string) *RuleUpdateOne {
+	_u.mutation.SetProfile(v)
+	return _u
+}
+
+// SetNillableProfile sets the "profile" field if the given value is not nil.
+func (_u *RuleUpdateOne) SetNillableProfile(v *string) *RuleUpdateOne {
+	if v != nil {
+		_u.SetProfile(*v)
+	}
+	return _u
+}
+
+// ClearProfile clears the value of the "profile" field.
+func (_u *RuleUpdateOne) ClearProfile() *RuleUpdateOne {
+	_u.mutation.ClearProfile()
+	return _u
+}
+
+// SetFireCount sets the "fire_count" field.
+func (_u *RuleUpdateOne) SetFireCount(v int) *RuleUpdateOne {
+	_u.mutation.ResetFireCount()
+	_u.mutation.SetFireCount(v)
+	return _u
+}
+
+// SetNillableFireCount sets the "fire_count" field if the given value is not nil.
+func (_u *RuleUpdateOne) SetNillableFireCount(v *int) *RuleUpdateOne {
+	if v != nil {
+		_u.SetFireCount(*v)
+	}
+	return _u
+}
+
+// AddFireCount adds value to the "fire_count" field.
+func (_u *RuleUpdateOne) AddFireCount(v int) *RuleUpdateOne {
+	_u.mutation.AddFireCount(v)
+	return _u
+}
+
+// SetLastFiredAt sets the "last_fired_at" field.
+func (_u *RuleUpdateOne) SetLastFiredAt(v time.Time) *RuleUpdateOne {
+	_u.mutation.SetLastFiredAt(v)
+	return _u
+}
+
+// SetNillableLastFiredAt sets the "last_fired_at" field if the given value is not nil.
+func (_u *RuleUpdateOne) SetNillableLastFiredAt(v *time.Time) *RuleUpdateOne {
+	if v != nil {
+		_u.SetLastFiredAt(*v)
+	}
+	return _u
+}
+
+// ClearLastFiredAt clears the value of the "last_fired_at" field.
+func (_u *RuleUpdateOne) ClearLastFiredAt() *RuleUpdateOne {
+	_u.mutation.ClearLastFiredAt()
+	return _u
+}
+
+// Mutation returns the RuleMutation object of the builder.
+func (_u *RuleUpdateOne) Mutation() *RuleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RuleUpdate builder.
+func (_u *RuleUpdateOne) Where(ps ...predicate.Rule) *RuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RuleUpdateOne) Select(field string, fields ...string) *RuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Rule entity.
+func (_u *RuleUpdateOne) Save(ctx context.Context) (*Rule, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RuleUpdateOne) SaveX(ctx context.Context) *Rule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RuleUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := rule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Rule.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RuleUpdateOne) sqlSave(ctx context.Context) (_node *Rule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rule.Table, rule.Columns, sqlgraph.NewFieldSpec(rule.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Rule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rule.FieldID)
+		for _, f := range fields {
+			if !rule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != rule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(rule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(rule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.FileType(); ok {
+		_spec.SetField(rule.FieldFileType, field.TypeString, value)
+	}
+	if _u.mutation.FileTypeCleared() {
+		_spec.ClearField(rule.FieldFileType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Extension(); ok {
+		_spec.SetField(rule.FieldExtension, field.TypeString, value)
+	}
+	if _u.mutation.ExtensionCleared() {
+		_spec.ClearField(rule.FieldExtension, field.TypeString)
+	}
+	if value, ok := _u.mutation.MinSizeBytes(); ok {
+		_spec.SetField(rule.FieldMinSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMinSizeBytes(); ok {
+		_spec.AddField(rule.FieldMinSizeBytes, field.TypeInt64, value)
+	}
+	if _u.mutation.MinSizeBytesCleared() {
+		_spec.ClearField(rule.FieldMinSizeBytes, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.TagID(); ok {
+		_spec.SetField(rule.FieldTagID, field.TypeString, value)
+	}
+	if _u.mutation.TagIDCleared() {
+		_spec.ClearField(rule.FieldTagID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(rule.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Profile(); ok {
+		_spec.SetField(rule.FieldProfile, field.TypeString, value)
+	}
+	if _u.mutation.ProfileCleared() {
+		_spec.ClearField(rule.FieldProfile, field.TypeString)
+	}
+	if value, ok := _u.mutation.FireCount(); ok {
+		_spec.SetField(rule.FieldFireCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFireCount(); ok {
+		_spec.AddField(rule.FieldFireCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastFiredAt(); ok {
+		_spec.SetField(rule.FieldLastFiredAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastFiredAtCleared() {
+		_spec.ClearField(rule.FieldLastFiredAt, field.TypeTime)
+	}
+	_node = &Rule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
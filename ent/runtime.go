@@ -7,8 +7,10 @@ import (
 
 	"github.com/adimail/asset-manager/ent/asset"
 	"github.com/adimail/asset-manager/ent/compressionjob"
+	"github.com/adimail/asset-manager/ent/rule"
 	"github.com/adimail/asset-manager/ent/schema"
 	"github.com/adimail/asset-manager/ent/tag"
+	"github.com/adimail/asset-manager/ent/variant"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -18,31 +20,101 @@ func init() {
 	assetFields := schema.Asset{}.Fields()
 	_ = assetFields
 	// assetDescCreatedAt is the schema descriptor for created_at field.
-	assetDescCreatedAt := assetFields[6].Descriptor()
+	assetDescCreatedAt := assetFields[7].Descriptor()
 	// asset.DefaultCreatedAt holds the default value on creation for the created_at field.
 	asset.DefaultCreatedAt = assetDescCreatedAt.Default.(func() time.Time)
 	// assetDescIsCompressed is the schema descriptor for is_compressed field.
-	assetDescIsCompressed := assetFields[7].Descriptor()
+	assetDescIsCompressed := assetFields[8].Descriptor()
 	// asset.DefaultIsCompressed holds the default value on creation for the is_compressed field.
 	asset.DefaultIsCompressed = assetDescIsCompressed.Default.(bool)
+	// assetDescAlreadyOptimal is the schema descriptor for already_optimal field.
+	assetDescAlreadyOptimal := assetFields[13].Descriptor()
+	// asset.DefaultAlreadyOptimal holds the default value on creation for the already_optimal field.
+	asset.DefaultAlreadyOptimal = assetDescAlreadyOptimal.Default.(bool)
+	// assetDescMetadataStripped is the schema descriptor for metadata_stripped field.
+	assetDescMetadataStripped := assetFields[14].Descriptor()
+	// asset.DefaultMetadataStripped holds the default value on creation for the metadata_stripped field.
+	asset.DefaultMetadataStripped = assetDescMetadataStripped.Default.(bool)
+	// assetDescHasLocation is the schema descriptor for has_location field.
+	assetDescHasLocation := assetFields[15].Descriptor()
+	// asset.DefaultHasLocation holds the default value on creation for the has_location field.
+	asset.DefaultHasLocation = assetDescHasLocation.Default.(bool)
 	// assetDescID is the schema descriptor for id field.
 	assetDescID := assetFields[0].Descriptor()
 	// asset.DefaultID holds the default value on creation for the id field.
 	asset.DefaultID = assetDescID.Default.(func() string)
 	compressionjobFields := schema.CompressionJob{}.Fields()
 	_ = compressionjobFields
+	// compressionjobDescKind is the schema descriptor for kind field.
+	compressionjobDescKind := compressionjobFields[1].Descriptor()
+	// compressionjob.DefaultKind holds the default value on creation for the kind field.
+	compressionjob.DefaultKind = compressionjobDescKind.Default.(string)
 	// compressionjobDescStatus is the schema descriptor for status field.
-	compressionjobDescStatus := compressionjobFields[1].Descriptor()
+	compressionjobDescStatus := compressionjobFields[2].Descriptor()
 	// compressionjob.DefaultStatus holds the default value on creation for the status field.
 	compressionjob.DefaultStatus = compressionjobDescStatus.Default.(string)
 	// compressionjobDescProgress is the schema descriptor for progress field.
-	compressionjobDescProgress := compressionjobFields[2].Descriptor()
+	compressionjobDescProgress := compressionjobFields[3].Descriptor()
 	// compressionjob.DefaultProgress holds the default value on creation for the progress field.
 	compressionjob.DefaultProgress = compressionjobDescProgress.Default.(int)
+	// compressionjobDescCreatedAt is the schema descriptor for created_at field.
+	compressionjobDescCreatedAt := compressionjobFields[5].Descriptor()
+	// compressionjob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	compressionjob.DefaultCreatedAt = compressionjobDescCreatedAt.Default.(func() time.Time)
+	// compressionjobDescNormalize is the schema descriptor for normalize field.
+	compressionjobDescNormalize := compressionjobFields[11].Descriptor()
+	// compressionjob.DefaultNormalize holds the default value on creation for the normalize field.
+	compressionjob.DefaultNormalize = compressionjobDescNormalize.Default.(bool)
+	// compressionjobDescPriority is the schema descriptor for priority field.
+	compressionjobDescPriority := compressionjobFields[23].Descriptor()
+	// compressionjob.DefaultPriority holds the default value on creation for the priority field.
+	compressionjob.DefaultPriority = compressionjobDescPriority.Default.(int)
+	// compressionjobDescSubmitter is the schema descriptor for submitter field.
+	compressionjobDescSubmitter := compressionjobFields[24].Descriptor()
+	// compressionjob.DefaultSubmitter holds the default value on creation for the submitter field.
+	compressionjob.DefaultSubmitter = compressionjobDescSubmitter.Default.(string)
+	// compressionjobDescCancelRequested is the schema descriptor for cancel_requested field.
+	compressionjobDescCancelRequested := compressionjobFields[28].Descriptor()
+	// compressionjob.DefaultCancelRequested holds the default value on creation for the cancel_requested field.
+	compressionjob.DefaultCancelRequested = compressionjobDescCancelRequested.Default.(bool)
+	// compressionjobDescAttempts is the schema descriptor for attempts field.
+	compressionjobDescAttempts := compressionjobFields[29].Descriptor()
+	// compressionjob.DefaultAttempts holds the default value on creation for the attempts field.
+	compressionjob.DefaultAttempts = compressionjobDescAttempts.Default.(int)
+	// compressionjobDescMaxAttempts is the schema descriptor for max_attempts field.
+	compressionjobDescMaxAttempts := compressionjobFields[30].Descriptor()
+	// compressionjob.DefaultMaxAttempts holds the default value on creation for the max_attempts field.
+	compressionjob.DefaultMaxAttempts = compressionjobDescMaxAttempts.Default.(int)
 	// compressionjobDescID is the schema descriptor for id field.
 	compressionjobDescID := compressionjobFields[0].Descriptor()
 	// compressionjob.DefaultID holds the default value on creation for the id field.
 	compressionjob.DefaultID = compressionjobDescID.Default.(func() string)
+	ruleFields := schema.Rule{}.Fields()
+	_ = ruleFields
+	// ruleDescName is the schema descriptor for name field.
+	ruleDescName := ruleFields[1].Descriptor()
+	// rule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	rule.NameValidator = ruleDescName.Validators[0].(func(string) error)
+	// ruleDescEnabled is the schema descriptor for enabled field.
+	ruleDescEnabled := ruleFields[2].Descriptor()
+	// rule.DefaultEnabled holds the default value on creation for the enabled field.
+	rule.DefaultEnabled = ruleDescEnabled.Default.(bool)
+	// ruleDescCreatedAt is the schema descriptor for created_at field.
+	ruleDescCreatedAt := ruleFields[3].Descriptor()
+	// rule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	rule.DefaultCreatedAt = ruleDescCreatedAt.Default.(func() time.Time)
+	// ruleDescKind is the schema descriptor for kind field.
+	ruleDescKind := ruleFields[8].Descriptor()
+	// rule.DefaultKind holds the default value on creation for the kind field.
+	rule.DefaultKind = ruleDescKind.Default.(string)
+	// ruleDescFireCount is the schema descriptor for fire_count field.
+	ruleDescFireCount := ruleFields[10].Descriptor()
+	// rule.DefaultFireCount holds the default value on creation for the fire_count field.
+	rule.DefaultFireCount = ruleDescFireCount.Default.(int)
+	// ruleDescID is the schema descriptor for id field.
+	ruleDescID := ruleFields[0].Descriptor()
+	// rule.DefaultID holds the default value on creation for the id field.
+	rule.DefaultID = ruleDescID.Default.(func() string)
 	tagFields := schema.Tag{}.Fields()
 	_ = tagFields
 	// tagDescName is the schema descriptor for name field.
@@ -61,4 +133,14 @@ func init() {
 	tagDescID := tagFields[0].Descriptor()
 	// tag.DefaultID holds the default value on creation for the id field.
 	tag.DefaultID = tagDescID.Default.(func() string)
+	variantFields := schema.Variant{}.Fields()
+	_ = variantFields
+	// variantDescCreatedAt is the schema descriptor for created_at field.
+	variantDescCreatedAt := variantFields[6].Descriptor()
+	// variant.DefaultCreatedAt holds the default value on creation for the created_at field.
+	variant.DefaultCreatedAt = variantDescCreatedAt.Default.(func() time.Time)
+	// variantDescID is the schema descriptor for id field.
+	variantDescID := variantFields[0].Descriptor()
+	// variant.DefaultID holds the default value on creation for the id field.
+	variant.DefaultID = variantDescID.Default.(func() string)
 }
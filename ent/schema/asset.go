@@ -20,14 +20,25 @@ func (Asset) Fields() []ent.Field {
 		field.String("original_filename"),
 		field.String("file_type"),
 		field.String("extension"),
+		field.String("mime_type").Optional(),
 		field.Int64("file_size_bytes"),
 		field.String("storage_path"),
 		field.Time("created_at").Default(time.Now),
 
 		// Compression fields
 		field.Bool("is_compressed").Default(false),
+		field.Time("compressed_at").Optional(),
 		field.String("original_path").Optional(),
+		field.Time("original_kept_at").Optional(), // last processing that kept the original; retention counts from here
 		field.Float("compression_ratio").Optional(),
+		field.Bool("already_optimal").Default(false), // compression did not save enough; bulk runs skip it
+
+		// Privacy fields
+		field.Bool("metadata_stripped").Default(false), // EXIF, XMP and GPS removed
+		field.Bool("has_location").Default(false),      // the file carries GPS coordinates
+
+		// Streaming fields
+		field.String("hls_path").Optional(), // master playlist
 	}
 }
 
@@ -35,5 +46,6 @@ func (Asset) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("tags", Tag.Type),
 		edge.To("compression_jobs", CompressionJob.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("variants", Variant.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
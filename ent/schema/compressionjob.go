@@ -1,9 +1,12 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 )
 
@@ -14,11 +17,56 @@ type CompressionJob struct {
 func (CompressionJob) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("id").DefaultFunc(uuid.NewString),
-		field.String("status").Default("pending"), // pending, processing, completed, failed
+		field.String("kind").Default("compress"),  // compress, hls
+		field.String("status").Default("pending"), // pending, processing, completed, failed, cancelled
 		field.Int("progress").Default(0),
 		field.String("error").Optional(),
+		field.Time("created_at").Optional().Default(time.Now).Immutable(), // optional for rows predating the column
 		field.Time("started_at").Optional(),
 		field.Time("completed_at").Optional(),
+		field.String("profile").Optional(),       // named encoder profile; empty means the default
+		field.String("target_format").Optional(), // webp, avif, opus, aac, mp3; empty keeps the source format
+		field.String("audio_bitrate").Optional(),
+		field.Bool("normalize").Default(false),                 // EBU R128 loudness normalization
+		field.String("outcome").Optional(),                     // compressed, already_optimal, below_quality_floor
+		field.JSON("steps", []PipelineStep{}).Optional(),       // pipeline jobs only
+		field.Int64("target_bytes").Optional(),                 // target-size mode: the output must not exceed it
+		field.JSON("target_params", TargetParams{}).Optional(), // settings the target-size search chose
+
+		// Quality of a compress job's output against the original, when
+		// measured.
+		field.Float("ssim").Optional().Nillable(),
+		field.Float("psnr").Optional().Nillable(), // dB
+		field.Float("vmaf").Optional().Nillable(),
+
+		// Record of the latest attempt, to debug failures and reproduce
+		// results.
+		field.JSON("encoder_params", EncoderParams{}).Optional(),
+		field.String("ffmpeg_version").Optional(),
+		field.Strings("commands").Optional(), // external commands run, shell-quoted
+		field.Text("log").Optional(),         // their output, cut to the last 64 KiB
+
+		// Queue fields
+		field.Int("priority").Default(0), // higher runs first
+		field.String("submitter").Default(""),
+		field.String("pool").Optional(), // image, video; empty for jobs predating pools
+		field.String("worker_id").Optional(),
+		field.Time("lease_expires_at").Optional(),
+		field.Bool("cancel_requested").Default(false),
+
+		// Retry fields
+		field.Int("attempts").Default(0),
+		field.Int("max_attempts").Default(1),
+		field.Time("next_attempt_at").Optional(),
+		field.Strings("attempt_errors").Optional(),
+	}
+}
+
+func (CompressionJob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "created_at"),
+		index.Fields("status", "pool", "priority"),
+		index.Edges("asset"), // an asset's history and latest job
 	}
 }
 
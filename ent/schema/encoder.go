@@ -0,0 +1,18 @@
+package schema
+
+// EncoderParams are the encoder settings a job ran with: its profile with
+// the job's overrides applied.
+type EncoderParams struct {
+	Profile      string `json:"profile"`
+	VideoCodec   string `json:"video_codec,omitempty"`
+	CRF          int    `json:"crf,omitempty"`
+	Preset       string `json:"preset,omitempty"`
+	MaxWidth     int    `json:"max_width,omitempty"`
+	MaxHeight    int    `json:"max_height,omitempty"`
+	ImageQuality int    `json:"image_quality,omitempty"`
+	ImageFormat  string `json:"image_format,omitempty"`
+	ImageMaxSize int    `json:"image_max_size,omitempty"`
+	AudioCodec   string `json:"audio_codec,omitempty"`
+	AudioBitrate string `json:"audio_bitrate,omitempty"`
+	Normalize    bool   `json:"normalize,omitempty"`
+}
@@ -0,0 +1,22 @@
+package schema
+
+import "time"
+
+// PipelineStep is one operation of a pipeline job, stored with its progress
+// in the job's steps field.
+type PipelineStep struct {
+	Op          string     `json:"op"`                 // strip_metadata, auto_rotate, resize, convert, compress, thumbnail, watermark
+	Size        int        `json:"size,omitempty"`     // resize: longest edge; thumbnail: longest edge
+	Format      string     `json:"format,omitempty"`   // convert: webp, avif
+	Quality     int        `json:"quality,omitempty"`  // 0 uses the job's profile
+	Overlay     string     `json:"overlay,omitempty"`  // watermark: ID of the image asset drawn on top
+	Position    string     `json:"position,omitempty"` // watermark: top-left, top-right, bottom-left, bottom-right (default), center
+	Opacity     float64    `json:"opacity,omitempty"`  // watermark: 0 to 1, default 0.5
+	Scale       float64    `json:"scale,omitempty"`    // watermark: overlay width as a fraction of the frame width, default 0.2
+	Output      string     `json:"output,omitempty"`   // watermark: variant (default) or replace
+	Status      string     `json:"status,omitempty"`   // pending, processing, completed, failed
+	Error       string     `json:"error,omitempty"`
+	OutputBytes int64      `json:"output_bytes,omitempty"`
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Rule queues processing for newly uploaded assets that match it. Empty
+// match fields match every asset.
+type Rule struct {
+	ent.Schema
+}
+
+func (Rule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").DefaultFunc(uuid.NewString),
+		field.String("name").NotEmpty(),
+		field.Bool("enabled").Default(true),
+		field.Time("created_at").Default(time.Now).Immutable(),
+
+		// Match fields
+		field.String("file_type").Optional(),     // image, video, audio, ...
+		field.String("extension").Optional(),     // ".png"
+		field.Int64("min_size_bytes").Optional(), // 0 matches any size
+		field.String("tag_id").Optional(),
+
+		// Action fields
+		field.String("kind").Default("compress"), // compress, hls
+		field.String("profile").Optional(),
+
+		// Firing stats
+		field.Int("fire_count").Default(0),
+		field.Time("last_fired_at").Optional().Nillable(),
+	}
+}
@@ -0,0 +1,12 @@
+package schema
+
+// TargetParams records the encoder settings a target-size compression
+// settled on, so the result can be reproduced.
+type TargetParams struct {
+	ImageQuality int   `json:"image_quality,omitempty"`
+	ImageMaxSize int   `json:"image_max_size,omitempty"` // longest edge
+	CRF          int   `json:"crf,omitempty"`
+	MaxHeight    int   `json:"max_height,omitempty"` // video
+	OutputBytes  int64 `json:"output_bytes"`
+	Tries        int   `json:"tries"` // encodes the search ran
+}
@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+type Variant struct {
+	ent.Schema
+}
+
+func (Variant) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").DefaultFunc(uuid.NewString),
+		field.String("kind"), // fallback
+		field.String("extension"),
+		field.String("mime_type").Optional(),
+		field.Int64("file_size_bytes"),
+		field.String("storage_path"),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+func (Variant) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("asset", Asset.Type).Ref("variants").Unique().Required(),
+	}
+}
@@ -16,8 +16,12 @@ type Tx struct {
 	Asset *AssetClient
 	// CompressionJob is the client for interacting with the CompressionJob builders.
 	CompressionJob *CompressionJobClient
+	// Rule is the client for interacting with the Rule builders.
+	Rule *RuleClient
 	// Tag is the client for interacting with the Tag builders.
 	Tag *TagClient
+	// Variant is the client for interacting with the Variant builders.
+	Variant *VariantClient
 
 	// lazily loaded.
 	client     *Client
@@ -151,7 +155,9 @@ func (tx *Tx) Client() *Client {
 func (tx *Tx) init() {
 	tx.Asset = NewAssetClient(tx.config)
 	tx.CompressionJob = NewCompressionJobClient(tx.config)
+	tx.Rule = NewRuleClient(tx.config)
 	tx.Tag = NewTagClient(tx.config)
+	tx.Variant = NewVariantClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -0,0 +1,200 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/adimail/asset-manager/ent/asset"
+	"github.com/adimail/asset-manager/ent/variant"
+)
+
+// Variant is the model entity for the Variant schema.
+type Variant struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// Extension holds the value of the "extension" field.
+	Extension string `json:"extension,omitempty"`
+	// MimeType holds the value of the "mime_type" field.
+	MimeType string `json:"mime_type,omitempty"`
+	// FileSizeBytes holds the value of the "file_size_bytes" field.
+	FileSizeBytes int64 `json:"file_size_bytes,omitempty"`
+	// StoragePath holds the value of the "storage_path" field.
+	StoragePath string `json:"storage_path,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the VariantQuery when eager-loading is set.
+	Edges          VariantEdges `json:"edges"`
+	asset_variants *string
+	selectValues   sql.SelectValues
+}
+
+// VariantEdges holds the relations/edges for other nodes in the graph.
+type VariantEdges struct {
+	// Asset holds the value of the asset edge.
+	Asset *Asset `json:"asset,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// AssetOrErr returns the Asset value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e VariantEdges) AssetOrErr() (*Asset, error) {
+	if e.Asset != nil {
+		return e.Asset, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: asset.Label}
+	}
+	return nil, &NotLoadedError{edge: "asset"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Variant) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case variant.FieldFileSizeBytes:
+			values[i] = new(sql.NullInt64)
+		case variant.FieldID, variant.FieldKind, variant.FieldExtension, variant.FieldMimeType, variant.FieldStoragePath:
+			values[i] = new(sql.NullString)
+		case variant.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case variant.ForeignKeys[0]: // asset_variants
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Variant fields.
+func (_m *Variant) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case variant.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case variant.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = value.String
+			}
+		case variant.FieldExtension:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field extension", values[i])
+			} else if value.Valid {
+				_m.Extension = value.String
+			}
+		case variant.FieldMimeType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mime_type", values[i])
+			} else if value.Valid {
+				_m.MimeType = value.String
+			}
+		case variant.FieldFileSizeBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field file_size_bytes", values[i])
+			} else if value.Valid {
+				_m.FileSizeBytes = value.Int64
+			}
+		case variant.FieldStoragePath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_path", values[i])
+			} else if value.Valid {
+				_m.StoragePath = value.String
+			}
+		case variant.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case variant.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field asset_variants", values[i])
+			} else if value.Valid {
+				_m.asset_variants = new(string)
+				*_m.asset_variants = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Variant.
+// This includes values selected through modifiers, order, etc.
+func (_m *Variant) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAsset queries the "asset" edge of the Variant entity.
+func (_m *Variant) QueryAsset() *AssetQuery {
+	return NewVariantClient(_m.config).QueryAsset(_m)
+}
+
+// Update returns a builder for updating this Variant.
+// Note that you need to call Variant.Unwrap() before calling this method if this Variant
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Variant) Update() *VariantUpdateOne {
+	return NewVariantClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Variant entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Variant) Unwrap() *Variant {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Variant is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Variant) String() string {
+	var builder strings.Builder
+	builder.WriteString("Variant(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("kind=")
+	builder.WriteString(_m.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("extension=")
+	builder.WriteString(_m.Extension)
+	builder.WriteString(", ")
+	builder.WriteString("mime_type=")
+	builder.WriteString(_m.MimeType)
+	builder.WriteString(", ")
+	builder.WriteString("file_size_bytes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FileSizeBytes))
+	builder.WriteString(", ")
+	builder.WriteString("storage_path=")
+	builder.WriteString(_m.StoragePath)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Variants is a parsable slice of Variant.
+type Variants []*Variant
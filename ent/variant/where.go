@@ -0,0 +1,484 @@
+// Code generated by ent, DO NOT EDIT.
+
+package variant
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/adimail/asset-manager/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Variant {
+	return predicate.Variant(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Variant {
+	return predicate.Variant(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Variant {
+	return predicate.Variant(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Variant {
+	return predicate.Variant(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Variant {
+	return predicate.Variant(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Variant {
+	return predicate.Variant(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Variant {
+	return predicate.Variant(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Variant {
+	return predicate.Variant(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Variant {
+	return predicate.Variant(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Variant {
+	return predicate.Variant(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Variant {
+	return predicate.Variant(sql.FieldContainsFold(FieldID, id))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldEQ(FieldKind, v))
+}
+
+// Extension applies equality check predicate on the "extension" field. It's identical to ExtensionEQ.
+func Extension(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldEQ(FieldExtension, v))
+}
+
+// MimeType applies equality check predicate on the "mime_type" field. It's identical to MimeTypeEQ.
+func MimeType(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldEQ(FieldMimeType, v))
+}
+
+// FileSizeBytes applies equality check predicate on the "file_size_bytes" field. It's identical to FileSizeBytesEQ.
+func FileSizeBytes(v int64) predicate.Variant {
+	return predicate.Variant(sql.FieldEQ(FieldFileSizeBytes, v))
+}
+
+// StoragePath applies equality check predicate on the "storage_path" field. It's identical to StoragePathEQ.
+func StoragePath(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldEQ(FieldStoragePath, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Variant {
+	return predicate.Variant(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.Variant {
+	return predicate.Variant(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.Variant {
+	return predicate.Variant(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldContainsFold(FieldKind, v))
+}
+
+// ExtensionEQ applies the EQ predicate on the "extension" field.
+func ExtensionEQ(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldEQ(FieldExtension, v))
+}
+
+// ExtensionNEQ applies the NEQ predicate on the "extension" field.
+func ExtensionNEQ(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldNEQ(FieldExtension, v))
+}
+
+// ExtensionIn applies the In predicate on the "extension" field.
+func ExtensionIn(vs ...string) predicate.Variant {
+	return predicate.Variant(sql.FieldIn(FieldExtension, vs...))
+}
+
+// ExtensionNotIn applies the NotIn predicate on the "extension" field.
+func ExtensionNotIn(vs ...string) predicate.Variant {
+	return predicate.Variant(sql.FieldNotIn(FieldExtension, vs...))
+}
+
+// ExtensionGT applies the GT predicate on the "extension" field.
+func ExtensionGT(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldGT(FieldExtension, v))
+}
+
+// ExtensionGTE applies the GTE predicate on the "extension" field.
+func ExtensionGTE(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldGTE(FieldExtension, v))
+}
+
+// ExtensionLT applies the LT predicate on the "extension" field.
+func ExtensionLT(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldLT(FieldExtension, v))
+}
+
+// ExtensionLTE applies the LTE predicate on the "extension" field.
+func ExtensionLTE(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldLTE(FieldExtension, v))
+}
+
+// ExtensionContains applies the Contains predicate on the "extension" field.
+func ExtensionContains(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldContains(FieldExtension, v))
+}
+
+// ExtensionHasPrefix applies the HasPrefix predicate on the "extension" field.
+func ExtensionHasPrefix(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldHasPrefix(FieldExtension, v))
+}
+
+// ExtensionHasSuffix applies the HasSuffix predicate on the "extension" field.
+func ExtensionHasSuffix(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldHasSuffix(FieldExtension, v))
+}
+
+// ExtensionEqualFold applies the EqualFold predicate on the "extension" field.
+func ExtensionEqualFold(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldEqualFold(FieldExtension, v))
+}
+
+// ExtensionContainsFold applies the ContainsFold predicate on the "extension" field.
+func ExtensionContainsFold(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldContainsFold(FieldExtension, v))
+}
+
+// MimeTypeEQ applies the EQ predicate on the "mime_type" field.
+func MimeTypeEQ(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldEQ(FieldMimeType, v))
+}
+
+// MimeTypeNEQ applies the NEQ predicate on the "mime_type" field.
+func MimeTypeNEQ(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldNEQ(FieldMimeType, v))
+}
+
+// MimeTypeIn applies the In predicate on the "mime_type" field.
+func MimeTypeIn(vs ...string) predicate.Variant {
+	return predicate.Variant(sql.FieldIn(FieldMimeType, vs...))
+}
+
+// MimeTypeNotIn applies the NotIn predicate on the "mime_type" field.
+func MimeTypeNotIn(vs ...string) predicate.Variant {
+	return predicate.Variant(sql.FieldNotIn(FieldMimeType, vs...))
+}
+
+// MimeTypeGT applies the GT predicate on the "mime_type" field.
+func MimeTypeGT(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldGT(FieldMimeType, v))
+}
+
+// MimeTypeGTE applies the GTE predicate on the "mime_type" field.
+func MimeTypeGTE(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldGTE(FieldMimeType, v))
+}
+
+// MimeTypeLT applies the LT predicate on the "mime_type" field.
+func MimeTypeLT(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldLT(FieldMimeType, v))
+}
+
+// MimeTypeLTE applies the LTE predicate on the "mime_type" field.
+func MimeTypeLTE(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldLTE(FieldMimeType, v))
+}
+
+// MimeTypeContains applies the Contains predicate on the "mime_type" field.
+func MimeTypeContains(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldContains(FieldMimeType, v))
+}
+
+// MimeTypeHasPrefix applies the HasPrefix predicate on the "mime_type" field.
+func MimeTypeHasPrefix(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldHasPrefix(FieldMimeType, v))
+}
+
+// MimeTypeHasSuffix applies the HasSuffix predicate on the "mime_type" field.
+func MimeTypeHasSuffix(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldHasSuffix(FieldMimeType, v))
+}
+
+// MimeTypeIsNil applies the IsNil predicate on the "mime_type" field.
+func MimeTypeIsNil() predicate.Variant {
+	return predicate.Variant(sql.FieldIsNull(FieldMimeType))
+}
+
+// MimeTypeNotNil applies the NotNil predicate on the "mime_type" field.
+func MimeTypeNotNil() predicate.Variant {
+	return predicate.Variant(sql.FieldNotNull(FieldMimeType))
+}
+
+// MimeTypeEqualFold applies the EqualFold predicate on the "mime_type" field.
+func MimeTypeEqualFold(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldEqualFold(FieldMimeType, v))
+}
+
+// MimeTypeContainsFold applies the ContainsFold predicate on the "mime_type" field.
+func MimeTypeContainsFold(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldContainsFold(FieldMimeType, v))
+}
+
+// FileSizeBytesEQ applies the EQ predicate on the "file_size_bytes" field.
+func FileSizeBytesEQ(v int64) predicate.Variant {
+	return predicate.Variant(sql.FieldEQ(FieldFileSizeBytes, v))
+}
+
+// FileSizeBytesNEQ applies the NEQ predicate on the "file_size_bytes" field.
+func FileSizeBytesNEQ(v int64) predicate.Variant {
+	return predicate.Variant(sql.FieldNEQ(FieldFileSizeBytes, v))
+}
+
+// FileSizeBytesIn applies the In predicate on the "file_size_bytes" field.
+func FileSizeBytesIn(vs ...int64) predicate.Variant {
+	return predicate.Variant(sql.FieldIn(FieldFileSizeBytes, vs...))
+}
+
+// FileSizeBytesNotIn applies the NotIn predicate on the "file_size_bytes" field.
+func FileSizeBytesNotIn(vs ...int64) predicate.Variant {
+	return predicate.Variant(sql.FieldNotIn(FieldFileSizeBytes, vs...))
+}
+
+// FileSizeBytesGT applies the GT predicate on the "file_size_bytes" field.
+func FileSizeBytesGT(v int64) predicate.Variant {
+	return predicate.Variant(sql.FieldGT(FieldFileSizeBytes, v))
+}
+
+// FileSizeBytesGTE applies the GTE predicate on the "file_size_bytes" field.
+func FileSizeBytesGTE(v int64) predicate.Variant {
+	return predicate.Variant(sql.FieldGTE(FieldFileSizeBytes, v))
+}
+
+// FileSizeBytesLT applies the LT predicate on the "file_size_bytes" field.
+func FileSizeBytesLT(v int64) predicate.Variant {
+	return predicate.Variant(sql.FieldLT(FieldFileSizeBytes, v))
+}
+
+// FileSizeBytesLTE applies the LTE predicate on the "file_size_bytes" field.
+func FileSizeBytesLTE(v int64) predicate.Variant {
+	return predicate.Variant(sql.FieldLTE(FieldFileSizeBytes, v))
+}
+
+// StoragePathEQ applies the EQ predicate on the "storage_path" field.
+func StoragePathEQ(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldEQ(FieldStoragePath, v))
+}
+
+// StoragePathNEQ applies the NEQ predicate on the "storage_path" field.
+func StoragePathNEQ(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldNEQ(FieldStoragePath, v))
+}
+
+// StoragePathIn applies the In predicate on the "storage_path" field.
+func StoragePathIn(vs ...string) predicate.Variant {
+	return predicate.Variant(sql.FieldIn(FieldStoragePath, vs...))
+}
+
+// StoragePathNotIn applies the NotIn predicate on the "storage_path" field.
+func StoragePathNotIn(vs ...string) predicate.Variant {
+	return predicate.Variant(sql.FieldNotIn(FieldStoragePath, vs...))
+}
+
+// StoragePathGT applies the GT predicate on the "storage_path" field.
+func StoragePathGT(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldGT(FieldStoragePath, v))
+}
+
+// StoragePathGTE applies the GTE predicate on the "storage_path" field.
+func StoragePathGTE(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldGTE(FieldStoragePath, v))
+}
+
+// StoragePathLT applies the LT predicate on the "storage_path" field.
+func StoragePathLT(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldLT(FieldStoragePath, v))
+}
+
+// StoragePathLTE applies the LTE predicate on the "storage_path" field.
+func StoragePathLTE(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldLTE(FieldStoragePath, v))
+}
+
+// StoragePathContains applies the Contains predicate on the "storage_path" field.
+func StoragePathContains(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldContains(FieldStoragePath, v))
+}
+
+// StoragePathHasPrefix applies the HasPrefix predicate on the "storage_path" field.
+func StoragePathHasPrefix(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldHasPrefix(FieldStoragePath, v))
+}
+
+// StoragePathHasSuffix applies the HasSuffix predicate on the "storage_path" field.
+func StoragePathHasSuffix(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldHasSuffix(FieldStoragePath, v))
+}
+
+// StoragePathEqualFold applies the EqualFold predicate on the "storage_path" field.
+func StoragePathEqualFold(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldEqualFold(FieldStoragePath, v))
+}
+
+// StoragePathContainsFold applies the ContainsFold predicate on the "storage_path" field.
+func StoragePathContainsFold(v string) predicate.Variant {
+	return predicate.Variant(sql.FieldContainsFold(FieldStoragePath, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Variant {
+	return predicate.Variant(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Variant {
+	return predicate.Variant(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Variant {
+	return predicate.Variant(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Variant {
+	return predicate.Variant(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Variant {
+	return predicate.Variant(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Variant {
+	return predicate.Variant(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Variant {
+	return predicate.Variant(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Variant {
+	return predicate.Variant(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasAsset applies the HasEdge predicate on the "asset" edge.
+func HasAsset() predicate.Variant {
+	return predicate.Variant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AssetTable, AssetColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAssetWith applies the HasEdge predicate on the "asset" edge with a given conditions (other predicates).
+func HasAssetWith(preds ...predicate.Asset) predicate.Variant {
+	return predicate.Variant(func(s *sql.Selector) {
+		step := newAssetStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Variant) predicate.Variant {
+	return predicate.Variant(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Variant) predicate.Variant {
+	return predicate.Variant(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Variant) predicate.Variant {
+	return predicate.Variant(sql.NotPredicates(p))
+}
@@ -0,0 +1,327 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adimail/asset-manager/ent/asset"
+	"github.com/adimail/asset-manager/ent/variant"
+)
+
+// VariantCreate is the builder for creating a Variant entity.
+type VariantCreate struct {
+	config
+	mutation *VariantMutation
+	hooks    []Hook
+}
+
+// SetKind sets the "kind" field.
+func (_c *VariantCreate) SetKind(v string) *VariantCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetExtension sets the "extension" field.
+func (_c *VariantCreate) SetExtension(v string) *VariantCreate {
+	_c.mutation.SetExtension(v)
+	return _c
+}
+
+// SetMimeType sets the "mime_type" field.
+func (_c *VariantCreate) SetMimeType(v string) *VariantCreate {
+	_c.mutation.SetMimeType(v)
+	return _c
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (_c *VariantCreate) SetNillableMimeType(v *string) *VariantCreate {
+	if v != nil {
+		_c.SetMimeType(*v)
+	}
+	return _c
+}
+
+// SetFileSizeBytes sets the "file_size_bytes" field.
+func (_c *VariantCreate) SetFileSizeBytes(v int64) *VariantCreate {
+	_c.mutation.SetFileSizeBytes(v)
+	return _c
+}
+
+// SetStoragePath sets the "storage_path" field.
+func (_c *VariantCreate) SetStoragePath(v string) *VariantCreate {
+	_c.mutation.SetStoragePath(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *VariantCreate) SetCreatedAt(v time.Time) *VariantCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *VariantCreate) SetNillableCreatedAt(v *time.Time) *VariantCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *VariantCreate) SetID(v string) *VariantCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *VariantCreate) SetNillableID(v *string) *VariantCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAssetID sets the "asset" edge to the Asset entity by ID.
+func (_c *VariantCreate) SetAssetID(id string) *VariantCreate {
+	_c.mutation.SetAssetID(id)
+	return _c
+}
+
+// SetAsset sets the "asset" edge to the Asset entity.
+func (_c *VariantCreate) SetAsset(v *Asset) *VariantCreate {
+	return _c.SetAssetID(v.ID)
+}
+
+// Mutation returns the VariantMutation object of the builder.
+func (_c *VariantCreate) Mutation() *VariantMutation {
+	return _c.mutation
+}
+
+// Save creates the Variant in the database.
+func (_c *VariantCreate) Save(ctx context.Context) (*Variant, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *VariantCreate) SaveX(ctx context.Context) *Variant {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VariantCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VariantCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *VariantCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := variant.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := variant.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *VariantCreate) check() error {
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Variant.kind"`)}
+	}
+	if _, ok := _c.mutation.Extension(); !ok {
+		return &ValidationError{Name: "extension", err: errors.New(`ent: missing required field "Variant.extension"`)}
+	}
+	if _, ok := _c.mutation.FileSizeBytes(); !ok {
+		return &ValidationError{Name: "file_size_bytes", err: errors.New(`ent: missing required field "Variant.file_size_bytes"`)}
+	}
+	if _, ok := _c.mutation.StoragePath(); !ok {
+		return &ValidationError{Name: "storage_path", err: errors.New(`ent: missing required field "Variant.storage_path"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Variant.created_at"`)}
+	}
+	if len(_c.mutation.AssetIDs()) == 0 {
+		return &ValidationError{Name: "asset", err: errors.New(`ent: missing required edge "Variant.asset"`)}
+	}
+	return nil
+}
+
+func (_c *VariantCreate) sqlSave(ctx context.Context) (*Variant, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Variant.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *VariantCreate) createSpec() (*Variant, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Variant{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(variant.Table, sqlgraph.NewFieldSpec(variant.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(variant.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Extension(); ok {
+		_spec.SetField(variant.FieldExtension, field.TypeString, value)
+		_node.Extension = value
+	}
+	if value, ok := _c.mutation.MimeType(); ok {
+		_spec.SetField(variant.FieldMimeType, field.TypeString, value)
+		_node.MimeType = value
+	}
+	if value, ok := _c.mutation.FileSizeBytes(); ok {
+		_spec.SetField(variant.FieldFileSizeBytes, field.TypeInt64, value)
+		_node.FileSizeBytes = value
+	}
+	if value, ok := _c.mutation.StoragePath(); ok {
+		_spec.SetField(variant.FieldStoragePath, field.TypeString, value)
+		_node.StoragePath = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(variant.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.AssetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   variant.AssetTable,
+			Columns: []string{variant.AssetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.asset_variants = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// VariantCreateBulk is the builder for creating many Variant entities in bulk.
+type VariantCreateBulk struct {
+	config
+	err      error
+	builders []*VariantCreate
+}
+
+// Save creates the Variant entities in the database.
+func (_c *VariantCreateBulk) Save(ctx context.Context) ([]*Variant, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Variant, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VariantMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *VariantCreateBulk) SaveX(ctx context.Context) []*Variant {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VariantCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VariantCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,533 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/adimail/asset-manager/ent/asset"
+	"github.com/adimail/asset-manager/ent/predicate"
+	"github.com/adimail/asset-manager/ent/variant"
+)
+
+// VariantUpdate is the builder for updating Variant entities.
+type VariantUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VariantMutation
+}
+
+// Where appends a list predicates to the VariantUpdate builder.
+func (_u *VariantUpdate) Where(ps ...predicate.Variant) *VariantUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *VariantUpdate) SetKind(v string) *VariantUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *VariantUpdate) SetNillableKind(v *string) *VariantUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetExtension sets the "extension" field.
+func (_u *VariantUpdate) SetExtension(v string) *VariantUpdate {
+	_u.mutation.SetExtension(v)
+	return _u
+}
+
+// SetNillableExtension sets the "extension" field if the given value is not nil.
+func (_u *VariantUpdate) SetNillableExtension(v *string) *VariantUpdate {
+	if v != nil {
+		_u.SetExtension(*v)
+	}
+	return _u
+}
+
+// SetMimeType sets the "mime_type" field.
+func (_u *VariantUpdate) SetMimeType(v string) *VariantUpdate {
+	_u.mutation.SetMimeType(v)
+	return _u
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (_u *VariantUpdate) SetNillableMimeType(v *string) *VariantUpdate {
+	if v != nil {
+		_u.SetMimeType(*v)
+	}
+	return _u
+}
+
+// ClearMimeType clears the value of the "mime_type" field.
+func (_u *VariantUpdate) ClearMimeType() *VariantUpdate {
+	_u.mutation.ClearMimeType()
+	return _u
+}
+
+// SetFileSizeBytes sets the "file_size_bytes" field.
+func (_u *VariantUpdate) SetFileSizeBytes(v int64) *VariantUpdate {
+	_u.mutation.ResetFileSizeBytes()
+	_u.mutation.SetFileSizeBytes(v)
+	return _u
+}
+
+// SetNillableFileSizeBytes sets the "file_size_bytes" field if the given value is not nil.
+func (_u *VariantUpdate) SetNillableFileSizeBytes(v *int64) *VariantUpdate {
+	if v != nil {
+		_u.SetFileSizeBytes(*v)
+	}
+	return _u
+}
+
+// AddFileSizeBytes adds value to the "file_size_bytes" field.
+func (_u *VariantUpdate) AddFileSizeBytes(v int64) *VariantUpdate {
+	_u.mutation.AddFileSizeBytes(v)
+	return _u
+}
+
+// SetStoragePath sets the "storage_path" field.
+func (_u *VariantUpdate) SetStoragePath(v string) *VariantUpdate {
+	_u.mutation.SetStoragePath(v)
+	return _u
+}
+
+// SetNillableStoragePath sets the "storage_path" field if the given value is not nil.
+func (_u *VariantUpdate) SetNillableStoragePath(v *string) *VariantUpdate {
+	if v != nil {
+		_u.SetStoragePath(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *VariantUpdate) SetCreatedAt(v time.Time) *VariantUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *VariantUpdate) SetNillableCreatedAt(v *time.Time) *VariantUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetAssetID sets the "asset" edge to the Asset entity by ID.
+func (_u *VariantUpdate) SetAssetID(id string) *VariantUpdate {
+	_u.mutation.SetAssetID(id)
+	return _u
+}
+
+// SetAsset sets the "asset" edge to the Asset entity.
+func (_u *VariantUpdate) SetAsset(v *Asset) *VariantUpdate {
+	return _u.SetAssetID(v.ID)
+}
+
+// Mutation returns the VariantMutation object of the builder.
+func (_u *VariantUpdate) Mutation() *VariantMutation {
+	return _u.mutation
+}
+
+// ClearAsset clears the "asset" edge to the Asset entity.
+func (_u *VariantUpdate) ClearAsset() *VariantUpdate {
+	_u.mutation.ClearAsset()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *VariantUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VariantUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *VariantUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VariantUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *VariantUpdate) check() error {
+	if _u.mutation.AssetCleared() && len(_u.mutation.AssetIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Variant.asset"`)
+	}
+	return nil
+}
+
+func (_u *VariantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(variant.Table, variant.Columns, sqlgraph.NewFieldSpec(variant.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(variant.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Extension(); ok {
+		_spec.SetField(variant.FieldExtension, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MimeType(); ok {
+		_spec.SetField(variant.FieldMimeType, field.TypeString, value)
+	}
+	if _u.mutation.MimeTypeCleared() {
+		_spec.ClearField(variant.FieldMimeType, field.TypeString)
+	}
+	if value, ok := _u.mutation.FileSizeBytes(); ok {
+		_spec.SetField(variant.FieldFileSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedFileSizeBytes(); ok {
+		_spec.AddField(variant.FieldFileSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.StoragePath(); ok {
+		_spec.SetField(variant.FieldStoragePath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(variant.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AssetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   variant.AssetTable,
+			Columns: []string{variant.AssetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AssetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   variant.AssetTable,
+			Columns: []string{variant.AssetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{variant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// VariantUpdateOne is the builder for updating a single Variant entity.
+type VariantUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VariantMutation
+}
+
+// SetKind sets the "kind" field.
+func (_u *VariantUpdateOne) SetKind(v string) *VariantUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *VariantUpdateOne) SetNillableKind(v *string) *VariantUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetExtension sets the "extension" field.
+func (_u *VariantUpdateOne) SetExtension(v string) *VariantUpdateOne {
+	_u.mutation.SetExtension(v)
+	return _u
+}
+
+// SetNillableExtension sets the "extension" field if the given value is not nil.
+func (_u *VariantUpdateOne) SetNillableExtension(v *string) *VariantUpdateOne {
+	if v != nil {
+		_u.SetExtension(*v)
+	}
+	return _u
+}
+
+// SetMimeType sets the "mime_type" field.
+func (_u *VariantUpdateOne) SetMimeType(v string) *VariantUpdateOne {
+	_u.mutation.SetMimeType(v)
+	return _u
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (_u *VariantUpdateOne) SetNillableMimeType(v *string) *VariantUpdateOne {
+	if v != nil {
+		_u.SetMimeType(*v)
+	}
+	return _u
+}
+
+// ClearMimeType clears the value of the "mime_type" field.
+func (_u *VariantUpdateOne) ClearMimeType() *VariantUpdateOne {
+	_u.mutation.ClearMimeType()
+	return _u
+}
+
+// SetFileSizeBytes sets the "file_size_bytes" field.
+func (_u *VariantUpdateOne) SetFileSizeBytes(v int64) *VariantUpdateOne {
+	_u.mutation.ResetFileSizeBytes()
+	_u.mutation.SetFileSizeBytes(v)
+	return _u
+}
+
+// SetNillableFileSizeBytes sets the "file_size_bytes" field if the given value is not nil.
+func (_u *VariantUpdateOne) SetNillableFileSizeBytes(v *int64) *VariantUpdateOne {
+	if v != nil {
+		_u.SetFileSizeBytes(*v)
+	}
+	return _u
+}
+
+// AddFileSizeBytes adds value to the "file_size_bytes" field.
+func (_u *VariantUpdateOne) AddFileSizeBytes(v int64) *VariantUpdateOne {
+	_u.mutation.AddFileSizeBytes(v)
+	return _u
+}
+
+// SetStoragePath sets the "storage_path" field.
+func (_u *VariantUpdateOne) SetStoragePath(v string) *VariantUpdateOne {
+	_u.mutation.SetStoragePath(v)
+	return _u
+}
+
+// SetNillableStoragePath sets the "storage_path" field if the given value is not nil.
+func (_u *VariantUpdateOne) SetNillableStoragePath(v *string) *VariantUpdateOne {
+	if v != nil {
+		_u.SetStoragePath(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *VariantUpdateOne) SetCreatedAt(v time.Time) *VariantUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *VariantUpdateOne) SetNillableCreatedAt(v *time.Time) *VariantUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetAssetID sets the "asset" edge to the Asset entity by ID.
+func (_u *VariantUpdateOne) SetAssetID(id string) *VariantUpdateOne {
+	_u.mutation.SetAssetID(id)
+	return _u
+}
+
+// SetAsset sets the "asset" edge to the Asset entity.
+func (_u *VariantUpdateOne) SetAsset(v *Asset) *VariantUpdateOne {
+	return _u.SetAssetID(v.ID)
+}
+
+// Mutation returns the VariantMutation object of the builder.
+func (_u *VariantUpdateOne) Mutation() *VariantMutation {
+	return _u.mutation
+}
+
+// ClearAsset clears the "asset" edge to the Asset entity.
+func (_u *VariantUpdateOne) ClearAsset() *VariantUpdateOne {
+	_u.mutation.ClearAsset()
+	return _u
+}
+
+// Where appends a list predicates to the VariantUpdate builder.
+func (_u *VariantUpdateOne) Where(ps ...predicate.Variant) *VariantUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *VariantUpdateOne) Select(field string, fields ...string) *VariantUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Variant entity.
+func (_u *VariantUpdateOne) Save(ctx context.Context) (*Variant, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VariantUpdateOne) SaveX(ctx context.Context) *Variant {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *VariantUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VariantUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *VariantUpdateOne) check() error {
+	if _u.mutation.AssetCleared() && len(_u.mutation.AssetIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Variant.asset"`)
+	}
+	return nil
+}
+
+func (_u *VariantUpdateOne) sqlSave(ctx context.Context) (_node *Variant, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(variant.Table, variant.Columns, sqlgraph.NewFieldSpec(variant.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Variant.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, variant.FieldID)
+		for _, f := range fields {
+			if !variant.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != variant.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(variant.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Extension(); ok {
+		_spec.SetField(variant.FieldExtension, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MimeType(); ok {
+		_spec.SetField(variant.FieldMimeType, field.TypeString, value)
+	}
+	if _u.mutation.MimeTypeCleared() {
+		_spec.ClearField(variant.FieldMimeType, field.TypeString)
+	}
+	if value, ok := _u.mutation.FileSizeBytes(); ok {
+		_spec.SetField(variant.FieldFileSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedFileSizeBytes(); ok {
+		_spec.AddField(variant.FieldFileSizeBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.StoragePath(); ok {
+		_spec.SetField(variant.FieldStoragePath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(variant.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AssetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   variant.AssetTable,
+			Columns: []string{variant.AssetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AssetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   variant.AssetTable,
+			Columns: []string{variant.AssetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Variant{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{variant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
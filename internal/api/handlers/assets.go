@@ -2,9 +2,13 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
+	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/adimail/asset-manager/internal/assets"
 	"github.com/gorilla/mux"
@@ -31,6 +35,10 @@ func (h *AssetHandler) Upload(w http.ResponseWriter, r *http.Request) {
 		Filename: header.Filename,
 		Size:     header.Size,
 	}
+	if t := r.FormValue("tag_ids"); t != "" {
+		req.TagIDs = strings.Split(t, ",")
+	}
+	req.StripMetadata = r.FormValue("strip_metadata") == "true"
 
 	asset, err := h.service.Upload(r.Context(), req)
 	if err != nil {
@@ -107,7 +115,18 @@ func (h *AssetHandler) BulkDelete(w http.ResponseWriter, r *http.Request) {
 
 func (h *AssetHandler) Compress(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
-	if err := h.service.CompressAsset(r.Context(), vars["id"]); err != nil {
+	var opts assets.CompressOptions
+	if err := json.NewDecoder(r.Body).Decode(&opts); err != nil && !errors.Is(err, io.EOF) {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	err := h.service.CompressAsset(r.Context(), vars["id"], opts)
+	if errors.Is(err, assets.ErrJobActive) {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -117,19 +136,135 @@ func (h *AssetHandler) Compress(w http.ResponseWriter, r *http.Request) {
 func (h *AssetHandler) BulkCompress(w http.ResponseWriter, r *http.Request) {
 	var req struct {
 		IDs []string `json:"ids"`
+		assets.CompressOptions
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.service.CompressMultiple(r.Context(), req.IDs, req.CompressOptions); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// Estimate encodes a sample of the asset and reports the projected
+// compression result without changing the asset.
+func (h *AssetHandler) Estimate(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	var opts assets.CompressOptions
+	if err := json.NewDecoder(r.Body).Decode(&opts); err != nil && !errors.Is(err, io.EOF) {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// A sample video encode can outlast the server's write timeout.
+	http.NewResponseController(w).SetWriteDeadline(time.Time{})
+
+	estimate, err := h.service.EstimateCompression(r.Context(), vars["id"], opts)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(estimate)
+}
+
+func (h *AssetHandler) BulkEstimate(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		IDs []string `json:"ids"`
+		assets.CompressOptions
 	}
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, "Invalid request body", http.StatusBadRequest)
 		return
 	}
 
-	if err := h.service.CompressMultiple(r.Context(), req.IDs); err != nil {
+	// Sample encodes of a large selection outlast the server's write
+	// timeout.
+	http.NewResponseController(w).SetWriteDeadline(time.Time{})
+
+	summary, err := h.service.EstimateMultiple(r.Context(), req.IDs, req.CompressOptions)
+	if errors.Is(err, assets.ErrTooManyAssets) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(summary)
+}
+
+func (h *AssetHandler) Watermark(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	var opts assets.WatermarkOptions
+	if err := json.NewDecoder(r.Body).Decode(&opts); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	err := h.service.Watermark(r.Context(), vars["id"], opts)
+	if errors.Is(err, assets.ErrInvalidPipeline) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if errors.Is(err, assets.ErrJobActive) {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *AssetHandler) DownloadVariant(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	path, err := h.service.GetVariantPath(r.Context(), vars["id"], vars["kind"])
+	if err != nil {
+		http.Error(w, "Variant not found", http.StatusNotFound)
+		return
+	}
+	http.ServeFile(w, r, path)
+}
+
+func (h *AssetHandler) StripMetadata(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	err := h.service.StripMetadata(r.Context(), vars["id"])
+	if errors.Is(err, assets.ErrJobActive) {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 	w.WriteHeader(http.StatusAccepted)
 }
 
+// LocationReport lists assets that still carry GPS coordinates. Pass
+// rescan=true to re-check every image and video first.
+func (h *AssetHandler) LocationReport(w http.ResponseWriter, r *http.Request) {
+	rescan := r.URL.Query().Get("rescan") == "true"
+	if rescan {
+		// Re-reading a large library outlasts the server's write timeout.
+		http.NewResponseController(w).SetWriteDeadline(time.Time{})
+	}
+
+	report, err := h.service.LocationReport(r.Context(), rescan)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(report)
+}
+
 func (h *AssetHandler) Download(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	asset, err := h.service.Get(r.Context(), vars["id"])
@@ -139,5 +274,101 @@ func (h *AssetHandler) Download(w http.ResponseWriter, r *http.Request) {
 	}
 
 	fullPath := h.service.GetFullStoragePath(asset)
+	if r.URL.Query().Get("format") == "auto" {
+		w.Header().Add("Vary", "Accept")
+		fullPath = negotiatePath(asset, r.Header.Get("Accept"))
+	}
 	http.ServeFile(w, r, fullPath)
 }
+
+func (h *AssetHandler) PackageHLS(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	err := h.service.PackageHLS(r.Context(), vars["id"])
+	if errors.Is(err, assets.ErrJobActive) {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *AssetHandler) RunPipeline(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	var opts assets.PipelineOptions
+	if err := json.NewDecoder(r.Body).Decode(&opts); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	err := h.service.RunPipeline(r.Context(), vars["id"], opts)
+	if errors.Is(err, assets.ErrInvalidPipeline) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if errors.Is(err, assets.ErrJobActive) {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *AssetHandler) ServeHLS(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	asset, err := h.service.Get(r.Context(), vars["id"])
+	if err != nil {
+		http.Error(w, "Asset not found", http.StatusNotFound)
+		return
+	}
+
+	path, err := h.service.GetHLSFilePath(asset, vars["path"])
+	if err != nil {
+		http.Error(w, "File not found", http.StatusNotFound)
+		return
+	}
+
+	switch filepath.Ext(path) {
+	case ".m3u8":
+		w.Header().Set("Content-Type", "application/vnd.apple.mpegurl")
+	case ".ts":
+		w.Header().Set("Content-Type", "video/mp2t")
+	}
+	http.ServeFile(w, r, path)
+}
+
+func (h *AssetHandler) Restore(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	asset, err := h.service.RestoreOriginal(r.Context(), vars["id"])
+	if errors.Is(err, assets.ErrNoOriginal) || errors.Is(err, assets.ErrJobActive) {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(asset)
+}
+
+func (h *AssetHandler) DownloadOriginal(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	asset, err := h.service.Get(r.Context(), vars["id"])
+	if err != nil {
+		http.Error(w, "Asset not found", http.StatusNotFound)
+		return
+	}
+
+	if asset.OriginalPath == "" {
+		http.Error(w, "Original not found", http.StatusNotFound)
+		return
+	}
+	http.ServeFile(w, r, asset.OriginalPath)
+}
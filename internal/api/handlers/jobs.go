@@ -0,0 +1,155 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/adimail/asset-manager/ent"
+	"github.com/adimail/asset-manager/internal/preprocessing"
+	"github.com/gorilla/mux"
+)
+
+type JobHandler struct {
+	service *preprocessing.Service
+}
+
+func NewJobHandler(s *preprocessing.Service) *JobHandler {
+	return &JobHandler{service: s}
+}
+
+func (h *JobHandler) List(w http.ResponseWriter, r *http.Request) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	res, err := h.service.ListJobs(r.Context(), preprocessing.JobFilter{
+		Status:  preprocessing.JobStatus(r.URL.Query().Get("status")),
+		AssetID: r.URL.Query().Get("asset_id"),
+		Page:    page,
+		Limit:   limit,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(res)
+}
+
+func (h *JobHandler) Get(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	job, err := h.service.GetJob(r.Context(), vars["id"])
+	if ent.IsNotFound(err) {
+		http.Error(w, "Job not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(job)
+}
+
+// Log returns the commands, encoder settings and tool output of a job's
+// latest attempt.
+func (h *JobHandler) Log(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	l, err := h.service.GetJobLog(r.Context(), vars["id"])
+	if ent.IsNotFound(err) {
+		http.Error(w, "Job not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(l)
+}
+
+func (h *JobHandler) AssetJobs(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	jobs, err := h.service.AssetJobs(r.Context(), vars["id"])
+	if ent.IsNotFound(err) {
+		http.Error(w, "Asset not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(jobs)
+}
+
+func (h *JobHandler) Cancel(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	job, err := h.service.CancelJob(r.Context(), vars["id"])
+	if ent.IsNotFound(err) {
+		http.Error(w, "Job not found", http.StatusNotFound)
+		return
+	}
+	if errors.Is(err, preprocessing.ErrJobFinished) {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(job)
+}
+
+func (h *JobHandler) RetryFailed(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		AssetID string `json:"asset_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	n, err := h.service.RetryFailed(r.Context(), req.AssetID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]int{
+		"retried": n,
+	})
+}
+
+func (h *JobHandler) Profiles(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(h.service.Profiles())
+}
+
+func (h *JobHandler) Workers(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(h.service.Workers())
+}
+
+// SetWorkers resizes worker pools, e.g. {"image": 4, "video": 1}. Pools left
+// out of the body keep their size.
+func (h *JobHandler) SetWorkers(w http.ResponseWriter, r *http.Request) {
+	var counts map[string]int
+	if err := json.NewDecoder(r.Body).Decode(&counts); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	for pool, n := range counts {
+		if err := h.service.SetWorkers(pool, n); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(h.service.Workers())
+}
@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/adimail/asset-manager/internal/assets"
+)
+
+type MaintenanceHandler struct {
+	janitor *assets.Janitor
+}
+
+func NewMaintenanceHandler(j *assets.Janitor) *MaintenanceHandler {
+	return &MaintenanceHandler{janitor: j}
+}
+
+func (h *MaintenanceHandler) RunJanitor(w http.ResponseWriter, r *http.Request) {
+	dryRun := r.URL.Query().Get("dry_run") == "true"
+
+	report, err := h.janitor.Run(r.Context(), dryRun)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(report)
+}
@@ -1,9 +1,11 @@
 package handlers
 
 import (
+	"net"
 	"net/http"
 	"time"
 
+	"github.com/adimail/asset-manager/internal/preprocessing"
 	"github.com/adimail/asset-manager/pkg/logger"
 	"go.uber.org/zap"
 )
@@ -42,3 +44,16 @@ func LoggingMiddleware(next http.Handler) http.Handler {
 		}
 	})
 }
+
+// SubmitterMiddleware records who made the request, so jobs it enqueues are
+// scheduled fairly against other submitters. Clients identify themselves
+// with the X-Submitter header; otherwise the remote address is used.
+func SubmitterMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		submitter := r.Header.Get("X-Submitter")
+		if submitter == "" {
+			submitter, _, _ = net.SplitHostPort(r.RemoteAddr)
+		}
+		next.ServeHTTP(w, r.WithContext(preprocessing.WithSubmitter(r.Context(), submitter)))
+	})
+}
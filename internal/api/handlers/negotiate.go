@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"mime"
+	"strconv"
+	"strings"
+
+	"github.com/adimail/asset-manager/internal/assets"
+)
+
+// negotiatePath picks the file to serve for an asset based on the request's
+// Accept header. The primary file is only served when the client lists its
+// type explicitly, since browsers that cannot decode WebP or AVIF still send
+// */*. Otherwise the first acceptable fallback variant is used.
+func negotiatePath(a *assets.Asset, accept string) string {
+	var fallbacks []assets.Variant
+	for _, v := range a.Variants {
+		if v.Kind == assets.VariantFallback {
+			fallbacks = append(fallbacks, v)
+		}
+	}
+	if len(fallbacks) == 0 || accepts(accept, a.MimeType, false) {
+		return a.StoragePath
+	}
+	for _, v := range fallbacks {
+		if accepts(accept, v.MimeType, true) {
+			return v.StoragePath
+		}
+	}
+	return fallbacks[0].StoragePath
+}
+
+// accepts reports whether the Accept header allows mimeType with a non-zero
+// quality. Wildcard ranges only count when wildcard is set.
+func accepts(accept, mimeType string, wildcard bool) bool {
+	if mimeType == "" {
+		return false
+	}
+	major, _, _ := strings.Cut(mimeType, "/")
+
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		if q, ok := params["q"]; ok {
+			if f, err := strconv.ParseFloat(q, 64); err == nil && f <= 0 {
+				continue
+			}
+		}
+
+		switch mediaType {
+		case mimeType:
+			return true
+		case major + "/*", "*/*":
+			if wildcard {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -7,32 +7,50 @@ import (
 
 	"github.com/adimail/asset-manager/internal/api/handlers"
 	"github.com/adimail/asset-manager/internal/assets"
+	"github.com/adimail/asset-manager/internal/preprocessing"
+	"github.com/adimail/asset-manager/internal/rules"
 	"github.com/adimail/asset-manager/internal/tags"
 	"github.com/gorilla/mux"
 	"github.com/rs/cors"
 )
 
-func NewServer(assetService *assets.Service, tagService *tags.Service) http.Handler {
+func NewServer(assetService *assets.Service, tagService *tags.Service, jobService *preprocessing.Service, janitor *assets.Janitor, ruleService *rules.Service) http.Handler {
 	r := mux.NewRouter()
 	r.Use(handlers.LoggingMiddleware)
 
 	h := handlers.NewAssetHandler(assetService)
 	th := handlers.NewTagHandler(tagService)
+	jh := handlers.NewJobHandler(jobService)
+	mh := handlers.NewMaintenanceHandler(janitor)
+	rh := handlers.NewRuleHandler(ruleService)
 
 	r.HandleFunc("/internal/health", handlers.HealthCheck).Methods("GET")
 
 	api := r.PathPrefix("/api/v1").Subrouter()
+	api.Use(handlers.SubmitterMiddleware)
 
 	// Assets
 	api.HandleFunc("/assets", h.Upload).Methods("POST")
 	api.HandleFunc("/assets", h.List).Methods("GET")
 	api.HandleFunc("/assets/bulk/delete", h.BulkDelete).Methods("POST")
 	api.HandleFunc("/assets/bulk/compress", h.BulkCompress).Methods("POST")
+	api.HandleFunc("/assets/bulk/estimate", h.BulkEstimate).Methods("POST")
+	api.HandleFunc("/assets/reports/location", h.LocationReport).Methods("GET")
 	api.HandleFunc("/assets/{id}", h.Delete).Methods("DELETE")
 	api.HandleFunc("/assets/{id}", h.Update).Methods("PUT")
 	api.HandleFunc("/assets/{id}/download", h.Download).Methods("GET")
+	api.HandleFunc("/assets/{id}/original", h.DownloadOriginal).Methods("GET")
 	api.HandleFunc("/assets/{id}/compress", h.Compress).Methods("POST")
+	api.HandleFunc("/assets/{id}/estimate", h.Estimate).Methods("POST")
+	api.HandleFunc("/assets/{id}/restore", h.Restore).Methods("POST")
+	api.HandleFunc("/assets/{id}/hls", h.PackageHLS).Methods("POST")
+	api.HandleFunc("/assets/{id}/pipeline", h.RunPipeline).Methods("POST")
+	api.HandleFunc("/assets/{id}/strip-metadata", h.StripMetadata).Methods("POST")
+	api.HandleFunc("/assets/{id}/watermark", h.Watermark).Methods("POST")
+	api.HandleFunc("/assets/{id}/variants/{kind}", h.DownloadVariant).Methods("GET")
+	api.HandleFunc("/assets/{id}/hls/{path:.+}", h.ServeHLS).Methods("GET")
 	api.HandleFunc("/assets/{id}/tags", th.TagAsset).Methods("POST")
+	api.HandleFunc("/assets/{id}/jobs", jh.AssetJobs).Methods("GET")
 
 	// Tags
 	api.HandleFunc("/tags", th.Create).Methods("POST")
@@ -41,6 +59,25 @@ func NewServer(assetService *assets.Service, tagService *tags.Service) http.Hand
 	api.HandleFunc("/tags/{id}", th.Update).Methods("PUT")
 	api.HandleFunc("/tags/{id}", th.Delete).Methods("DELETE")
 
+	// Compression jobs
+	api.HandleFunc("/jobs", jh.List).Methods("GET")
+	api.HandleFunc("/jobs/retry-failed", jh.RetryFailed).Methods("POST")
+	api.HandleFunc("/jobs/{id}", jh.Get).Methods("GET")
+	api.HandleFunc("/jobs/{id}/log", jh.Log).Methods("GET")
+	api.HandleFunc("/jobs/{id}/cancel", jh.Cancel).Methods("POST")
+	api.HandleFunc("/compression/profiles", jh.Profiles).Methods("GET")
+	api.HandleFunc("/compression/workers", jh.Workers).Methods("GET")
+	api.HandleFunc("/compression/workers", jh.SetWorkers).Methods("PUT")
+
+	// Auto-process rules
+	api.HandleFunc("/rules", rh.Create).Methods("POST")
+	api.HandleFunc("/rules", rh.List).Methods("GET")
+	api.HandleFunc("/rules/{id}", rh.Update).Methods("PUT")
+	api.HandleFunc("/rules/{id}", rh.Delete).Methods("DELETE")
+
+	// Maintenance
+	api.HandleFunc("/maintenance/janitor", mh.RunJanitor).Methods("POST")
+
 	staticPath := "web/dist"
 	indexPath := "index.html"
 
@@ -0,0 +1,143 @@
+package assets
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"time"
+
+	"github.com/adimail/asset-manager/ent/asset"
+)
+
+// Janitor deletes the originals kept by compression once they are older than
+// the retention window, reclaiming the disk space they use.
+type Janitor struct {
+	service   *Service
+	retention time.Duration
+	interval  time.Duration
+	dryRun    bool
+}
+
+type JanitorItem struct {
+	AssetID string `json:"asset_id"`
+	// CompressedAt is when the original was kept, which a pipeline may
+	// do without compressing the asset.
+	CompressedAt time.Time `json:"compressed_at"`
+	Bytes        int64     `json:"bytes"`
+}
+
+type JanitorReport struct {
+	DryRun         bool          `json:"dry_run"`
+	Cutoff         time.Time     `json:"cutoff"`
+	Originals      []JanitorItem `json:"originals"`
+	RemovedCount   int           `json:"removed_count"`
+	ReclaimedBytes int64         `json:"reclaimed_bytes"`
+}
+
+// NewJanitor creates a janitor that removes originals older than
+// retainDays. A retainDays of 0 keeps originals forever.
+func NewJanitor(service *Service, retainDays int, interval time.Duration, dryRun bool) *Janitor {
+	return &Janitor{
+		service:   service,
+		retention: time.Duration(retainDays) * 24 * time.Hour,
+		interval:  interval,
+		dryRun:    dryRun,
+	}
+}
+
+// Start runs the janitor every interval until ctx is done.
+func (j *Janitor) Start(ctx context.Context) {
+	if j.retention <= 0 || j.interval <= 0 {
+		log.Printf("Original janitor disabled")
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+		for {
+			report, err := j.Run(ctx, j.dryRun)
+			if err != nil {
+				log.Printf("Original janitor failed: %v", err)
+			} else if report.RemovedCount > 0 {
+				verb := "Removed"
+				if report.DryRun {
+					verb = "Would remove"
+				}
+				log.Printf("Original janitor: %s %d originals, %d bytes", verb, report.RemovedCount, report.ReclaimedBytes)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Run removes expired originals once. In dry-run mode it only reports what
+// would be removed.
+func (j *Janitor) Run(ctx context.Context, dryRun bool) (*JanitorReport, error) {
+	report := &JanitorReport{
+		DryRun:    dryRun,
+		Originals: []JanitorItem{},
+	}
+	if j.retention <= 0 {
+		return report, nil
+	}
+	report.Cutoff = time.Now().Add(-j.retention)
+
+	client := j.service.client
+	expired, err := client.Asset.Query().
+		Where(
+			asset.OriginalPathNEQ(""),
+			asset.Or(
+				asset.OriginalKeptAtLT(report.Cutoff),
+				// Kept before original_kept_at was recorded.
+				asset.And(asset.OriginalKeptAtIsNil(), asset.CompressedAtLT(report.Cutoff)),
+				// Compressed before compressed_at was recorded.
+				asset.And(asset.OriginalKeptAtIsNil(), asset.CompressedAtIsNil(), asset.CreatedAtLT(report.Cutoff)),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, a := range expired {
+		var size int64
+		if info, err := os.Stat(a.OriginalPath); err == nil {
+			size = info.Size()
+		}
+
+		if !dryRun {
+			if err := j.service.storage.Delete(a.OriginalPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+				log.Printf("failed to delete original %s: %v", a.OriginalPath, err)
+				continue
+			}
+			if err := client.Asset.UpdateOneID(a.ID).ClearOriginalPath().ClearOriginalKeptAt().Exec(ctx); err != nil {
+				log.Printf("failed to clear original path for asset %s: %v", a.ID, err)
+				continue
+			}
+		}
+
+		compressedAt := a.OriginalKeptAt
+		if compressedAt.IsZero() {
+			compressedAt = a.CompressedAt
+		}
+		if compressedAt.IsZero() {
+			compressedAt = a.CreatedAt
+		}
+		report.Originals = append(report.Originals, JanitorItem{
+			AssetID:      a.ID,
+			CompressedAt: compressedAt,
+			Bytes:        size,
+		})
+		report.RemovedCount++
+		report.ReclaimedBytes += size
+	}
+
+	return report, nil
+}
@@ -5,14 +5,19 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"mime"
+	"os"
 	"path/filepath"
 	"strings"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/adimail/asset-manager/ent"
 	"github.com/adimail/asset-manager/ent/asset"
 	"github.com/adimail/asset-manager/ent/compressionjob"
+	"github.com/adimail/asset-manager/ent/predicate"
 	"github.com/adimail/asset-manager/ent/tag"
+	"github.com/adimail/asset-manager/ent/variant"
 	"github.com/adimail/asset-manager/internal/preprocessing"
 	"github.com/google/uuid"
 )
@@ -20,6 +25,8 @@ import (
 type FileStorage interface {
 	Write(path string, data io.Reader) error
 	Delete(path string) error
+	DeleteAll(path string) error
+	Move(src, dst string) error
 }
 
 type Service struct {
@@ -28,6 +35,7 @@ type Service struct {
 	validator    *Validator
 	assetsDir    string
 	preprocessor *preprocessing.Service
+	uploadHooks  []UploadHook
 }
 
 func NewService(client *ent.Client, storage FileStorage, validator *Validator, assetsDir string, preprocessor *preprocessing.Service) *Service {
@@ -40,6 +48,11 @@ func NewService(client *ent.Client, storage FileStorage, validator *Validator, a
 	}
 }
 
+// OnUpload registers a hook to run after each successful upload.
+func (s *Service) OnUpload(hook UploadHook) {
+	s.uploadHooks = append(s.uploadHooks, hook)
+}
+
 func (s *Service) getAbsolutePath(relativePath string) string {
 	abs, _ := filepath.Abs(filepath.Join(s.assetsDir, relativePath))
 	return abs
@@ -60,22 +73,53 @@ func (s *Service) Upload(ctx context.Context, req UploadRequest) (*Asset, error)
 		return nil, fmt.Errorf("failed to write file to managed folder: %w", err)
 	}
 
+	size := req.Size
+	if req.StripMetadata {
+		if !canStrip(fileType, ext) {
+			s.storage.Delete(managedPath)
+			return nil, fmt.Errorf("metadata stripping is not supported for %s files", ext)
+		}
+		if err := s.preprocessor.StripMetadata(ctx, managedPath); err != nil {
+			s.storage.Delete(managedPath)
+			return nil, fmt.Errorf("failed to strip metadata: %w", err)
+		}
+		if info, err := os.Stat(managedPath); err == nil {
+			size = info.Size()
+		}
+	}
+	hasLocation := false
+	if fileType == FileTypeImage || fileType == FileTypeVideo {
+		hasLocation = s.preprocessor.HasLocation(ctx, managedPath)
+	}
+
 	saved, err := s.client.Asset.Create().
 		SetID(id).
 		SetOriginalFilename(req.Filename).
 		SetFileType(string(fileType)).
 		SetExtension(ext).
-		SetFileSizeBytes(req.Size).
+		SetMimeType(mimeType(ext)).
+		SetFileSizeBytes(size).
 		SetStoragePath(managedPath).
 		SetCreatedAt(time.Now()).
 		SetIsCompressed(false).
+		SetMetadataStripped(req.StripMetadata).
+		SetHasLocation(hasLocation).
+		AddTagIDs(req.TagIDs...).
 		Save(ctx)
 	if err != nil {
 		s.storage.Delete(managedPath)
 		return nil, fmt.Errorf("failed to save metadata: %w", err)
 	}
 
-	return s.mapToDomain(saved), nil
+	if len(req.TagIDs) > 0 {
+		saved.Edges.Tags, _ = saved.QueryTags().All(ctx)
+	}
+	uploaded := s.mapToDomain(saved)
+	for _, hook := range s.uploadHooks {
+		hook.AssetUploaded(ctx, uploaded)
+	}
+
+	return uploaded, nil
 }
 
 func (s *Service) Rename(ctx context.Context, id string, newName string) (*Asset, error) {
@@ -92,6 +136,7 @@ func (s *Service) Rename(ctx context.Context, id string, newName string) (*Asset
 	a, err := s.client.Asset.UpdateOneID(id).
 		SetOriginalFilename(newName).
 		SetExtension(ext).
+		SetMimeType(mimeType(ext)).
 		SetFileType(string(fileType)).
 		Save(ctx)
 	if err != nil {
@@ -100,25 +145,354 @@ func (s *Service) Rename(ctx context.Context, id string, newName string) (*Asset
 	return s.mapToDomain(a), nil
 }
 
-func (s *Service) CompressAsset(ctx context.Context, id string) error {
+func (s *Service) CompressAsset(ctx context.Context, id string, opts CompressOptions) error {
 	a, err := s.client.Asset.Get(ctx, id)
 	if err != nil {
 		return err
 	}
 
+	if err := checkCompressible(a, opts); err != nil {
+		return err
+	}
+
+	return s.preprocessor.Enqueue(ctx, id, opts.jobOptions(FileType(a.FileType)))
+}
+
+// EstimateCompression projects what compressing an asset with opts would
+// save by encoding a sample. The asset is not modified.
+func (s *Service) EstimateCompression(ctx context.Context, id string, opts CompressOptions) (*preprocessing.Estimate, error) {
+	a, err := s.client.Asset.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkCompressible(a, opts); err != nil {
+		return nil, err
+	}
+
+	return s.preprocessor.Estimate(ctx, id, opts.jobOptions(FileType(a.FileType)))
+}
+
+// EstimateMultiple estimates the assets a bulk compression of ids would
+// queue, one at a time, and totals the results. At most MaxEstimateAssets
+// ids are accepted.
+func (s *Service) EstimateMultiple(ctx context.Context, ids []string, opts CompressOptions) (*EstimateSummary, error) {
+	if len(ids) > MaxEstimateAssets {
+		return nil, fmt.Errorf("%w: %d selected, at most %d", ErrTooManyAssets, len(ids), MaxEstimateAssets)
+	}
+	if opts.Format != "" && !preprocessing.IsImageFormat(opts.Format) {
+		return nil, fmt.Errorf("unsupported image format: %s", opts.Format)
+	}
+	if opts.AudioCodec != "" && !preprocessing.IsAudioCodec(opts.AudioCodec) {
+		return nil, fmt.Errorf("unsupported audio codec: %s", opts.AudioCodec)
+	}
+
+	assets, err := s.client.Asset.Query().Where(asset.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &EstimateSummary{Assets: []*preprocessing.Estimate{}}
+	for _, a := range assets {
+		ft := FileType(a.FileType)
+		if !isCompressible(ft) || a.IsCompressed || a.AlreadyOptimal {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		e, err := s.preprocessor.Estimate(ctx, a.ID, opts.jobOptions(ft))
+		if err != nil {
+			summary.Assets = append(summary.Assets, &preprocessing.Estimate{AssetID: a.ID, Error: err.Error()})
+			continue
+		}
+		summary.Assets = append(summary.Assets, e)
+		summary.OriginalBytes += e.OriginalBytes
+		summary.ProjectedBytes += e.ProjectedBytes
+		summary.ProjectedSeconds += e.ProjectedSeconds
+	}
+	if summary.OriginalBytes > 0 {
+		summary.ProjectedRatio = float64(summary.ProjectedBytes) / float64(summary.OriginalBytes)
+	}
+	return summary, nil
+}
+
+// checkCompressible rejects compress options that do not apply to a.
+func checkCompressible(a *ent.Asset, opts CompressOptions) error {
 	ft := FileType(a.FileType)
-	if ft != FileTypeImage && ft != FileTypeVideo {
+	if !isCompressible(ft) {
 		return fmt.Errorf("asset type not supported for compression")
 	}
 
+	if opts.Format != "" && ft != FileTypeImage {
+		return fmt.Errorf("format conversion is only supported for images")
+	}
+	if (opts.AudioCodec != "" || opts.AudioBitrate != "" || opts.Normalize) && ft != FileTypeAudio {
+		return fmt.Errorf("audio options are only supported for audio assets")
+	}
+	if opts.TargetBytes < 0 {
+		return fmt.Errorf("target size must be positive")
+	}
+	if opts.TargetBytes > 0 && ft == FileTypeAudio {
+		return fmt.Errorf("target sizes are only supported for images and videos")
+	}
+
 	if a.IsCompressed {
 		return fmt.Errorf("asset is already compressed")
 	}
+	return nil
+}
+
+// RestoreOriginal reverts a compression or pipeline: the kept original
+// replaces the processed file, any fallback variants are removed and the
+// asset's size, format and compression metadata are reset.
+func (s *Service) RestoreOriginal(ctx context.Context, id string) (*Asset, error) {
+	a, err := s.client.Asset.Query().Where(asset.ID(id)).WithVariants().Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if a.OriginalPath == "" {
+		return nil, ErrNoOriginal
+	}
+
+	active, err := a.QueryCompressionJobs().
+		Where(compressionjob.StatusIn(string(preprocessing.StatusPending), string(preprocessing.StatusProcessing))).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if active {
+		return nil, ErrJobActive
+	}
+
+	info, err := os.Stat(a.OriginalPath)
+	if err != nil {
+		return nil, fmt.Errorf("original file is missing: %w", err)
+	}
+
+	ext := filepath.Ext(a.OriginalPath)
+	restoredPath := strings.TrimSuffix(a.OriginalPath, "_original"+ext) + ext
+
+	// The original is moved into place before anything is deleted, so a
+	// failed move leaves the asset as it was. A same-format compression
+	// is replaced by the move itself.
+	if err := s.storage.Move(a.OriginalPath, restoredPath); err != nil {
+		return nil, fmt.Errorf("failed to restore original: %w", err)
+	}
+
+	for _, v := range a.Edges.Variants {
+		if v.Kind != VariantFallback {
+			continue
+		}
+		s.storage.Delete(v.StoragePath)
+		if err := s.client.Variant.DeleteOneID(v.ID).Exec(ctx); err != nil {
+			log.Printf("failed to delete variant %s: %v", v.ID, err)
+		}
+	}
+
+	ext = strings.ToLower(ext)
+	filename := strings.TrimSuffix(a.OriginalFilename, filepath.Ext(a.OriginalFilename)) + ext
+	// A pipeline strips only the processed file, so the original may still
+	// carry a location.
+	hasLocation := s.preprocessor.HasLocation(ctx, restoredPath)
+
+	_, err = s.client.Asset.UpdateOneID(id).
+		SetOriginalFilename(filename).
+		SetExtension(ext).
+		SetMimeType(mimeType(ext)).
+		SetStoragePath(restoredPath).
+		SetFileSizeBytes(info.Size()).
+		SetIsCompressed(false).
+		ClearCompressedAt().
+		ClearOriginalPath().
+		ClearOriginalKeptAt().
+		ClearCompressionRatio().
+		SetHasLocation(hasLocation).
+		SetMetadataStripped(a.MetadataStripped && !hasLocation).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// A converted primary is only removed once the row no longer points
+	// at it.
+	if a.StoragePath != restoredPath {
+		if err := s.storage.Delete(a.StoragePath); err != nil {
+			log.Printf("failed to delete compressed file %s: %v", a.StoragePath, err)
+		}
+	}
+
+	return s.Get(ctx, id)
+}
+
+// PackageHLS queues a video for transcoding into an HLS bitrate ladder.
+func (s *Service) PackageHLS(ctx context.Context, id string) error {
+	a, err := s.client.Asset.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if FileType(a.FileType) != FileTypeVideo {
+		return fmt.Errorf("HLS packaging is only supported for videos")
+	}
+
+	return s.preprocessor.Enqueue(ctx, id, preprocessing.JobOptions{Kind: preprocessing.KindHLS})
+}
+
+// StripMetadata queues removal of EXIF, XMP and GPS metadata from an image
+// or video, including its kept original.
+func (s *Service) StripMetadata(ctx context.Context, id string) error {
+	a, err := s.client.Asset.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if !canStrip(FileType(a.FileType), a.Extension) {
+		return fmt.Errorf("metadata stripping is not supported for %s files", a.Extension)
+	}
 
-	return s.preprocessor.Enqueue(ctx, id)
+	return s.preprocessor.Enqueue(ctx, id, preprocessing.JobOptions{Kind: preprocessing.KindStrip})
 }
 
-func (s *Service) CompressMultiple(ctx context.Context, ids []string) error {
+// LocationReport lists the assets whose files carry GPS coordinates. With
+// rescan, every image and video is checked again first, which also covers
+// assets uploaded before locations were tracked.
+func (s *Service) LocationReport(ctx context.Context, rescan bool) ([]*Asset, error) {
+	if rescan {
+		all, err := s.client.Asset.Query().
+			Where(asset.FileTypeIn(string(FileTypeImage), string(FileTypeVideo))).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range all {
+			has := s.preprocessor.HasLocation(ctx, a.StoragePath)
+			if has == a.HasLocation {
+				continue
+			}
+			if err := a.Update().SetHasLocation(has).Exec(ctx); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	list, err := s.client.Asset.Query().
+		Where(asset.HasLocation(true)).
+		WithTags().
+		Order(ent.Desc(asset.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := make([]*Asset, len(list))
+	for i, a := range list {
+		report[i] = s.mapToDomain(a)
+	}
+	return report, nil
+}
+
+// RunPipeline queues an ordered list of processing steps for an asset.
+func (s *Service) RunPipeline(ctx context.Context, id string, opts PipelineOptions) error {
+	a, err := s.client.Asset.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	ft := FileType(a.FileType)
+	if !isCompressible(ft) {
+		return fmt.Errorf("%w: asset type not supported for processing", ErrInvalidPipeline)
+	}
+	if err := preprocessing.ValidateSteps(a.FileType, opts.Steps); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidPipeline, err)
+	}
+	for _, st := range opts.Steps {
+		if st.Op == preprocessing.OpWatermark {
+			if err := s.checkWatermark(ctx, st.Overlay); err != nil {
+				return err
+			}
+		}
+	}
+
+	return s.preprocessor.Enqueue(ctx, id, preprocessing.JobOptions{
+		Kind:    preprocessing.KindPipeline,
+		Profile: opts.Profile,
+		Steps:   opts.Steps,
+	})
+}
+
+// Watermark queues stamping an image or video with another image asset.
+func (s *Service) Watermark(ctx context.Context, id string, opts WatermarkOptions) error {
+	return s.RunPipeline(ctx, id, PipelineOptions{
+		Profile: opts.Profile,
+		Steps: []preprocessing.PipelineStep{{
+			Op:       preprocessing.OpWatermark,
+			Overlay:  opts.Overlay,
+			Position: opts.Position,
+			Opacity:  opts.Opacity,
+			Scale:    opts.Scale,
+			Output:   opts.Output,
+		}},
+	})
+}
+
+func (s *Service) checkWatermark(ctx context.Context, overlayID string) error {
+	overlay, err := s.client.Asset.Get(ctx, overlayID)
+	if ent.IsNotFound(err) {
+		return fmt.Errorf("%w: overlay asset not found: %s", ErrInvalidPipeline, overlayID)
+	}
+	if err != nil {
+		return err
+	}
+	if FileType(overlay.FileType) != FileTypeImage {
+		return fmt.Errorf("%w: overlay must be an image", ErrInvalidPipeline)
+	}
+	return nil
+}
+
+// GetVariantPath returns the file of an asset's variant of the given kind.
+func (s *Service) GetVariantPath(ctx context.Context, id, kind string) (string, error) {
+	v, err := s.client.Variant.Query().
+		Where(variant.Kind(kind), variant.HasAssetWith(asset.ID(id))).
+		First(ctx)
+	if err != nil {
+		return "", err
+	}
+	return v.StoragePath, nil
+}
+
+// GetHLSFilePath resolves a file inside an asset's HLS package, rejecting
+// paths that escape the package directory.
+func (s *Service) GetHLSFilePath(a *Asset, name string) (string, error) {
+	if a.HLSPath == "" {
+		return "", fmt.Errorf("asset has no HLS package")
+	}
+
+	dir := filepath.Dir(a.HLSPath)
+	path := filepath.Join(dir, filepath.FromSlash(name))
+	if !strings.HasPrefix(path, dir+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid HLS path")
+	}
+	if _, err := os.Stat(path); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+func (s *Service) CompressMultiple(ctx context.Context, ids []string, opts CompressOptions) error {
+	if opts.Format != "" && !preprocessing.IsImageFormat(opts.Format) {
+		return fmt.Errorf("unsupported image format: %s", opts.Format)
+	}
+	if opts.AudioCodec != "" && !preprocessing.IsAudioCodec(opts.AudioCodec) {
+		return fmt.Errorf("unsupported audio codec: %s", opts.AudioCodec)
+	}
+
+	if opts.TargetBytes < 0 {
+		return fmt.Errorf("target size must be positive")
+	}
+
 	assets, err := s.client.Asset.Query().Where(asset.IDIn(ids...)).All(ctx)
 	if err != nil {
 		return err
@@ -126,8 +500,17 @@ func (s *Service) CompressMultiple(ctx context.Context, ids []string) error {
 
 	for _, a := range assets {
 		ft := FileType(a.FileType)
-		if (ft == FileTypeImage || ft == FileTypeVideo) && !a.IsCompressed {
-			if err := s.preprocessor.Enqueue(ctx, a.ID); err != nil {
+		// Assets an earlier run found already optimal can still be
+		// compressed one at a time, e.g. with a different profile.
+		// A target size leaves audio out rather than compressing it
+		// without one.
+		if opts.TargetBytes > 0 && ft == FileTypeAudio {
+			continue
+		}
+		if isCompressible(ft) && !a.IsCompressed && !a.AlreadyOptimal {
+			jobOpts := opts.jobOptions(ft)
+			jobOpts.Priority = preprocessing.PriorityBulk
+			if err := s.preprocessor.Enqueue(ctx, a.ID, jobOpts); err != nil {
 				log.Printf("Failed to enqueue %s: %v", a.ID, err)
 			}
 		}
@@ -157,6 +540,7 @@ func (s *Service) List(ctx context.Context, page, limit int, tagNames []string)
 
 	list, err := query.
 		WithTags().
+		WithVariants().
 		Order(ent.Desc(asset.FieldCreatedAt)).
 		Limit(limit).
 		Offset(offset).
@@ -165,9 +549,19 @@ func (s *Service) List(ctx context.Context, page, limit int, tagNames []string)
 		return nil, err
 	}
 
+	ids := make([]string, len(list))
+	for i, item := range list {
+		ids[i] = item.ID
+	}
+	latest, err := s.latestJobs(ctx, ids...)
+	if err != nil {
+		return nil, err
+	}
+
 	result := make([]*Asset, len(list))
 	for i, item := range list {
 		result[i] = s.mapToDomain(item)
+		result[i].LatestJob = latest[item.ID]
 	}
 
 	return &ListResponse{
@@ -179,7 +573,7 @@ func (s *Service) List(ctx context.Context, page, limit int, tagNames []string)
 }
 
 func (s *Service) Delete(ctx context.Context, id string) error {
-	a, err := s.client.Asset.Get(ctx, id)
+	a, err := s.client.Asset.Query().Where(asset.ID(id)).WithVariants().Only(ctx)
 	if err != nil {
 		return err
 	}
@@ -192,15 +586,27 @@ func (s *Service) Delete(ctx context.Context, id string) error {
 		s.storage.Delete(a.OriginalPath)
 	}
 
+	for _, v := range a.Edges.Variants {
+		s.storage.Delete(v.StoragePath)
+	}
+
+	if a.HlsPath != "" {
+		s.storage.DeleteAll(filepath.Dir(a.HlsPath))
+	}
+
 	if _, err := s.client.CompressionJob.Delete().Where(compressionjob.HasAssetWith(asset.ID(id))).Exec(ctx); err != nil {
 		log.Printf("failed to delete compression jobs for asset %s: %v", id, err)
 	}
 
+	if _, err := s.client.Variant.Delete().Where(variant.HasAssetWith(asset.ID(id))).Exec(ctx); err != nil {
+		log.Printf("failed to delete variants for asset %s: %v", id, err)
+	}
+
 	return s.client.Asset.DeleteOneID(id).Exec(ctx)
 }
 
 func (s *Service) DeleteMultiple(ctx context.Context, ids []string) error {
-	assets, err := s.client.Asset.Query().Where(asset.IDIn(ids...)).All(ctx)
+	assets, err := s.client.Asset.Query().Where(asset.IDIn(ids...)).WithVariants().All(ctx)
 	if err != nil {
 		return err
 	}
@@ -212,22 +618,42 @@ func (s *Service) DeleteMultiple(ctx context.Context, ids []string) error {
 		if a.OriginalPath != "" {
 			s.storage.Delete(a.OriginalPath)
 		}
+		for _, v := range a.Edges.Variants {
+			s.storage.Delete(v.StoragePath)
+		}
+		if a.HlsPath != "" {
+			s.storage.DeleteAll(filepath.Dir(a.HlsPath))
+		}
 	}
 
 	if _, err := s.client.CompressionJob.Delete().Where(compressionjob.HasAssetWith(asset.IDIn(ids...))).Exec(ctx); err != nil {
 		return fmt.Errorf("failed to delete associated compression jobs: %w", err)
 	}
 
+	if _, err := s.client.Variant.Delete().Where(variant.HasAssetWith(asset.IDIn(ids...))).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete associated variants: %w", err)
+	}
+
 	_, err = s.client.Asset.Delete().Where(asset.IDIn(ids...)).Exec(ctx)
 	return err
 }
 
 func (s *Service) Get(ctx context.Context, id string) (*Asset, error) {
-	a, err := s.client.Asset.Query().Where(asset.ID(id)).WithTags().Only(ctx)
+	a, err := s.client.Asset.Query().
+		Where(asset.ID(id)).
+		WithTags().
+		WithVariants().
+		Only(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return s.mapToDomain(a), nil
+	latest, err := s.latestJobs(ctx, a.ID)
+	if err != nil {
+		return nil, err
+	}
+	result := s.mapToDomain(a)
+	result.LatestJob = latest[a.ID]
+	return result, nil
 }
 
 func (s *Service) GetFullStoragePath(a *Asset) string {
@@ -240,27 +666,95 @@ func (s *Service) mapToDomain(e *ent.Asset) *Asset {
 		tags[i] = Tag{ID: t.ID, Name: t.Name, Color: t.Color}
 	}
 
+	var variants []Variant
+	for _, v := range e.Edges.Variants {
+		variants = append(variants, Variant{
+			ID:            v.ID,
+			Kind:          v.Kind,
+			Extension:     v.Extension,
+			MimeType:      v.MimeType,
+			FileSizeBytes: v.FileSizeBytes,
+			StoragePath:   v.StoragePath,
+		})
+	}
+
 	return &Asset{
 		ID:               e.ID,
 		OriginalFilename: e.OriginalFilename,
 		FileType:         FileType(e.FileType),
 		Extension:        e.Extension,
+		MimeType:         e.MimeType,
 		FileSizeBytes:    e.FileSizeBytes,
 		StoragePath:      e.StoragePath,
 		CreatedAt:        e.CreatedAt,
 		IsCompressed:     e.IsCompressed,
+		AlreadyOptimal:   e.AlreadyOptimal,
+		MetadataStripped: e.MetadataStripped,
+		HasLocation:      e.HasLocation,
 		CompressionRatio: e.CompressionRatio,
+		OriginalPath:     e.OriginalPath,
+		HasOriginal:      e.OriginalPath != "",
+		HLSPath:          e.HlsPath,
+		HasHLS:           e.HlsPath != "",
 		Tags:             tags,
+		Variants:         variants,
+	}
+}
+
+// latestJobs returns the newest compression job of each of the given
+// assets, keyed by asset ID. Only that job is read, however long an asset's
+// history is.
+func (s *Service) latestJobs(ctx context.Context, ids ...string) (map[string]*preprocessing.Job, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	jobs, err := s.client.CompressionJob.Query().
+		Where(newestJobOf(ids)).
+		WithAsset(func(q *ent.AssetQuery) { q.Select(asset.FieldID) }).
+		Order(ent.Desc(compressionjob.FieldCreatedAt)).
+		Select(preprocessing.JobFields...).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	latest := make(map[string]*preprocessing.Job, len(jobs))
+	for _, j := range jobs {
+		// Jobs created in the same instant all match; the order keeps
+		// this deterministic enough to show one of them.
+		if a := j.Edges.Asset; a != nil && latest[a.ID] == nil {
+			latest[a.ID] = preprocessing.MapJob(j)
+		}
+	}
+	return latest, nil
+}
+
+// newestJobOf matches the jobs of the given assets that no later job of the
+// same asset follows.
+func newestJobOf(ids []string) predicate.CompressionJob {
+	return func(s *sql.Selector) {
+		t := sql.Table(compressionjob.Table).As("later")
+		newest := sql.Select(sql.Max(t.C(compressionjob.FieldCreatedAt))).
+			From(t).
+			Where(sql.ColumnsEQ(t.C(compressionjob.AssetColumn), s.C(compressionjob.AssetColumn)))
+		args := make([]any, len(ids))
+		for i, id := range ids {
+			args[i] = id
+		}
+		s.Where(sql.And(
+			sql.In(s.C(compressionjob.AssetColumn), args...),
+			sql.EQ(s.C(compressionjob.FieldCreatedAt), newest),
+		))
 	}
 }
 
 func (s *Service) determineFileType(ext string) FileType {
 	switch ext {
-	case ".jpg", ".jpeg", ".png", ".gif", ".webp", ".svg":
+	case ".jpg", ".jpeg", ".png", ".gif", ".webp", ".avif", ".svg":
 		return FileTypeImage
 	case ".mp4", ".mov", ".webm", ".avi", ".mkv":
 		return FileTypeVideo
-	case ".mp3", ".wav", ".ogg", ".flac":
+	case ".mp3", ".wav", ".ogg", ".flac", ".opus", ".m4a", ".aac":
 		return FileTypeAudio
 	case ".pdf", ".doc", ".docx", ".txt", ".xls", ".xlsx":
 		return FileTypeDocument
@@ -270,3 +764,21 @@ func (s *Service) determineFileType(ext string) FileType {
 		return FileTypeOther
 	}
 }
+
+// canStrip reports whether metadata can be stripped from files of type ft
+// with extension ext.
+func canStrip(ft FileType, ext string) bool {
+	return ft == FileTypeVideo || (ft == FileTypeImage && ext != ".svg")
+}
+
+func isCompressible(ft FileType) bool {
+	return ft == FileTypeImage || ft == FileTypeVideo || ft == FileTypeAudio
+}
+
+func mimeType(ext string) string {
+	t := mime.TypeByExtension(ext)
+	if i := strings.Index(t, ";"); i >= 0 {
+		t = t[:i]
+	}
+	return t
+}
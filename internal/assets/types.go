@@ -1,8 +1,12 @@
 package assets
 
 import (
+	"context"
+	"errors"
 	"io"
 	"time"
+
+	"github.com/adimail/asset-manager/internal/preprocessing"
 )
 
 type FileType string
@@ -16,6 +20,26 @@ const (
 	FileTypeOther    FileType = "other"
 )
 
+// VariantFallback is the kind of variant kept in an asset's source format
+// after conversion.
+const VariantFallback = preprocessing.VariantFallback
+
+// VariantThumbnail is the kind of variant holding a pipeline's thumbnail.
+const VariantThumbnail = preprocessing.VariantThumbnail
+
+// VariantWatermarked is the kind of variant holding a watermarked copy.
+const VariantWatermarked = preprocessing.VariantWatermarked
+
+var (
+	ErrNoOriginal = errors.New("asset has no original to restore")
+	ErrJobActive  = preprocessing.ErrJobActive
+	// ErrInvalidPipeline wraps the reason a pipeline cannot run on an asset.
+	ErrInvalidPipeline = errors.New("invalid pipeline")
+	// ErrTooManyAssets rejects an estimate of more assets than
+	// MaxEstimateAssets.
+	ErrTooManyAssets = errors.New("too many assets to estimate")
+)
+
 type Tag struct {
 	ID    string `json:"id"`
 	Name  string `json:"name"`
@@ -23,16 +47,37 @@ type Tag struct {
 }
 
 type Asset struct {
-	ID               string    `json:"id"`
-	OriginalFilename string    `json:"original_filename"`
-	FileType         FileType  `json:"file_type"`
-	Extension        string    `json:"extension"`
-	FileSizeBytes    int64     `json:"file_size_bytes"`
-	StoragePath      string    `json:"-"`
-	CreatedAt        time.Time `json:"created_at"`
-	IsCompressed     bool      `json:"is_compressed"`
-	CompressionRatio float64   `json:"compression_ratio,omitempty"`
-	Tags             []Tag     `json:"tags"`
+	ID               string             `json:"id"`
+	OriginalFilename string             `json:"original_filename"`
+	FileType         FileType           `json:"file_type"`
+	Extension        string             `json:"extension"`
+	MimeType         string             `json:"mime_type,omitempty"`
+	FileSizeBytes    int64              `json:"file_size_bytes"`
+	StoragePath      string             `json:"-"`
+	CreatedAt        time.Time          `json:"created_at"`
+	IsCompressed     bool               `json:"is_compressed"`
+	AlreadyOptimal   bool               `json:"already_optimal"`
+	MetadataStripped bool               `json:"metadata_stripped"`
+	HasLocation      bool               `json:"has_location"`
+	CompressionRatio float64            `json:"compression_ratio,omitempty"`
+	OriginalPath     string             `json:"-"`
+	HasOriginal      bool               `json:"has_original"`
+	HLSPath          string             `json:"-"`
+	HasHLS           bool               `json:"has_hls"`
+	Tags             []Tag              `json:"tags"`
+	Variants         []Variant          `json:"variants,omitempty"`
+	LatestJob        *preprocessing.Job `json:"latest_job,omitempty"`
+}
+
+// Variant is an alternative rendition of an asset, such as the source-format
+// fallback kept after converting an image to WebP or AVIF.
+type Variant struct {
+	ID            string `json:"id"`
+	Kind          string `json:"kind"`
+	Extension     string `json:"extension"`
+	MimeType      string `json:"mime_type,omitempty"`
+	FileSizeBytes int64  `json:"file_size_bytes"`
+	StoragePath   string `json:"-"`
 }
 
 type ListResponse struct {
@@ -46,4 +91,70 @@ type UploadRequest struct {
 	File     io.Reader
 	Filename string
 	Size     int64
+	TagIDs   []string
+	// StripMetadata removes EXIF, XMP and GPS metadata before the file is
+	// stored, so the location is never published.
+	StripMetadata bool
+}
+
+// UploadHook is notified after an asset has been stored.
+type UploadHook interface {
+	AssetUploaded(ctx context.Context, a *Asset)
+}
+
+type CompressOptions struct {
+	Profile      string `json:"profile"`
+	Format       string `json:"format"`
+	AudioCodec   string `json:"audio_codec"`
+	AudioBitrate string `json:"audio_bitrate"`
+	Normalize    bool   `json:"normalize"`
+	// TargetBytes caps the output size of images and videos; the worker
+	// lowers quality, then resolution, until the output fits.
+	TargetBytes int64 `json:"target_bytes"`
+}
+
+// MaxEstimateAssets bounds a bulk estimate. Every asset costs a sample
+// encode, run one after another while the request waits.
+const MaxEstimateAssets = 50
+
+// EstimateSummary totals the compression estimates of a bulk selection.
+// Assets that could not be estimated carry an error and are left out of the
+// totals.
+type EstimateSummary struct {
+	Assets           []*preprocessing.Estimate `json:"assets"`
+	OriginalBytes    int64                     `json:"original_bytes"`
+	ProjectedBytes   int64                     `json:"projected_bytes"`
+	ProjectedRatio   float64                   `json:"projected_ratio"`
+	ProjectedSeconds float64                   `json:"projected_seconds"`
+}
+
+// PipelineOptions is the request body for a processing pipeline. Steps
+// without their own quality use the profile's settings.
+type PipelineOptions struct {
+	Profile string                       `json:"profile"`
+	Steps   []preprocessing.PipelineStep `json:"steps"`
+}
+
+// WatermarkOptions is the request body for watermarking an asset. Overlay
+// is the ID of the image asset drawn on top; Output is "variant" (default)
+// to keep a stamped copy next to the asset or "replace" to stamp the asset
+// itself.
+type WatermarkOptions struct {
+	Profile  string  `json:"profile"`
+	Overlay  string  `json:"overlay"`
+	Position string  `json:"position"`
+	Opacity  float64 `json:"opacity"`
+	Scale    float64 `json:"scale"`
+	Output   string  `json:"output"`
+}
+
+// jobOptions narrows the request to the options that apply to assets of type ft.
+func (o CompressOptions) jobOptions(ft FileType) preprocessing.JobOptions {
+	switch ft {
+	case FileTypeImage:
+		return preprocessing.JobOptions{Profile: o.Profile, Format: o.Format, TargetBytes: o.TargetBytes}
+	case FileTypeAudio:
+		return preprocessing.JobOptions{Profile: o.Profile, Format: o.AudioCodec, AudioBitrate: o.AudioBitrate, Normalize: o.Normalize}
+	}
+	return preprocessing.JobOptions{Profile: o.Profile, TargetBytes: o.TargetBytes}
 }
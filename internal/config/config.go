@@ -1,12 +1,23 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
+// Run modes. ModeAll serves the API and processes jobs in one process; the
+// others split the two, sharing the database and storage.
+const (
+	ModeAll    = "all"
+	ModeAPI    = "api"
+	ModeWorker = "worker"
+)
+
 type Config struct {
+	Mode        string
 	Server      ServerConfig
 	Database    DatabaseConfig
 	Storage     StorageConfig
@@ -30,15 +41,44 @@ type StorageConfig struct {
 
 type CompressionConfig struct {
 	Enabled            bool
-	WorkerCount        int
+	RunWorkers         bool // false queues jobs for workers in other processes
+	WorkerCount        int  // image and audio workers
+	VideoWorkerCount   int
+	MaxWorkers         int // largest size a pool may be given, at startup or at runtime
 	ImageQuality       int
+	ImageFormat        string
 	VideoMaxHeight     int
-	RetainOriginalDays int
+	AudioCodec         string
+	AudioBitrate       string
+	AudioNormalize     bool
+	MinSavings         float64  // fraction of the original size an output must save to be kept
+	QualityMetrics     []string // ssim, psnr, vmaf: measured on every compress job
+	RetainOriginalDays int      // 0 keeps originals forever
+	JanitorInterval    time.Duration
+	JanitorDryRun      bool
 	FFmpegPath         string
+	FFprobePath        string
+	Processors         []string // encoding backends in preference order
+	PollInterval       time.Duration
+	LeaseDuration      time.Duration
+	ProgressInterval   time.Duration
+	MaxAttempts        int
+	RetryBaseDelay     time.Duration
+	RetryMaxDelay      time.Duration
+	ImageTimeout       time.Duration // base time allowed per job, by file type
+	AudioTimeout       time.Duration
+	VideoTimeout       time.Duration
+	TimeoutFactor      float64       // extra seconds allowed per second of media, per encoding pass
+	ShutdownTimeout    time.Duration // how long running jobs may finish on shutdown before being requeued
+	Profiles           map[string]Profile
+	DefaultProfile     string
+
+	profilesErr error // from reading COMPRESSION_PROFILES_FILE
 }
 
 func Load() *Config {
-	return &Config{
+	cfg := &Config{
+		Mode: getEnv("APP_MODE", ModeAll),
 		Server: ServerConfig{
 			Port:          getEnv("SERVER_PORT", "8080"),
 			ReadTimeout:   time.Second * 15,
@@ -54,12 +94,62 @@ func Load() *Config {
 		Compression: CompressionConfig{
 			Enabled:            getEnv("COMPRESSION_ENABLED", "true") == "true",
 			WorkerCount:        getEnvInt("COMPRESSION_WORKERS", 2),
+			VideoWorkerCount:   getEnvInt("COMPRESSION_VIDEO_WORKERS", 1),
+			MaxWorkers:         getEnvInt("COMPRESSION_MAX_WORKERS", 16),
 			ImageQuality:       getEnvInt("COMPRESSION_IMAGE_QUALITY", 85),
+			ImageFormat:        getEnv("COMPRESSION_IMAGE_FORMAT", ""),
 			VideoMaxHeight:     getEnvInt("COMPRESSION_VIDEO_MAX_HEIGHT", 1080),
+			AudioCodec:         getEnv("COMPRESSION_AUDIO_CODEC", "opus"),
+			AudioBitrate:       getEnv("COMPRESSION_AUDIO_BITRATE", "128k"),
+			AudioNormalize:     getEnv("COMPRESSION_AUDIO_NORMALIZE", "false") == "true",
+			MinSavings:         getEnvFloat("COMPRESSION_MIN_SAVINGS", 0.05),
+			QualityMetrics:     getEnvList("COMPRESSION_QUALITY_METRICS", "ssim,psnr"),
 			RetainOriginalDays: getEnvInt("COMPRESSION_RETAIN_DAYS", 7),
+			JanitorInterval:    getEnvDuration("COMPRESSION_JANITOR_INTERVAL", time.Hour),
+			JanitorDryRun:      getEnv("COMPRESSION_JANITOR_DRY_RUN", "false") == "true",
 			FFmpegPath:         getEnv("FFMPEG_PATH", "ffmpeg"),
+			FFprobePath:        getEnv("FFPROBE_PATH", "ffprobe"),
+			Processors:         getEnvList("COMPRESSION_PROCESSORS", "ffmpeg,go"),
+			PollInterval:       getEnvDuration("COMPRESSION_POLL_INTERVAL", 2*time.Second),
+			LeaseDuration:      getEnvDuration("COMPRESSION_LEASE_DURATION", 2*time.Minute),
+			ProgressInterval:   getEnvDuration("COMPRESSION_PROGRESS_INTERVAL", 2*time.Second),
+			MaxAttempts:        getEnvInt("COMPRESSION_MAX_ATTEMPTS", 3),
+			RetryBaseDelay:     getEnvDuration("COMPRESSION_RETRY_BASE_DELAY", 30*time.Second),
+			RetryMaxDelay:      getEnvDuration("COMPRESSION_RETRY_MAX_DELAY", 10*time.Minute),
+			ImageTimeout:       getEnvDuration("COMPRESSION_IMAGE_TIMEOUT", 2*time.Minute),
+			AudioTimeout:       getEnvDuration("COMPRESSION_AUDIO_TIMEOUT", 5*time.Minute),
+			VideoTimeout:       getEnvDuration("COMPRESSION_VIDEO_TIMEOUT", 10*time.Minute),
+			TimeoutFactor:      getEnvFloat("COMPRESSION_TIMEOUT_FACTOR", 4),
+			ShutdownTimeout:    getEnvDuration("COMPRESSION_SHUTDOWN_TIMEOUT", 20*time.Second),
+			DefaultProfile:     getEnv("COMPRESSION_DEFAULT_PROFILE", DefaultProfileName),
 		},
 	}
+	cfg.Compression.RunWorkers = cfg.Mode != ModeAPI
+	cfg.Compression.Profiles, cfg.Compression.profilesErr = loadProfiles(cfg.Compression, getEnv("COMPRESSION_PROFILES_FILE", ""))
+	return cfg
+}
+
+// Validate reports compression settings that cannot work together, and a
+// profiles file that could not be read.
+func (c CompressionConfig) Validate() error {
+	if c.profilesErr != nil {
+		return fmt.Errorf("COMPRESSION_PROFILES_FILE: %w", c.profilesErr)
+	}
+	if _, ok := c.Profiles[c.DefaultProfile]; !ok {
+		return fmt.Errorf("COMPRESSION_DEFAULT_PROFILE: unknown profile %q", c.DefaultProfile)
+	}
+	// A worker checks its lease every poll interval and renews it once a
+	// third of it has passed; polling any slower lets leases expire under
+	// healthy workers.
+	if c.PollInterval <= 0 || c.PollInterval >= c.LeaseDuration/3 {
+		return fmt.Errorf("COMPRESSION_POLL_INTERVAL (%v) must be positive and under a third of COMPRESSION_LEASE_DURATION (%v)",
+			c.PollInterval, c.LeaseDuration)
+	}
+	if c.WorkerCount > c.MaxWorkers || c.VideoWorkerCount > c.MaxWorkers {
+		return fmt.Errorf("COMPRESSION_WORKERS (%d) and COMPRESSION_VIDEO_WORKERS (%d) must not exceed COMPRESSION_MAX_WORKERS (%d)",
+			c.WorkerCount, c.VideoWorkerCount, c.MaxWorkers)
+	}
+	return nil
 }
 
 func getEnv(key, fallback string) string {
@@ -77,3 +167,32 @@ func getEnvInt(key string, fallback int) int {
 	}
 	return fallback
 }
+
+func getEnvFloat(key string, fallback float64) float64 {
+	if value, ok := os.LookupEnv(key); ok {
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return f
+		}
+	}
+	return fallback
+}
+
+// getEnvList splits a comma-separated variable, dropping empty entries.
+func getEnvList(key, fallback string) []string {
+	var list []string
+	for _, item := range strings.Split(getEnv(key, fallback), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	if value, ok := os.LookupEnv(key); ok {
+		if d, err := time.ParseDuration(value); err == nil {
+			return d
+		}
+	}
+	return fallback
+}
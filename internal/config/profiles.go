@@ -0,0 +1,151 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// DefaultProfileName is the profile built from the flat COMPRESSION_* settings.
+const DefaultProfileName = "default"
+
+// Profile is a named set of encoder settings a compression job can select.
+// Zero dimensions mean no limit; an empty ImageFormat keeps the source format.
+type Profile struct {
+	Name         string `json:"name"`
+	VideoCodec   string `json:"video_codec"` // libx264, libx265 or libvpx-vp9
+	CRF          int    `json:"crf"`
+	Preset       string `json:"preset"`
+	MaxWidth     int    `json:"max_width"`  // video
+	MaxHeight    int    `json:"max_height"` // video
+	ImageQuality int    `json:"image_quality"`
+	ImageFormat  string `json:"image_format"`
+	ImageMaxSize int    `json:"image_max_size"` // longest edge
+	AudioCodec   string `json:"audio_codec"`
+	AudioBitrate string `json:"audio_bitrate"`
+	Normalize    bool   `json:"normalize"`
+
+	// Quality floors. A compression whose output scores below any of them
+	// is discarded and the original kept. Zero disables a floor.
+	MinSSIM float64 `json:"min_ssim,omitempty"`
+	MinPSNR float64 `json:"min_psnr,omitempty"` // dB
+	MinVMAF float64 `json:"min_vmaf,omitempty"`
+}
+
+// defaultProfile mirrors the encoder settings used before profiles existed.
+func defaultProfile(c CompressionConfig) Profile {
+	return Profile{
+		Name:         DefaultProfileName,
+		VideoCodec:   "libx264",
+		CRF:          23,
+		Preset:       "medium",
+		MaxHeight:    c.VideoMaxHeight,
+		ImageQuality: c.ImageQuality,
+		ImageFormat:  c.ImageFormat,
+		ImageMaxSize: 4096,
+		AudioCodec:   c.AudioCodec,
+		AudioBitrate: c.AudioBitrate,
+		Normalize:    c.AudioNormalize,
+	}
+}
+
+var builtinProfiles = []Profile{
+	{
+		Name:         "web-small",
+		VideoCodec:   "libx264",
+		CRF:          28,
+		Preset:       "medium",
+		MaxHeight:    720,
+		ImageQuality: 75,
+		ImageFormat:  "webp",
+		ImageMaxSize: 1920,
+		AudioCodec:   "opus",
+		AudioBitrate: "96k",
+	},
+	{
+		Name:         "archive-hq",
+		VideoCodec:   "libx264",
+		CRF:          18,
+		Preset:       "slow",
+		ImageQuality: 95,
+		AudioCodec:   "aac",
+		AudioBitrate: "256k",
+	},
+	{
+		Name:         "social-720p",
+		VideoCodec:   "libx264",
+		CRF:          23,
+		Preset:       "fast",
+		MaxWidth:     1280,
+		MaxHeight:    720,
+		ImageQuality: 85,
+		ImageMaxSize: 1080,
+		AudioCodec:   "aac",
+		AudioBitrate: "128k",
+	},
+}
+
+// loadProfiles returns the default and built-in profiles, overlaid with any
+// profiles defined in the JSON array at path. A profile from the file
+// replaces a built-in one with the same name. A file that cannot be read is
+// an error; the profiles without it are still returned.
+func loadProfiles(c CompressionConfig, path string) (map[string]Profile, error) {
+	profiles := map[string]Profile{DefaultProfileName: defaultProfile(c)}
+	for _, p := range builtinProfiles {
+		profiles[p.Name] = p
+	}
+	if path == "" {
+		return profiles, nil
+	}
+
+	custom, err := readProfiles(path)
+	if err != nil {
+		return profiles, err
+	}
+	for _, p := range custom {
+		profiles[p.Name] = p.withDefaults(profiles[DefaultProfileName])
+	}
+	return profiles, nil
+}
+
+// withDefaults fills the encoder settings a profile left unset from base.
+// Dimensions and the image format are left alone, as zero values are
+// meaningful there.
+func (p Profile) withDefaults(base Profile) Profile {
+	if p.VideoCodec == "" {
+		p.VideoCodec = base.VideoCodec
+	}
+	if p.CRF <= 0 {
+		p.CRF = base.CRF
+	}
+	if p.Preset == "" {
+		p.Preset = base.Preset
+	}
+	if p.ImageQuality <= 0 {
+		p.ImageQuality = base.ImageQuality
+	}
+	if p.AudioCodec == "" {
+		p.AudioCodec = base.AudioCodec
+	}
+	if p.AudioBitrate == "" {
+		p.AudioBitrate = base.AudioBitrate
+	}
+	return p
+}
+
+func readProfiles(path string) ([]Profile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var profiles []Profile
+	if err := json.Unmarshal(data, &profiles); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	for i, p := range profiles {
+		if p.Name == "" {
+			return nil, fmt.Errorf("%s: profile %d has no name", path, i)
+		}
+	}
+	return profiles, nil
+}
@@ -28,3 +28,14 @@ func (s *Storage) Write(path string, data io.Reader) error {
 func (s *Storage) Delete(path string) error {
 	return os.Remove(path)
 }
+
+func (s *Storage) DeleteAll(path string) error {
+	return os.RemoveAll(path)
+}
+
+func (s *Storage) Move(src, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+	return os.Rename(src, dst)
+}
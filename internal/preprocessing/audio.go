@@ -0,0 +1,130 @@
+package preprocessing
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/adimail/asset-manager/ent"
+	"github.com/adimail/asset-manager/internal/config"
+)
+
+type audioCodec struct {
+	Encoder   string
+	Extension string
+	MimeType  string
+}
+
+// audioCodecs maps the supported audio targets to their ffmpeg encoder and
+// container.
+var audioCodecs = map[string]audioCodec{
+	"opus": {Encoder: "libopus", Extension: ".opus", MimeType: "audio/ogg"},
+	"aac":  {Encoder: "aac", Extension: ".m4a", MimeType: "audio/mp4"},
+	"mp3":  {Encoder: "libmp3lame", Extension: ".mp3", MimeType: "audio/mpeg"},
+}
+
+var bitratePattern = regexp.MustCompile(`^[1-9][0-9]*k$`)
+
+// IsAudioCodec reports whether codec is a supported audio compression target.
+func IsAudioCodec(codec string) bool {
+	_, ok := audioCodecs[codec]
+	return ok
+}
+
+// IsAudioBitrate reports whether bitrate is in ffmpeg's "<n>k" form.
+func IsAudioBitrate(bitrate string) bool {
+	return bitratePattern.MatchString(bitrate)
+}
+
+// compressAudio transcodes an audio asset to the job's codec. The container
+// usually changes (WAV to Opus, for example), so the asset's file name,
+// extension and MIME type follow the output.
+func (s *Service) compressAudio(ctx context.Context, job *ent.CompressionJob, a *ent.Asset, profile config.Profile, progress *progressTracker) error {
+	codecName := job.TargetFormat
+	if codecName == "" {
+		codecName = profile.AudioCodec
+	}
+	codec, ok := audioCodecs[codecName]
+	if !ok {
+		return permanent(fmt.Errorf("unsupported audio codec: %s", codecName))
+	}
+	if job.AudioBitrate != "" {
+		profile.AudioBitrate = job.AudioBitrate
+	}
+	profile.Normalize = profile.Normalize || job.Normalize
+	jobLogFrom(ctx).setParams(profile)
+
+	originalPath := a.StoragePath
+	ext := filepath.Ext(originalPath)
+	base := strings.TrimSuffix(originalPath, ext)
+	tempOutput := base + "_compressed" + codec.Extension
+
+	progress.start(s.probeDuration(ctx, originalPath), 1)
+	err := s.encode(ctx, originalPath, tempOutput, encodeOptions{
+		FileType: "audio",
+		Format:   codecName,
+		Profile:  profile,
+	}, progress)
+	if err == nil {
+		ctx, err = commit(ctx)
+	}
+	if err != nil {
+		os.Remove(tempOutput)
+		return err
+	}
+
+	// Calculate stats
+	infoOrig, _ := os.Stat(originalPath)
+	infoComp, _ := os.Stat(tempOutput)
+	ratio := float64(infoComp.Size()) / float64(infoOrig.Size())
+	if !s.saves(infoOrig.Size(), infoComp.Size()) {
+		return s.keepOriginal(ctx, job, a, ratio, tempOutput)
+	}
+
+	updateJob(job).
+		SetTargetFormat(codecName).
+		SetAudioBitrate(profile.AudioBitrate).
+		SetNormalize(profile.Normalize).
+		SetOutcome(OutcomeCompressed).
+		Save(ctx)
+
+	// Move files, then update the row. A failed update puts the files
+	// back, so a retry starts again from the original.
+	primaryPath := base + codec.Extension
+	filename := strings.TrimSuffix(a.OriginalFilename, filepath.Ext(a.OriginalFilename)) + codec.Extension
+
+	var moves fileMoves
+	backupPath, replaced, err := backupOriginal(a, &moves)
+	if err == nil {
+		err = moves.move(tempOutput, primaryPath)
+	}
+	if err == nil {
+		err = s.client.Asset.UpdateOneID(a.ID).
+			SetOriginalFilename(filename).
+			SetExtension(codec.Extension).
+			SetMimeType(codec.MimeType).
+			SetStoragePath(primaryPath).
+			SetIsCompressed(true).
+			SetAlreadyOptimal(false).
+			SetCompressedAt(time.Now()).
+			SetOriginalPath(backupPath).
+			SetOriginalKeptAt(time.Now()).
+			SetCompressionRatio(ratio).
+			SetFileSizeBytes(infoComp.Size()).
+			Exec(ctx)
+	}
+	if err != nil {
+		moves.rollback()
+		os.Remove(tempOutput)
+		return err
+	}
+	os.Remove(replaced)
+
+	log.Printf("[Asset %s] Transcoded to %s at %s, ratio: %.2f", a.ID, codecName, profile.AudioBitrate, ratio)
+	return nil
+}
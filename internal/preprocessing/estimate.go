@@ -0,0 +1,171 @@
+package preprocessing
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Sample sizes for estimates. Media longer than twice the sample and images
+// larger than the sample edge are estimated from a partial encode; anything
+// smaller is encoded in full.
+const (
+	sampleSeconds = 5
+	sampleEdge    = 2048
+)
+
+// Estimate is the projected result of compressing an asset with given
+// settings.
+type Estimate struct {
+	AssetID          string  `json:"asset_id"`
+	OriginalBytes    int64   `json:"original_bytes"`
+	ProjectedBytes   int64   `json:"projected_bytes"`
+	ProjectedRatio   float64 `json:"projected_ratio"`
+	ProjectedSeconds float64 `json:"projected_seconds"` // encoding time
+	Sampled          bool    `json:"sampled"`           // projected from a partial encode
+	WouldKeep        bool    `json:"would_keep"`        // saves enough for the output to be kept
+	Error            string  `json:"error,omitempty"`
+}
+
+// Estimate encodes a sample of an asset with the settings a compress job
+// with opts would use and projects the full result from it. The asset and
+// its files are left untouched.
+func (s *Service) Estimate(ctx context.Context, assetID string, opts JobOptions) (*Estimate, error) {
+	if opts.Format != "" && !IsImageFormat(opts.Format) && !IsAudioCodec(opts.Format) {
+		return nil, fmt.Errorf("unsupported format: %s", opts.Format)
+	}
+	if opts.AudioBitrate != "" && !IsAudioBitrate(opts.AudioBitrate) {
+		return nil, fmt.Errorf("invalid audio bitrate: %s", opts.AudioBitrate)
+	}
+	profile, err := s.profile(opts.Profile)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := s.client.Asset.Get(ctx, assetID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.baseTimeout(a.FileType))
+	defer cancel()
+
+	// Mirror the settings compress and compressAudio pick for the job.
+	ext := strings.ToLower(filepath.Ext(a.StoragePath))
+	enc := encodeOptions{FileType: a.FileType, Profile: profile}
+	passes := 1
+	switch a.FileType {
+	case "image":
+		enc.Format = opts.Format
+		if enc.Format == "" {
+			enc.Format = profile.ImageFormat
+		}
+		if "."+enc.Format == ext {
+			enc.Format = ""
+		}
+		if enc.Format != "" {
+			// The source-format fallback is encoded as well.
+			passes = 2
+			ext = "." + enc.Format
+		}
+	case "audio":
+		enc.Format = opts.Format
+		if enc.Format == "" {
+			enc.Format = profile.AudioCodec
+		}
+		codec, ok := audioCodecs[enc.Format]
+		if !ok {
+			return nil, fmt.Errorf("unsupported audio codec: %s", enc.Format)
+		}
+		ext = codec.Extension
+		if opts.AudioBitrate != "" {
+			enc.Profile.AudioBitrate = opts.AudioBitrate
+		}
+		enc.Profile.Normalize = profile.Normalize || opts.Normalize
+	case "video":
+	default:
+		return nil, fmt.Errorf("asset type not supported for compression")
+	}
+
+	scale := 1.0
+	switch a.FileType {
+	case "image":
+		// Encode a crop of the output at full resolution and scale by the
+		// pixel count. A downscaled copy would be a poor predictor: it
+		// packs more detail into each pixel than the real output.
+		w, h, err := s.imageSize(ctx, a.StoragePath)
+		if size := float64(profile.ImageMaxSize); size > 0 && err == nil && max(w, h) > profile.ImageMaxSize {
+			f := size / float64(max(w, h))
+			w, h = int(float64(w)*f), int(float64(h)*f)
+		}
+		if err == nil && (w > sampleEdge || h > sampleEdge) {
+			enc.Crop = sampleEdge
+			scale = float64(w*h) / float64(min(w, sampleEdge)*min(h, sampleEdge))
+		}
+	default:
+		// Encode a few seconds from the middle, where the content is more
+		// typical than the opening titles.
+		duration := s.probeDuration(ctx, a.StoragePath)
+		if duration > 2*sampleSeconds {
+			enc.Start = duration/2 - sampleSeconds/2
+			enc.Length = sampleSeconds
+			scale = duration / sampleSeconds
+		}
+	}
+
+	work, err := os.MkdirTemp("", "estimate-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(work)
+	output := filepath.Join(work, "sample"+ext)
+
+	start := time.Now()
+	if err := s.encode(ctx, a.StoragePath, output, enc, nil); err != nil {
+		return nil, err
+	}
+	elapsed := time.Since(start)
+
+	info, err := os.Stat(output)
+	if err != nil {
+		return nil, err
+	}
+	original := a.FileSizeBytes
+	if orig, err := os.Stat(a.StoragePath); err == nil {
+		original = orig.Size()
+	}
+
+	e := &Estimate{
+		AssetID:          a.ID,
+		OriginalBytes:    original,
+		ProjectedBytes:   int64(float64(info.Size()) * scale),
+		ProjectedSeconds: elapsed.Seconds() * scale * float64(passes),
+		Sampled:          scale != 1,
+	}
+	if original > 0 {
+		e.ProjectedRatio = float64(e.ProjectedBytes) / float64(original)
+	}
+	e.WouldKeep = s.saves(original, e.ProjectedBytes)
+	return e, nil
+}
+
+// imageSize returns the pixel dimensions of an image, reading only its
+// header where Go can decode the format.
+func (s *Service) imageSize(ctx context.Context, path string) (int, int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer f.Close()
+	if cfg, _, err := image.DecodeConfig(f); err == nil {
+		return cfg.Width, cfg.Height, nil
+	}
+	if !s.hasProcessor(ProcessorFFmpeg) {
+		return 0, 0, fmt.Errorf("unknown image format")
+	}
+	return s.probeDimensions(ctx, path)
+}
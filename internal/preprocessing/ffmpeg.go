@@ -0,0 +1,287 @@
+package preprocessing
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/adimail/asset-manager/internal/config"
+)
+
+// imageFormats maps the supported image conversion targets to their MIME types.
+var imageFormats = map[string]string{
+	"webp": "image/webp",
+	"avif": "image/avif",
+}
+
+// videoCodecs maps the supported video encoders to their highest CRF.
+var videoCodecs = map[string]int{
+	"libx264":    51,
+	"libx265":    51,
+	"libvpx-vp9": 63,
+}
+
+// IsVideoCodec reports whether codec is a supported video encoder.
+func IsVideoCodec(codec string) bool {
+	_, ok := videoCodecs[codec]
+	return ok
+}
+
+// IsImageFormat reports whether format is a supported image conversion target.
+func IsImageFormat(format string) bool {
+	_, ok := imageFormats[format]
+	return ok
+}
+
+// encodeOptions describes a single ffmpeg encode. Profile holds the job's
+// encoder settings with any per-request overrides already applied.
+type encodeOptions struct {
+	FileType string
+	Format   string
+	Profile  config.Profile
+	// Start and Length, when Length is set, limit a video or audio encode
+	// to that section of the input, in seconds.
+	Start, Length float64
+	// Crop, when set, keeps only a centred square of at most Crop pixels a
+	// side of the scaled image.
+	Crop int
+}
+
+func (s *Service) runFFmpeg(ctx context.Context, input, output string, opts encodeOptions, progress *progressTracker) error {
+	var args []string
+	p := opts.Profile
+
+	if opts.FileType == "video" {
+		args = inputArgs(input, opts)
+		if vf := videoScale(p.MaxWidth, p.MaxHeight); vf != "" {
+			args = append(args, "-vf", vf)
+		}
+		args = append(args, videoCodecArgs(p)...)
+		args = append(args,
+			"-c:a", "aac",
+			"-b:a", p.AudioBitrate,
+			"-movflags", "+faststart",
+			"-y", output,
+		)
+	} else if opts.FileType == "image" {
+		// Scale down if too large, optimize
+		args = inputArgs(input, opts)
+		var filters []string
+		if p.ImageMaxSize > 0 {
+			filters = append(filters, fmt.Sprintf("scale='min(iw,%[1]d)':'min(ih,%[1]d)':force_original_aspect_ratio=decrease", p.ImageMaxSize))
+		}
+		if opts.Crop > 0 {
+			filters = append(filters, fmt.Sprintf("crop='min(iw,%[1]d)':'min(ih,%[1]d)'", opts.Crop))
+		}
+		if len(filters) > 0 {
+			args = append(args, "-vf", strings.Join(filters, ","))
+		}
+		args = append(args, imageCodecArgs(opts.Format, p.ImageQuality)...)
+		args = append(args, "-y", output)
+	} else if opts.FileType == "audio" {
+		codec, ok := audioCodecs[opts.Format]
+		if !ok {
+			return permanent(fmt.Errorf("unsupported audio codec: %s", opts.Format))
+		}
+		args = append(inputArgs(input, opts),
+			"-vn",
+			"-map_metadata", "0",
+			"-c:a", codec.Encoder,
+			"-b:a", p.AudioBitrate,
+		)
+		if p.Normalize {
+			args = append(args, "-af", "loudnorm=I=-16:TP=-1.5:LRA=11")
+		}
+		if codec.Extension == ".m4a" {
+			args = append(args, "-movflags", "+faststart")
+		}
+		args = append(args, "-y", output)
+	} else {
+		return permanent(fmt.Errorf("unsupported file type for compression"))
+	}
+
+	return s.execFFmpeg(ctx, args, progress)
+}
+
+// videoCodecArgs returns the encoder arguments for a profile's video codec.
+func videoCodecArgs(p config.Profile) []string {
+	args := []string{"-c:v", p.VideoCodec, "-crf", strconv.Itoa(p.CRF)}
+	switch p.VideoCodec {
+	case "libvpx-vp9":
+		// VP9 only runs in constant-quality mode with a zero bitrate.
+		return append(args, "-b:v", "0")
+	case "libx265":
+		return append(args, "-preset", p.Preset, "-tag:v", "hvc1")
+	}
+	return append(args, "-preset", p.Preset)
+}
+
+// imageCodecArgs returns the encoder arguments for an image in format, or
+// in the source format when format is empty.
+func imageCodecArgs(format string, quality int) []string {
+	switch format {
+	case "webp":
+		return []string{"-c:v", "libwebp", "-quality", strconv.Itoa(quality)}
+	case "avif":
+		return []string{"-c:v", "libaom-av1", "-still-picture", "1", "-crf", strconv.Itoa(avifCRF(quality)), "-b:v", "0"}
+	}
+	return []string{"-q:v", strconv.Itoa(jpegQScale(quality))}
+}
+
+// inputArgs returns the arguments that read input, seeking to the section
+// opts selects, if any.
+func inputArgs(input string, opts encodeOptions) []string {
+	if opts.Length <= 0 {
+		return []string{"-i", input}
+	}
+	return []string{
+		"-ss", strconv.FormatFloat(opts.Start, 'f', 3, 64),
+		"-t", strconv.FormatFloat(opts.Length, 'f', 3, 64),
+		"-i", input,
+	}
+}
+
+// execFFmpeg runs ffmpeg with args, killing its process tree if ctx ends.
+// When progress is set, ffmpeg's machine-readable progress stream is parsed
+// and forwarded to it. Failures carry the tail of ffmpeg's stderr, which is
+// where it explains what went wrong.
+func (s *Service) execFFmpeg(ctx context.Context, args []string, progress *progressTracker) error {
+	args = append([]string{"-hide_banner", "-nostdin"}, args...)
+	if progress != nil {
+		args = append([]string{"-progress", "pipe:1", "-nostats"}, args...)
+	}
+
+	var stderr tailBuffer
+	cmd := command(ctx, s.config.FFmpegPath, args...)
+	cmd.Stderr = logOutput(ctx, &stderr)
+
+	var err error
+	if progress == nil {
+		cmd.Stdout = logOutput(ctx, nil)
+		err = cmd.Run()
+	} else {
+		err = runWithProgress(cmd, progress)
+	}
+	if err != nil {
+		if tail := stderr.String(); tail != "" {
+			return fmt.Errorf("%w: %s", err, tail)
+		}
+		return err
+	}
+	return nil
+}
+
+func runWithProgress(cmd *exec.Cmd, progress *progressTracker) error {
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	scanner := bufio.NewScanner(stdout)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), "=")
+		if !ok || key != "out_time_us" {
+			continue
+		}
+		if us, err := strconv.ParseInt(value, 10, 64); err == nil {
+			progress.update(float64(us) / 1e6)
+		}
+	}
+
+	return cmd.Wait()
+}
+
+// command prepares an external tool run bound to ctx. Ending ctx kills the
+// tool's whole process tree, and Wait gives up on its output pipes shortly
+// after, even if an orphaned child still holds them open. Within a job the
+// command line is recorded in the job log and stderr goes to it; callers
+// that read stderr themselves tee it with logOutput.
+func command(ctx context.Context, name string, args ...string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, name, args...)
+	killProcessTree(cmd)
+	cmd.WaitDelay = 5 * time.Second
+	if l := jobLogFrom(ctx); l != nil {
+		l.command(name, args)
+		cmd.Stderr = l
+	}
+	return cmd
+}
+
+// tailBuffer is an io.Writer that keeps the last few lines written to it.
+type tailBuffer struct {
+	buf []byte
+}
+
+const (
+	tailBytes = 4096
+	tailLines = 5
+)
+
+func (t *tailBuffer) Write(p []byte) (int, error) {
+	t.buf = append(t.buf, p...)
+	if len(t.buf) > tailBytes {
+		t.buf = t.buf[len(t.buf)-tailBytes:]
+	}
+	return len(p), nil
+}
+
+// String returns the last non-empty lines, joined with " | " so they fit in
+// a single-line error message.
+func (t *tailBuffer) String() string {
+	var lines []string
+	for _, line := range strings.Split(string(t.buf), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if len(lines) > tailLines {
+		lines = lines[len(lines)-tailLines:]
+	}
+	return strings.Join(lines, " | ")
+}
+
+// videoScale returns a scale filter that fits the video within the given
+// bounds without upscaling, keeping dimensions even for the encoder. A zero
+// bound is unlimited; with no bounds no filter is needed.
+func videoScale(maxWidth, maxHeight int) string {
+	switch {
+	case maxWidth > 0 && maxHeight > 0:
+		return fmt.Sprintf("scale='min(iw,%d)':'min(ih,%d)':force_original_aspect_ratio=decrease:force_divisible_by=2", maxWidth, maxHeight)
+	case maxHeight > 0:
+		return fmt.Sprintf("scale=-2:'min(ih,%d)'", maxHeight)
+	case maxWidth > 0:
+		return fmt.Sprintf("scale='min(iw,%d)':-2", maxWidth)
+	}
+	return ""
+}
+
+// jpegQScale maps a 0-100 quality setting onto ffmpeg's qscale, where 2 is
+// the best quality and 31 the worst.
+func jpegQScale(quality int) int {
+	if quality > 100 {
+		quality = 100
+	}
+	if quality < 0 {
+		quality = 0
+	}
+	return 2 + (100-quality)*29/100
+}
+
+// avifCRF maps a 0-100 quality setting onto the libaom CRF scale, where 0 is
+// lossless and 63 the worst quality.
+func avifCRF(quality int) int {
+	if quality > 100 {
+		quality = 100
+	}
+	if quality < 0 {
+		quality = 0
+	}
+	return 18 + (100-quality)*45/100
+}
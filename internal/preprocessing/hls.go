@@ -0,0 +1,154 @@
+package preprocessing
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/adimail/asset-manager/ent"
+)
+
+// MasterPlaylist is the file name of the HLS master playlist inside an
+// asset's HLS directory.
+const MasterPlaylist = "master.m3u8"
+
+type rendition struct {
+	Name         string
+	Height       int
+	VideoBitrate int // kbit/s
+	AudioBitrate int // kbit/s
+}
+
+var hlsLadder = []rendition{
+	{Name: "360p", Height: 360, VideoBitrate: 800, AudioBitrate: 96},
+	{Name: "720p", Height: 720, VideoBitrate: 2800, AudioBitrate: 128},
+	{Name: "1080p", Height: 1080, VideoBitrate: 5000, AudioBitrate: 192},
+}
+
+// HLSDir returns the directory holding the HLS package for the file stored at
+// storagePath.
+func HLSDir(storagePath string) string {
+	return strings.TrimSuffix(storagePath, filepath.Ext(storagePath)) + "_hls"
+}
+
+// ladder picks the renditions to encode for a source of the given height. It
+// never upscales and respects VideoMaxHeight.
+func (s *Service) ladder(srcHeight int) []rendition {
+	maxHeight := srcHeight
+	if s.config.VideoMaxHeight > 0 && s.config.VideoMaxHeight < maxHeight {
+		maxHeight = s.config.VideoMaxHeight
+	}
+
+	var out []rendition
+	for _, r := range hlsLadder {
+		if r.Height <= maxHeight {
+			out = append(out, r)
+		}
+	}
+	if len(out) == 0 {
+		r := hlsLadder[0]
+		r.Name = fmt.Sprintf("%dp", maxHeight)
+		r.Height = maxHeight
+		out = append(out, r)
+	}
+	return out
+}
+
+func (s *Service) packageHLS(ctx context.Context, a *ent.Asset, progress *progressTracker) error {
+	if a.FileType != "video" {
+		return permanent(fmt.Errorf("HLS packaging requires a video asset"))
+	}
+	if !s.hasProcessor(ProcessorFFmpeg) {
+		return permanent(fmt.Errorf("HLS packaging requires ffmpeg"))
+	}
+
+	width, height, err := s.probeDimensions(ctx, a.StoragePath)
+	if err != nil {
+		return fmt.Errorf("ffprobe: %w", err)
+	}
+
+	dir := HLSDir(a.StoragePath)
+	tmpDir := dir + "_tmp"
+	os.RemoveAll(tmpDir)
+
+	renditions := s.ladder(height)
+	progress.start(s.probeDuration(ctx, a.StoragePath), len(renditions))
+	var master strings.Builder
+	master.WriteString("#EXTM3U\n#EXT-X-VERSION:3\n")
+
+	for i, r := range renditions {
+		progress.pass(i)
+		if err := s.encodeRendition(ctx, a.StoragePath, tmpDir, r, progress); err != nil {
+			os.RemoveAll(tmpDir)
+			return fmt.Errorf("ffmpeg %s: %w", r.Name, err)
+		}
+
+		// Keep the source aspect ratio, rounded to an even width as libx264 requires.
+		w := (width*r.Height/height + 1) &^ 1
+		bandwidth := (r.VideoBitrate + r.AudioBitrate) * 1000
+		fmt.Fprintf(&master, "#EXT-X-STREAM-INF:BANDWIDTH=%d,RESOLUTION=%dx%d\n%s/index.m3u8\n", bandwidth, w, r.Height, r.Name)
+	}
+
+	ctx, err = commit(ctx)
+	if err != nil {
+		os.RemoveAll(tmpDir)
+		return err
+	}
+
+	if err := os.WriteFile(filepath.Join(tmpDir, MasterPlaylist), []byte(master.String()), 0o644); err != nil {
+		os.RemoveAll(tmpDir)
+		return err
+	}
+
+	// Swap in the new package only once every rendition has been written.
+	os.RemoveAll(dir)
+	if err := os.Rename(tmpDir, dir); err != nil {
+		os.RemoveAll(tmpDir)
+		return err
+	}
+
+	_, err = s.client.Asset.UpdateOneID(a.ID).
+		SetHlsPath(filepath.Join(dir, MasterPlaylist)).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[Asset %s] Packaged HLS with %d renditions", a.ID, len(renditions))
+	return nil
+}
+
+func (s *Service) encodeRendition(ctx context.Context, input, dir string, r rendition, progress *progressTracker) error {
+	out := filepath.Join(dir, r.Name)
+	if err := os.MkdirAll(out, 0o755); err != nil {
+		return err
+	}
+
+	args := []string{
+		"-i", input,
+		"-map", "0:v:0",
+		"-map", "0:a:0?",
+		"-vf", fmt.Sprintf("scale=-2:%d", r.Height),
+		"-c:v", "libx264",
+		"-preset", "medium",
+		"-b:v", fmt.Sprintf("%dk", r.VideoBitrate),
+		"-maxrate", fmt.Sprintf("%dk", r.VideoBitrate*107/100),
+		"-bufsize", fmt.Sprintf("%dk", r.VideoBitrate*3/2),
+		"-g", "48",
+		"-keyint_min", "48",
+		"-sc_threshold", "0",
+		"-c:a", "aac",
+		"-b:a", fmt.Sprintf("%dk", r.AudioBitrate),
+		"-ac", "2",
+		"-f", "hls",
+		"-hls_time", "6",
+		"-hls_playlist_type", "vod",
+		"-hls_segment_filename", filepath.Join(out, "segment_%03d.ts"),
+		"-y", filepath.Join(out, "index.m3u8"),
+	}
+
+	return s.execFFmpeg(ctx, args, progress)
+}
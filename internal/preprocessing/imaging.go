@@ -0,0 +1,259 @@
+package preprocessing
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// goImageProcessor re-encodes JPEG and PNG images with the standard library,
+// so images can be compressed on machines without ffmpeg. It keeps the
+// source format; conversions to WebP or AVIF are left to ffmpeg.
+type goImageProcessor struct{}
+
+func (goImageProcessor) name() string { return ProcessorGo }
+
+func (goImageProcessor) supports(input string, opts encodeOptions) bool {
+	if opts.FileType != "image" || opts.Format != "" {
+		return false
+	}
+	switch strings.ToLower(filepath.Ext(input)) {
+	case ".jpg", ".jpeg", ".png":
+		return true
+	}
+	return false
+}
+
+func (goImageProcessor) encode(ctx context.Context, input, output string, opts encodeOptions, progress *progressTracker) error {
+	data, err := os.ReadFile(input)
+	if err != nil {
+		return err
+	}
+	img, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return permanent(fmt.Errorf("decode: %w", err))
+	}
+	if format == "jpeg" {
+		// The re-encoded file carries no EXIF, so the rotation a viewer
+		// would have applied is baked into the pixels.
+		img = orient(img, jpegOrientation(data))
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if size := opts.Profile.ImageMaxSize; size > 0 {
+		img = fit(img, size)
+	}
+	if opts.Crop > 0 {
+		img = cropCenter(img, opts.Crop)
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return writeImage(output, img, format, opts.Profile.ImageQuality)
+}
+
+// cropCenter returns the centred square of img, at most size pixels a side.
+func cropCenter(img image.Image, size int) image.Image {
+	b := img.Bounds()
+	w, h := min(b.Dx(), size), min(b.Dy(), size)
+	r := image.Rect(0, 0, w, h).Add(b.Min).Add(image.Pt((b.Dx()-w)/2, (b.Dy()-h)/2))
+
+	out := image.NewNRGBA(image.Rect(0, 0, w, h))
+	draw.Draw(out, out.Bounds(), img, r.Min, draw.Src)
+	return out
+}
+
+// writeImage encodes img to path as a JPEG or PNG. PNGs use the best
+// compression level; quality applies to JPEGs only.
+func writeImage(path string, img image.Image, format string, quality int) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	switch format {
+	case "jpeg":
+		err = jpeg.Encode(f, img, &jpeg.Options{Quality: min(max(quality, 1), 100)})
+	case "png":
+		enc := png.Encoder{CompressionLevel: png.BestCompression}
+		err = enc.Encode(f, img)
+	default:
+		err = permanent(fmt.Errorf("unsupported image format: %s", format))
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// fit scales img down so its longest edge is at most size, averaging the
+// source pixels each destination pixel covers. Smaller images are returned
+// unchanged.
+func fit(img image.Image, size int) image.Image {
+	b := img.Bounds()
+	sw, sh := b.Dx(), b.Dy()
+	if sw <= size && sh <= size {
+		return img
+	}
+
+	dw, dh := size, sh*size/sw
+	if sh > sw {
+		dw, dh = sw*size/sh, size
+	}
+	dw, dh = max(dw, 1), max(dh, 1)
+
+	src := image.NewNRGBA(image.Rect(0, 0, sw, sh))
+	draw.Draw(src, src.Bounds(), img, b.Min, draw.Src)
+	dst := image.NewNRGBA(image.Rect(0, 0, dw, dh))
+
+	for y := 0; y < dh; y++ {
+		y0, y1 := y*sh/dh, max((y+1)*sh/dh, y*sh/dh+1)
+		for x := 0; x < dw; x++ {
+			x0, x1 := x*sw/dw, max((x+1)*sw/dw, x*sw/dw+1)
+
+			var r, g, bl, a, n int
+			for sy := y0; sy < y1; sy++ {
+				row := src.Pix[sy*src.Stride:]
+				for sx := x0; sx < x1; sx++ {
+					p := row[sx*4 : sx*4+4]
+					// Weight colour by alpha so transparent pixels do not
+					// bleed into their neighbours.
+					r += int(p[0]) * int(p[3])
+					g += int(p[1]) * int(p[3])
+					bl += int(p[2]) * int(p[3])
+					a += int(p[3])
+					n++
+				}
+			}
+
+			o := dst.Pix[y*dst.Stride+x*4:]
+			if a > 0 {
+				o[0], o[1], o[2] = uint8(r/a), uint8(g/a), uint8(bl/a)
+			}
+			o[3] = uint8(a / n)
+		}
+	}
+	return dst
+}
+
+// orient applies an EXIF orientation (1-8) to img.
+func orient(img image.Image, orientation int) image.Image {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, dw, dh))
+
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2: // mirrored
+				dx, dy = w-1-x, y
+			case 3: // rotated 180
+				dx, dy = w-1-x, h-1-y
+			case 4: // mirrored vertically
+				dx, dy = x, h-1-y
+			case 5: // transposed
+				dx, dy = y, x
+			case 6: // rotated 90 clockwise
+				dx, dy = h-1-y, x
+			case 7: // transversed
+				dx, dy = h-1-y, w-1-x
+			case 8: // rotated 90 counter-clockwise
+				dx, dy = y, w-1-x
+			}
+			dst.Set(dx, dy, img.At(b.Min.X+x, b.Min.Y+y))
+		}
+	}
+	return dst
+}
+
+// jpegOrientation returns the EXIF orientation tag of a JPEG, or 1 when the
+// file has none.
+func jpegOrientation(data []byte) int {
+	if o, ok := exifTag(jpegEXIF(data), 0x0112); ok {
+		return o
+	}
+	return 1
+}
+
+// exifTag looks tag up in the first IFD of an EXIF TIFF structure and
+// returns the SHORT value at the start of its value field. For LONG values
+// and offsets only the presence of the tag is meaningful.
+func exifTag(tiff []byte, tag int) (int, bool) {
+	if len(tiff) < 8 {
+		return 0, false
+	}
+
+	var u16 func([]byte) int
+	var u32 func([]byte) int
+	switch string(tiff[:2]) {
+	case "II":
+		u16 = func(b []byte) int { return int(b[0]) | int(b[1])<<8 }
+		u32 = func(b []byte) int { return u16(b) | u16(b[2:])<<16 }
+	case "MM":
+		u16 = func(b []byte) int { return int(b[0])<<8 | int(b[1]) }
+		u32 = func(b []byte) int { return u16(b)<<16 | u16(b[2:]) }
+	default:
+		return 0, false
+	}
+
+	ifd := u32(tiff[4:])
+	if ifd < 8 || ifd+2 > len(tiff) {
+		return 0, false
+	}
+	n := u16(tiff[ifd:])
+	for i := 0; i < n; i++ {
+		entry := ifd + 2 + i*12
+		if entry+12 > len(tiff) {
+			break
+		}
+		if u16(tiff[entry:]) == tag {
+			return u16(tiff[entry+8:]), true
+		}
+	}
+	return 0, false
+}
+
+// jpegEXIF returns the TIFF structure of a JPEG's EXIF segment, or nil.
+func jpegEXIF(data []byte) []byte {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil
+	}
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF {
+			return nil
+		}
+		marker := data[i+1]
+		if marker == 0xDA || marker == 0xD9 { // image data starts
+			return nil
+		}
+		length := int(data[i+2])<<8 | int(data[i+3])
+		end := i + 2 + length
+		if length < 2 || end > len(data) {
+			return nil
+		}
+		seg := data[i+4 : end]
+		if marker == 0xE1 && len(seg) > 6 && string(seg[:6]) == "Exif\x00\x00" {
+			return seg[6:]
+		}
+		i = end
+	}
+	return nil
+}
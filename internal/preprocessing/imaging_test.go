@@ -0,0 +1,247 @@
+package preprocessing
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/adimail/asset-manager/internal/config"
+)
+
+var (
+	red   = color.NRGBA{R: 255, A: 255}
+	green = color.NRGBA{G: 255, A: 255}
+	grey  = color.NRGBA{R: 128, G: 128, B: 128, A: 255}
+)
+
+// filled returns a w x h image of c.
+func filled(w, h int, c color.NRGBA) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.SetNRGBA(x, y, c)
+		}
+	}
+	return img
+}
+
+func sameColor(a, b color.Color) bool {
+	r1, g1, b1, a1 := a.RGBA()
+	r2, g2, b2, a2 := b.RGBA()
+	return r1 == r2 && g1 == g2 && b1 == b2 && a1 == a2
+}
+
+func TestFit(t *testing.T) {
+	tests := []struct {
+		name         string
+		w, h, size   int
+		wantW, wantH int
+	}{
+		{"landscape", 400, 200, 100, 100, 50},
+		{"portrait", 200, 400, 100, 50, 100},
+		{"square", 300, 300, 100, 100, 100},
+		{"thin", 1000, 1, 10, 10, 1},
+		{"one edge over", 120, 80, 100, 100, 66},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := fit(filled(tt.w, tt.h, grey), tt.size).Bounds()
+			if b.Dx() != tt.wantW || b.Dy() != tt.wantH {
+				t.Errorf("fit() = %dx%d, want %dx%d", b.Dx(), b.Dy(), tt.wantW, tt.wantH)
+			}
+		})
+	}
+}
+
+func TestFitSmallImageUnchanged(t *testing.T) {
+	img := filled(50, 80, grey)
+	if got := fit(img, 80); got != img {
+		t.Error("fit() copied an image already within size")
+	}
+}
+
+func TestFitAverages(t *testing.T) {
+	img := filled(2, 1, color.NRGBA{A: 255})
+	img.SetNRGBA(1, 0, color.NRGBA{R: 255, G: 255, B: 255, A: 255})
+	want := color.NRGBA{R: 127, G: 127, B: 127, A: 255}
+	if got := fit(img, 1).At(0, 0); !sameColor(got, want) {
+		t.Errorf("fit() = %v, want %v", got, want)
+	}
+}
+
+func TestFitTransparentPixelsDoNotBleed(t *testing.T) {
+	img := filled(2, 1, red)
+	img.SetNRGBA(1, 0, color.NRGBA{}) // transparent black
+	want := color.NRGBA{R: 255, A: 127}
+	if got := fit(img, 1).At(0, 0); !sameColor(got, want) {
+		t.Errorf("fit() = %v, want %v", got, want)
+	}
+}
+
+func TestOrient(t *testing.T) {
+	// A 3x2 image with its top corners marked: red at the left, green at
+	// the right.
+	src := filled(3, 2, grey)
+	src.SetNRGBA(0, 0, red)
+	src.SetNRGBA(2, 0, green)
+
+	tests := []struct {
+		orientation  int
+		wantW, wantH int
+		red, green   image.Point
+	}{
+		{1, 3, 2, image.Pt(0, 0), image.Pt(2, 0)},
+		{2, 3, 2, image.Pt(2, 0), image.Pt(0, 0)},
+		{3, 3, 2, image.Pt(2, 1), image.Pt(0, 1)},
+		{4, 3, 2, image.Pt(0, 1), image.Pt(2, 1)},
+		{5, 2, 3, image.Pt(0, 0), image.Pt(0, 2)},
+		{6, 2, 3, image.Pt(1, 0), image.Pt(1, 2)},
+		{7, 2, 3, image.Pt(1, 2), image.Pt(1, 0)},
+		{8, 2, 3, image.Pt(0, 2), image.Pt(0, 0)},
+	}
+	for _, tt := range tests {
+		got := orient(src, tt.orientation)
+		b := got.Bounds()
+		if b.Dx() != tt.wantW || b.Dy() != tt.wantH {
+			t.Errorf("orientation %d: size %dx%d, want %dx%d", tt.orientation, b.Dx(), b.Dy(), tt.wantW, tt.wantH)
+			continue
+		}
+		if !sameColor(got.At(tt.red.X, tt.red.Y), red) {
+			t.Errorf("orientation %d: top-left corner not at %v", tt.orientation, tt.red)
+		}
+		if !sameColor(got.At(tt.green.X, tt.green.Y), green) {
+			t.Errorf("orientation %d: top-right corner not at %v", tt.orientation, tt.green)
+		}
+	}
+}
+
+func TestOrientOutOfRange(t *testing.T) {
+	src := filled(3, 2, grey)
+	for _, orientation := range []int{0, 9} {
+		if got := orient(src, orientation); got != src {
+			t.Errorf("orientation %d changed the image", orientation)
+		}
+	}
+}
+
+func TestWriteImage(t *testing.T) {
+	dir := t.TempDir()
+	src := filled(4, 3, red)
+
+	for _, format := range []string{"jpeg", "png"} {
+		path := filepath.Join(dir, "out."+format)
+		// Quality is clamped, so out-of-range values still encode.
+		if err := writeImage(path, src, format, 0); err != nil {
+			t.Fatalf("%s: %v", format, err)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		img, got, err := image.Decode(bytes.NewReader(data))
+		if err != nil {
+			t.Fatalf("%s: decode: %v", format, err)
+		}
+		if got != format {
+			t.Errorf("wrote %s, want %s", got, format)
+		}
+		if img.Bounds().Size() != src.Bounds().Size() {
+			t.Errorf("%s: size %v, want %v", format, img.Bounds().Size(), src.Bounds().Size())
+		}
+		if format == "png" && !sameColor(img.At(1, 1), red) {
+			t.Errorf("png: pixel %v, want %v", img.At(1, 1), red)
+		}
+	}
+
+	err := writeImage(filepath.Join(dir, "out.gif"), src, "gif", 80)
+	var perm *permanentError
+	if !errors.As(err, &perm) {
+		t.Errorf("unsupported format: got %v, want a permanent error", err)
+	}
+}
+
+func TestGoImageProcessorSupports(t *testing.T) {
+	tests := []struct {
+		input string
+		opts  encodeOptions
+		want  bool
+	}{
+		{"a.jpg", encodeOptions{FileType: "image"}, true},
+		{"a.JPEG", encodeOptions{FileType: "image"}, true},
+		{"a.png", encodeOptions{FileType: "image"}, true},
+		{"a.webp", encodeOptions{FileType: "image"}, false},
+		{"a.gif", encodeOptions{FileType: "image"}, false},
+		{"a.jpg", encodeOptions{FileType: "image", Format: "webp"}, false},
+		{"a.mp4", encodeOptions{FileType: "video"}, false},
+		{"a.png", encodeOptions{FileType: "audio"}, false},
+	}
+	for _, tt := range tests {
+		if got := (goImageProcessor{}).supports(tt.input, tt.opts); got != tt.want {
+			t.Errorf("supports(%q, %+v) = %v, want %v", tt.input, tt.opts, got, tt.want)
+		}
+	}
+}
+
+func TestGoImageProcessorEncode(t *testing.T) {
+	dir := t.TempDir()
+
+	// A 40x20 JPEG tagged as rotated 90 degrees clockwise.
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, filled(40, 20, grey), nil); err != nil {
+		t.Fatal(err)
+	}
+	data := append(append([]byte{0xFF, 0xD8}, orientationEXIF(6)...), buf.Bytes()[2:]...)
+	input := filepath.Join(dir, "in.jpg")
+	if err := os.WriteFile(input, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	pngInput := filepath.Join(dir, "in.png")
+	f, err := os.Create(pngInput)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := png.Encode(f, filled(40, 20, grey)); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	tests := []struct {
+		name         string
+		input        string
+		profile      config.Profile
+		wantW, wantH int
+		wantFormat   string
+	}{
+		{"rotated", input, config.Profile{ImageQuality: 80}, 20, 40, "jpeg"},
+		{"rotated and fitted", input, config.Profile{ImageQuality: 80, ImageMaxSize: 10}, 5, 10, "jpeg"},
+		{"png fitted", pngInput, config.Profile{ImageMaxSize: 10}, 10, 5, "png"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := filepath.Join(dir, "out"+filepath.Ext(tt.input))
+			opts := encodeOptions{FileType: "image", Profile: tt.profile}
+			if err := (goImageProcessor{}).encode(context.Background(), tt.input, output, opts, nil); err != nil {
+				t.Fatal(err)
+			}
+			out, err := os.ReadFile(output)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cfg, format, err := image.DecodeConfig(bytes.NewReader(out))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if format != tt.wantFormat || cfg.Width != tt.wantW || cfg.Height != tt.wantH {
+				t.Errorf("got %s %dx%d, want %s %dx%d", format, cfg.Width, cfg.Height, tt.wantFormat, tt.wantW, tt.wantH)
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package preprocessing
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/adimail/asset-manager/ent"
+	"github.com/adimail/asset-manager/ent/compressionjob"
+	"github.com/adimail/asset-manager/ent/schema"
+	"github.com/adimail/asset-manager/internal/config"
+)
+
+// EncoderParams are the encoder settings a job ran with.
+type EncoderParams = schema.EncoderParams
+
+// maxLogBytes bounds the output kept in a job's log. The end is kept, as
+// that is where ffmpeg explains a failure.
+const maxLogBytes = 64 << 10
+
+// JobFields are the job columns every query reads: all but the command log,
+// which can run to 64 KiB and is only read by GetJobLog.
+var JobFields = func() []string {
+	var fields []string
+	for _, c := range compressionjob.Columns {
+		if c != compressionjob.FieldLog && c != compressionjob.FieldCommands {
+			fields = append(fields, c)
+		}
+	}
+	return fields
+}()
+
+// updateJob returns an update of job that reads back only its ID, leaving
+// the command log where it is.
+func updateJob(job *ent.CompressionJob) *ent.CompressionJobUpdateOne {
+	return job.Update().Select(compressionjob.FieldID)
+}
+
+// JobLog is the record of a job's latest attempt: what it ran and what the
+// tools printed.
+type JobLog struct {
+	JobID         string         `json:"job_id"`
+	FFmpegVersion string         `json:"ffmpeg_version,omitempty"`
+	EncoderParams *EncoderParams `json:"encoder_params,omitempty"`
+	Commands      []string       `json:"commands"`
+	Log           string         `json:"log"`
+}
+
+type jobLogKey struct{}
+
+// jobLog collects the commands a running job executes and their output.
+type jobLog struct {
+	mu       sync.Mutex
+	commands []string
+	out      []byte
+	dropped  int
+	params   *EncoderParams
+}
+
+func withJobLog(ctx context.Context, l *jobLog) context.Context {
+	return context.WithValue(ctx, jobLogKey{}, l)
+}
+
+// jobLogFrom returns the log of the job ctx runs, or nil outside a job, such
+// as for estimates. A nil log ignores everything recorded in it.
+func jobLogFrom(ctx context.Context) *jobLog {
+	l, _ := ctx.Value(jobLogKey{}).(*jobLog)
+	return l
+}
+
+// command records a command line and starts its section of the output.
+func (l *jobLog) command(name string, args []string) {
+	if l == nil {
+		return
+	}
+	line := shellQuote(append([]string{name}, args...))
+	l.mu.Lock()
+	l.commands = append(l.commands, line)
+	l.mu.Unlock()
+	fmt.Fprintf(l, "$ %s\n", line)
+}
+
+// setParams records the encoder settings the job runs with. Jobs that pick
+// their settings in steps, like target-size searches, call it again with the
+// final ones.
+func (l *jobLog) setParams(p config.Profile) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.params = &EncoderParams{
+		Profile:      p.Name,
+		VideoCodec:   p.VideoCodec,
+		CRF:          p.CRF,
+		Preset:       p.Preset,
+		MaxWidth:     p.MaxWidth,
+		MaxHeight:    p.MaxHeight,
+		ImageQuality: p.ImageQuality,
+		ImageFormat:  p.ImageFormat,
+		ImageMaxSize: p.ImageMaxSize,
+		AudioCodec:   p.AudioCodec,
+		AudioBitrate: p.AudioBitrate,
+		Normalize:    p.Normalize,
+	}
+}
+
+// Write appends tool output, dropping the oldest bytes past maxLogBytes.
+func (l *jobLog) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.out = append(l.out, p...)
+	if over := len(l.out) - maxLogBytes; over > 0 {
+		l.out = append(l.out[:0], l.out[over:]...)
+		l.dropped += over
+	}
+	return len(p), nil
+}
+
+func (l *jobLog) String() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.dropped > 0 {
+		return fmt.Sprintf("[%d earlier bytes dropped]\n%s", l.dropped, l.out)
+	}
+	return string(l.out)
+}
+
+// logf adds a line to the job log of ctx, for work done in process that no
+// command line describes.
+func logf(ctx context.Context, format string, args ...any) {
+	if l := jobLogFrom(ctx); l != nil {
+		fmt.Fprintf(l, format+"\n", args...)
+	}
+}
+
+// logOutput returns w, also copying to the job log of ctx when there is one.
+func logOutput(ctx context.Context, w io.Writer) io.Writer {
+	l := jobLogFrom(ctx)
+	if l == nil {
+		return w
+	}
+	if w == nil {
+		return l
+	}
+	return io.MultiWriter(w, l)
+}
+
+// saveLog stores the record of the attempt that just ran on the job.
+func (s *Service) saveLog(ctx context.Context, job *ent.CompressionJob, l *jobLog) {
+	output := l.String()
+	l.mu.Lock()
+	update := s.updateOwned(job).
+		SetCommands(l.commands).
+		SetLog(output)
+	if l.params != nil {
+		update.SetEncoderParams(*l.params)
+	}
+	l.mu.Unlock()
+	if v := s.ffmpegVersion(); v != "" {
+		update.SetFfmpegVersion(v)
+	}
+	if _, err := update.Save(ctx); err != nil {
+		log.Printf("[Job %s] Failed to save log: %v", job.ID, err)
+	}
+}
+
+// ffmpegVersion returns the first line of `ffmpeg -version`, or "" when
+// ffmpeg is not in use. It is read once per process.
+func (s *Service) ffmpegVersion() string {
+	if !s.hasProcessor(ProcessorFFmpeg) {
+		return ""
+	}
+	s.versionOnce.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), probeTimeout)
+		defer cancel()
+		out, err := command(ctx, s.config.FFmpegPath, "-version").Output()
+		if err != nil {
+			log.Printf("Failed to read ffmpeg version: %v", err)
+			return
+		}
+		s.version, _, _ = strings.Cut(strings.TrimSpace(string(out)), "\n")
+	})
+	return s.version
+}
+
+// GetJobLog returns the record of a job's latest attempt.
+func (s *Service) GetJobLog(ctx context.Context, id string) (*JobLog, error) {
+	j, err := s.client.CompressionJob.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	l := &JobLog{
+		JobID:         j.ID,
+		FFmpegVersion: j.FfmpegVersion,
+		Commands:      j.Commands,
+		Log:           j.Log,
+	}
+	if j.EncoderParams.Profile != "" {
+		l.EncoderParams = &j.EncoderParams
+	}
+	if l.Commands == nil {
+		l.Commands = []string{}
+	}
+	return l, nil
+}
+
+// shellQuote joins args into a command line that can be pasted into a
+// shell to run the command again.
+func shellQuote(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		if arg != "" && strings.Trim(arg, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-_=+,.:/@%") == "" {
+			quoted[i] = arg
+			continue
+		}
+		quoted[i] = "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+	}
+	return strings.Join(quoted, " ")
+}
@@ -0,0 +1,154 @@
+package preprocessing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/adimail/asset-manager/ent"
+	"github.com/adimail/asset-manager/ent/asset"
+	"github.com/adimail/asset-manager/ent/compressionjob"
+)
+
+// ErrJobFinished is returned when cancelling a job that has already ended.
+var ErrJobFinished = errors.New("job has already finished")
+
+// ErrJobActive is returned when queueing a job for an asset that already
+// has one pending or running. Two jobs on one asset would write the same
+// files.
+var ErrJobActive = errors.New("asset has a pending or running job")
+
+// CancelJob stops a job. A pending job is taken off the queue; a running job
+// has its ffmpeg process killed and is marked cancelled by its worker once
+// the temporary output has been cleaned up. The asset is left unchanged.
+func (s *Service) CancelJob(ctx context.Context, id string) (*Job, error) {
+	job, err := s.client.CompressionJob.Query().
+		Where(compressionjob.ID(id)).
+		Select(JobFields...).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch JobStatus(job.Status) {
+	case StatusPending:
+		n, err := s.client.CompressionJob.Update().
+			Where(
+				compressionjob.ID(id),
+				compressionjob.StatusEQ(string(StatusPending)),
+			).
+			SetStatus(string(StatusCancelled)).
+			SetError("cancelled").
+			SetCompletedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			// Claimed by a worker in the meantime.
+			return s.CancelJob(ctx, id)
+		}
+	case StatusProcessing:
+		// The flag reaches workers in other processes through their
+		// heartbeat; a local worker is stopped right away.
+		err := s.client.CompressionJob.UpdateOneID(id).
+			SetCancelRequested(true).
+			Select(compressionjob.FieldID).
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.mu.Lock()
+		if cancel, ok := s.running[job.WorkerID]; ok {
+			cancel(nil)
+		}
+		s.mu.Unlock()
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrJobFinished, job.Status)
+	}
+
+	return s.GetJob(ctx, id)
+}
+
+func (s *Service) ListJobs(ctx context.Context, filter JobFilter) (*JobListResponse, error) {
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.Limit < 1 {
+		filter.Limit = 50
+	}
+	offset := (filter.Page - 1) * filter.Limit
+
+	query := s.client.CompressionJob.Query()
+
+	if filter.Status != "" {
+		query.Where(compressionjob.StatusEQ(string(filter.Status)))
+	}
+	if filter.AssetID != "" {
+		query.Where(compressionjob.HasAssetWith(asset.ID(filter.AssetID)))
+	}
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := query.
+		WithAsset().
+		Order(ent.Desc(compressionjob.FieldCreatedAt)).
+		Limit(filter.Limit).
+		Offset(offset).
+		Select(JobFields...).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*Job, len(list))
+	for i, item := range list {
+		result[i] = MapJob(item)
+	}
+
+	return &JobListResponse{
+		Jobs:       result,
+		TotalCount: total,
+		Page:       filter.Page,
+		Limit:      filter.Limit,
+	}, nil
+}
+
+func (s *Service) GetJob(ctx context.Context, id string) (*Job, error) {
+	j, err := s.client.CompressionJob.Query().
+		Where(compressionjob.ID(id)).
+		WithAsset().
+		Select(JobFields...).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return MapJob(j), nil
+}
+
+// AssetJobs returns the job history of an asset, newest first.
+func (s *Service) AssetJobs(ctx context.Context, assetID string) ([]*Job, error) {
+	if _, err := s.client.Asset.Get(ctx, assetID); err != nil {
+		return nil, err
+	}
+
+	list, err := s.client.CompressionJob.Query().
+		Where(compressionjob.HasAssetWith(asset.ID(assetID))).
+		Order(ent.Desc(compressionjob.FieldCreatedAt)).
+		Select(JobFields...).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*Job, len(list))
+	for i, item := range list {
+		result[i] = MapJob(item)
+		result[i].AssetID = assetID
+	}
+	return result, nil
+}
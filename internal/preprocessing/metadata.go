@@ -0,0 +1,134 @@
+package preprocessing
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// stripMetadata copies input to output without EXIF, XMP, comments and text
+// chunks. The pixel data is copied as is. A JPEG's orientation survives as a
+// minimal EXIF block so viewers still display it upright.
+func stripMetadata(input, output string) error {
+	data, err := os.ReadFile(input)
+	if err != nil {
+		return err
+	}
+
+	var stripped []byte
+	switch strings.ToLower(filepath.Ext(input)) {
+	case ".jpg", ".jpeg":
+		stripped, err = stripJPEG(data)
+	case ".png":
+		stripped, err = stripPNG(data)
+	default:
+		return permanent(fmt.Errorf("metadata stripping supports JPEG and PNG images"))
+	}
+	if err != nil {
+		return permanent(err)
+	}
+	return os.WriteFile(output, stripped, 0o644)
+}
+
+func stripJPEG(data []byte) ([]byte, error) {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil, fmt.Errorf("not a JPEG file")
+	}
+
+	out := bytes.NewBuffer(make([]byte, 0, len(data)))
+	out.Write(data[:2])
+	if o := jpegOrientation(data); o >= 2 && o <= 8 {
+		out.Write(orientationEXIF(o))
+	}
+
+	for i := 2; ; {
+		if i+4 > len(data) || data[i] != 0xFF {
+			return nil, fmt.Errorf("malformed JPEG segment at offset %d", i)
+		}
+		marker := data[i+1]
+		if marker == 0xDA {
+			// Entropy-coded data follows the scan header; copy the rest.
+			out.Write(data[i:])
+			return out.Bytes(), nil
+		}
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+		end := i + 2 + length
+		if length < 2 || end > len(data) {
+			return nil, fmt.Errorf("truncated JPEG segment at offset %d", i)
+		}
+		if keepJPEGSegment(marker, data[i+4:end]) {
+			out.Write(data[i:end])
+		}
+		i = end
+	}
+}
+
+// keepJPEGSegment keeps everything needed to decode and colour-manage the
+// image: non-APP segments, JFIF, the ICC profile and the Adobe colour
+// transform marker.
+func keepJPEGSegment(marker byte, payload []byte) bool {
+	switch {
+	case marker == 0xFE: // COM
+		return false
+	case marker == 0xE0:
+		return bytes.HasPrefix(payload, []byte("JFIF\x00"))
+	case marker == 0xE2:
+		return bytes.HasPrefix(payload, []byte("ICC_PROFILE\x00"))
+	case marker == 0xEE:
+		return bytes.HasPrefix(payload, []byte("Adobe"))
+	case marker >= 0xE0 && marker <= 0xEF:
+		return false
+	}
+	return true
+}
+
+// orientationEXIF builds an APP1 segment holding only the orientation tag.
+func orientationEXIF(orientation int) []byte {
+	return []byte{
+		0xFF, 0xE1, 0x00, 0x22,
+		'E', 'x', 'i', 'f', 0, 0,
+		'M', 'M', 0x00, 0x2A, 0x00, 0x00, 0x00, 0x08, // TIFF header, IFD0 at 8
+		0x00, 0x01, // one entry
+		0x01, 0x12, 0x00, 0x03, 0x00, 0x00, 0x00, 0x01, // orientation, SHORT, count 1
+		0x00, byte(orientation), 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, // no next IFD
+	}
+}
+
+// pngMetadataChunks are the ancillary PNG chunks that carry metadata rather
+// than anything needed to render the image.
+var pngMetadataChunks = map[string]bool{
+	"tEXt": true,
+	"zTXt": true,
+	"iTXt": true,
+	"eXIf": true,
+	"tIME": true,
+}
+
+func stripPNG(data []byte) ([]byte, error) {
+	const signature = "\x89PNG\r\n\x1a\n"
+	if !bytes.HasPrefix(data, []byte(signature)) {
+		return nil, fmt.Errorf("not a PNG file")
+	}
+
+	out := bytes.NewBuffer(make([]byte, 0, len(data)))
+	out.WriteString(signature)
+	for i := len(signature); i < len(data); {
+		if i+12 > len(data) {
+			return nil, fmt.Errorf("truncated PNG chunk at offset %d", i)
+		}
+		length := int64(binary.BigEndian.Uint32(data[i:]))
+		if length > int64(len(data)-i-12) {
+			return nil, fmt.Errorf("truncated PNG chunk at offset %d", i)
+		}
+		end := i + 12 + int(length)
+		if !pngMetadataChunks[string(data[i+4:i+8])] {
+			out.Write(data[i:end])
+		}
+		i = end
+	}
+	return out.Bytes(), nil
+}
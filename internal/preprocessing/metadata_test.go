@@ -0,0 +1,183 @@
+package preprocessing
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"testing"
+)
+
+// jpegSegment builds a JPEG marker segment with a correct length field.
+func jpegSegment(marker byte, payload []byte) []byte {
+	seg := []byte{0xFF, marker, 0, 0}
+	binary.BigEndian.PutUint16(seg[2:], uint16(len(payload)+2))
+	return append(seg, payload...)
+}
+
+// gpsEXIF is an APP1 payload whose IFD0 holds only a GPS IFD pointer.
+func gpsEXIF() []byte {
+	return []byte{
+		'E', 'x', 'i', 'f', 0, 0,
+		'M', 'M', 0x00, 0x2A, 0x00, 0x00, 0x00, 0x08,
+		0x00, 0x01,
+		0x88, 0x25, 0x00, 0x04, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x1A,
+		0x00, 0x00, 0x00, 0x00,
+	}
+}
+
+func testJPEG(segments ...[]byte) []byte {
+	data := []byte{0xFF, 0xD8}
+	for _, seg := range segments {
+		data = append(data, seg...)
+	}
+	data = append(data, jpegSegment(0xDA, []byte{0x01, 0x02})...)
+	return append(data, 0x11, 0x22, 0xFF, 0xD9)
+}
+
+// pngChunk builds a PNG chunk with a correct length and CRC.
+func pngChunk(typ string, payload []byte) []byte {
+	chunk := binary.BigEndian.AppendUint32(nil, uint32(len(payload)))
+	chunk = append(chunk, typ...)
+	chunk = append(chunk, payload...)
+	return binary.BigEndian.AppendUint32(chunk, crc32.ChecksumIEEE(chunk[4:]))
+}
+
+func testPNG(chunks ...[]byte) []byte {
+	data := []byte("\x89PNG\r\n\x1a\n")
+	data = append(data, pngChunk("IHDR", make([]byte, 13))...)
+	for _, chunk := range chunks {
+		data = append(data, chunk...)
+	}
+	return append(data, pngChunk("IEND", nil)...)
+}
+
+var (
+	soi    = []byte{0xFF, 0xD8}
+	pngSig = []byte("\x89PNG\r\n\x1a\n")
+)
+
+func TestStripJPEG(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr bool
+		drop    []byte // must not survive stripping
+	}{
+		{"not a JPEG", []byte("GIF89a"), true, nil},
+		{"SOI only", soi, true, nil},
+		{"truncated marker", append(soi, 0xFF, 0xE1, 0x00), true, nil},
+		{"zero length", append(soi, 0xFF, 0xE1, 0x00, 0x00, 0xAA, 0xBB), true, nil},
+		{"length one", append(soi, 0xFF, 0xE1, 0x00, 0x01, 0xAA, 0xBB), true, nil},
+		{"length past end", append(soi, 0xFF, 0xE1, 0x01, 0x00, 0xAA, 0xBB), true, nil},
+		{"not a marker", append(soi, 0x00, 0xE1, 0x00, 0x04, 0xAA, 0xBB), true, nil},
+		{"GPS EXIF", testJPEG(jpegSegment(0xE1, gpsEXIF())), false, []byte("Exif")},
+		{"comment", testJPEG(jpegSegment(0xFE, []byte("secret"))), false, []byte("secret")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := stripJPEG(tt.data)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if bytes.Contains(out, tt.drop) {
+				t.Errorf("%q survived stripping", tt.drop)
+			}
+			if !bytes.HasSuffix(out, []byte{0x11, 0x22, 0xFF, 0xD9}) {
+				t.Error("image data was not copied")
+			}
+		})
+	}
+}
+
+func TestJPEGHasLocation(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want bool
+	}{
+		{"empty", nil, false},
+		{"SOI only", soi, false},
+		{"truncated marker", append(soi, 0xFF, 0xE1, 0x00), false},
+		{"zero length", append(soi, 0xFF, 0xE1, 0x00, 0x00, 0xAA, 0xBB), false},
+		{"length one", append(soi, 0xFF, 0xE1, 0x00, 0x01, 0xAA, 0xBB), false},
+		{"length past end", append(soi, 0xFF, 0xE1, 0x01, 0x00, 0xAA, 0xBB), false},
+		{"short EXIF", testJPEG(jpegSegment(0xE1, []byte("Exif\x00\x00MM"))), false},
+		{"no metadata", testJPEG(), false},
+		{"GPS EXIF", testJPEG(jpegSegment(0xE1, gpsEXIF())), true},
+		{"GPS XMP", testJPEG(jpegSegment(0xE1, []byte("http://ns.adobe.com/xap/1.0/\x00<exif:GPSLatitude>"))), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := jpegHasLocation(tt.data); got != tt.want {
+				t.Errorf("jpegHasLocation() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStripPNG(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr bool
+		drop    []byte
+	}{
+		{"not a PNG", []byte("GIF89a"), true, nil},
+		{"truncated chunk header", append(pngSig, 0x00, 0x00, 0x00, 0x0D, 'I'), true, nil},
+		{"length past end", append(pngSig, 0x00, 0x01, 0x00, 0x00, 'I', 'H', 'D', 'R', 0, 0, 0, 0), true, nil},
+		{"huge length", append(pngSig, 0xFF, 0xFF, 0xFF, 0xFF, 'I', 'H', 'D', 'R', 0, 0, 0, 0), true, nil},
+		{"text chunk", testPNG(pngChunk("tEXt", []byte("Comment\x00secret"))), false, []byte("secret")},
+		{"EXIF chunk", testPNG(pngChunk("eXIf", gpsEXIF()[6:])), false, []byte("eXIf")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := stripPNG(tt.data)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if bytes.Contains(out, tt.drop) {
+				t.Errorf("%q survived stripping", tt.drop)
+			}
+			if !bytes.Contains(out, []byte("IHDR")) || !bytes.Contains(out, []byte("IEND")) {
+				t.Error("image chunks were not copied")
+			}
+		})
+	}
+}
+
+func TestPNGHasLocation(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want bool
+	}{
+		{"empty", nil, false},
+		{"signature only", pngSig, false},
+		{"truncated chunk header", append(pngSig, 0x00, 0x00, 0x00, 0x0D, 'I'), false},
+		{"length past end", append(pngSig, 0x00, 0x01, 0x00, 0x00, 'e', 'X', 'I', 'f', 0, 0, 0, 0), false},
+		{"huge length", append(pngSig, 0xFF, 0xFF, 0xFF, 0xFF, 'e', 'X', 'I', 'f', 0, 0, 0, 0), false},
+		{"short EXIF", testPNG(pngChunk("eXIf", []byte("MM"))), false},
+		{"no metadata", testPNG(), false},
+		{"GPS EXIF", testPNG(pngChunk("eXIf", gpsEXIF()[6:])), true},
+		{"GPS XMP", testPNG(pngChunk("iTXt", []byte("XML:com.adobe.xmp\x00\x00\x00\x00\x00<exif:GPSLatitude>"))), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pngHasLocation(tt.data); got != tt.want {
+				t.Errorf("pngHasLocation() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
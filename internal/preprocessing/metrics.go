@@ -0,0 +1,173 @@
+package preprocessing
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/adimail/asset-manager/ent"
+	"github.com/adimail/asset-manager/internal/config"
+)
+
+// OutcomeBelowQualityFloor is recorded when a compress job's output scored
+// below its profile's quality floor and was discarded.
+const OutcomeBelowQualityFloor = "below_quality_floor"
+
+// Quality metrics.
+const (
+	MetricSSIM = "ssim"
+	MetricPSNR = "psnr"
+	MetricVMAF = "vmaf" // needs an ffmpeg built with libvmaf
+)
+
+// maxPSNR stands in for the infinite PSNR of identical images.
+const maxPSNR = 100
+
+// metricPatterns extract each metric's overall score from ffmpeg's log.
+var metricPatterns = map[string]*regexp.Regexp{
+	MetricSSIM: regexp.MustCompile(`SSIM .* All:([0-9.]+)`),
+	MetricPSNR: regexp.MustCompile(`PSNR .* average:([0-9.]+|inf)`),
+	MetricVMAF: regexp.MustCompile(`VMAF score: ([0-9.]+)`),
+}
+
+// qualityMetrics returns the metrics to measure for a job with profile p:
+// the configured ones plus any the profile sets a floor for.
+func (s *Service) qualityMetrics(p config.Profile) []string {
+	var metrics []string
+	for _, m := range s.config.QualityMetrics {
+		if _, ok := metricPatterns[m]; ok && !slices.Contains(metrics, m) {
+			metrics = append(metrics, m)
+		}
+	}
+	floors := map[string]float64{MetricSSIM: p.MinSSIM, MetricPSNR: p.MinPSNR, MetricVMAF: p.MinVMAF}
+	for _, m := range []string{MetricSSIM, MetricPSNR, MetricVMAF} {
+		if floors[m] > 0 && !slices.Contains(metrics, m) {
+			metrics = append(metrics, m)
+		}
+	}
+	return metrics
+}
+
+// checkQuality measures output against original, stores the scores on the
+// job and returns a description of the first floor of p the output falls
+// below, or "" when it meets them all. Without ffmpeg nothing is measured;
+// a profile with floors then fails the job, as its floors cannot be checked.
+func (s *Service) checkQuality(ctx context.Context, job *ent.CompressionJob, fileType, original, output string, p config.Profile, progress *progressTracker) (string, error) {
+	metrics := s.qualityMetrics(p)
+	if len(metrics) == 0 || (fileType != "image" && fileType != "video") {
+		return "", nil
+	}
+	hasFloors := p.MinSSIM > 0 || p.MinPSNR > 0 || p.MinVMAF > 0
+	if !s.hasProcessor(ProcessorFFmpeg) {
+		if hasFloors {
+			return "", permanent(fmt.Errorf("profile %s has quality floors, which need ffmpeg to check", p.Name))
+		}
+		return "", nil
+	}
+
+	scores, err := s.measureQuality(ctx, original, output, metrics, progress)
+	if err != nil {
+		if !hasFloors && ctx.Err() == nil {
+			log.Printf("[Job %s] Failed to measure quality: %v", job.ID, err)
+			return "", nil
+		}
+		return "", fmt.Errorf("measure quality: %w", err)
+	}
+
+	update := updateJob(job)
+	if v, ok := scores[MetricSSIM]; ok {
+		update.SetSsim(v)
+	}
+	if v, ok := scores[MetricPSNR]; ok {
+		update.SetPsnr(v)
+	}
+	if v, ok := scores[MetricVMAF]; ok {
+		update.SetVmaf(v)
+	}
+	if err := update.Exec(context.WithoutCancel(ctx)); err != nil {
+		log.Printf("[Job %s] Failed to save quality metrics: %v", job.ID, err)
+	}
+
+	for _, floor := range []struct {
+		metric string
+		min    float64
+	}{{MetricSSIM, p.MinSSIM}, {MetricPSNR, p.MinPSNR}, {MetricVMAF, p.MinVMAF}} {
+		if floor.min > 0 && scores[floor.metric] < floor.min {
+			return fmt.Sprintf("%s %.4g is below the floor of %.4g", floor.metric, scores[floor.metric], floor.min), nil
+		}
+	}
+	return "", nil
+}
+
+// measureQuality runs ffmpeg's metric filters over output and original. The
+// output is scaled to the original's frames first, as the metrics compare
+// frames pixel by pixel. scale2ref sizes it from the decoded original, so a
+// rotation ffmpeg applies to the original is matched.
+func (s *Service) measureQuality(ctx context.Context, original, output string, metrics []string, progress *progressTracker) (map[string]float64, error) {
+	n := len(metrics)
+	filter := fmt.Sprintf("[0:v][1:v]scale2ref=flags=bicubic[dist][ref];[dist]format=yuv420p,split=%d", n)
+	for i := range metrics {
+		filter += fmt.Sprintf("[d%d]", i)
+	}
+	filter += fmt.Sprintf(";[ref]format=yuv420p,split=%d", n)
+	for i := range metrics {
+		filter += fmt.Sprintf("[r%d]", i)
+	}
+	for i, m := range metrics {
+		name := m
+		if m == MetricVMAF {
+			name = "libvmaf"
+		}
+		filter += fmt.Sprintf(";[d%d][r%d]%s", i, i, name)
+	}
+
+	args := []string{"-hide_banner", "-nostdin", "-nostats"}
+	if progress != nil {
+		args = append(args, "-progress", "pipe:1")
+	}
+	args = append(args,
+		"-i", output,
+		"-i", original,
+		"-lavfi", filter,
+		"-f", "null", "-",
+	)
+
+	var stderr bytes.Buffer
+	cmd := command(ctx, s.config.FFmpegPath, args...)
+	cmd.Stderr = logOutput(ctx, &stderr)
+	var err error
+	if progress == nil {
+		cmd.Stdout = logOutput(ctx, nil)
+		err = cmd.Run()
+	} else {
+		err = runWithProgress(cmd, progress)
+	}
+	if err != nil {
+		var tail tailBuffer
+		tail.Write(stderr.Bytes())
+		return nil, fmt.Errorf("%w: %s", err, tail.String())
+	}
+
+	scores := make(map[string]float64, n)
+	for _, m := range metrics {
+		match := metricPatterns[m].FindStringSubmatch(stderr.String())
+		if match == nil {
+			return nil, fmt.Errorf("ffmpeg reported no %s score", m)
+		}
+		if strings.EqualFold(match[1], "inf") {
+			scores[m] = maxPSNR
+			continue
+		}
+		v, err := strconv.ParseFloat(match[1], 64)
+		if err != nil {
+			return nil, err
+		}
+		scores[m] = v
+	}
+	return scores, nil
+}
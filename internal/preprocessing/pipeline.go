@@ -0,0 +1,454 @@
+package preprocessing
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"log"
+	"mime"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/adimail/asset-manager/ent"
+	"github.com/adimail/asset-manager/ent/asset"
+	"github.com/adimail/asset-manager/ent/schema"
+	"github.com/adimail/asset-manager/ent/variant"
+	"github.com/adimail/asset-manager/internal/config"
+)
+
+// PipelineStep is one operation of a pipeline job.
+type PipelineStep = schema.PipelineStep
+
+// Pipeline operations.
+const (
+	OpStripMetadata = "strip_metadata"
+	OpAutoRotate    = "auto_rotate"
+	OpResize        = "resize"
+	OpConvert       = "convert"
+	OpCompress      = "compress"
+	OpThumbnail     = "thumbnail"
+	OpWatermark     = "watermark"
+)
+
+// VariantThumbnail marks the preview image generated by a pipeline.
+const VariantThumbnail = "thumbnail"
+
+const defaultThumbnailSize = 256
+
+// stepFileTypes lists the asset types each operation applies to.
+var stepFileTypes = map[string][]string{
+	OpStripMetadata: {"image", "video", "audio"},
+	OpAutoRotate:    {"image", "video"},
+	OpResize:        {"image", "video"},
+	OpConvert:       {"image"},
+	OpCompress:      {"image", "video", "audio"},
+	OpThumbnail:     {"image", "video"},
+	OpWatermark:     {"image", "video"},
+}
+
+// ValidateSteps checks a pipeline definition for an asset of the given type
+// before it is queued.
+func ValidateSteps(fileType string, steps []PipelineStep) error {
+	if len(steps) == 0 {
+		return fmt.Errorf("pipeline has no steps")
+	}
+	for i, st := range steps {
+		if types, ok := stepFileTypes[st.Op]; ok && !slices.Contains(types, fileType) {
+			return fmt.Errorf("step %d: %s does not apply to %s assets", i+1, st.Op, fileType)
+		}
+		switch st.Op {
+		case OpStripMetadata, OpAutoRotate, OpCompress, OpThumbnail:
+		case OpResize:
+			if st.Size <= 0 {
+				return fmt.Errorf("step %d: resize needs a positive size", i+1)
+			}
+		case OpConvert:
+			if !IsImageFormat(st.Format) {
+				return fmt.Errorf("step %d: unsupported format: %s", i+1, st.Format)
+			}
+		case OpWatermark:
+			if err := validateWatermark(st); err != nil {
+				return fmt.Errorf("step %d: %w", i+1, err)
+			}
+		default:
+			return fmt.Errorf("step %d: unknown op: %s", i+1, st.Op)
+		}
+		if st.Quality < 0 || st.Quality > 100 {
+			return fmt.Errorf("step %d: quality must be between 0 and 100", i+1)
+		}
+	}
+	return nil
+}
+
+// runPipeline runs a pipeline job's steps in a private work directory, each
+// step reading the previous step's output. The asset is only touched once
+// every step has succeeded, so a failed or cancelled pipeline changes nothing.
+func (s *Service) runPipeline(ctx context.Context, job *ent.CompressionJob, a *ent.Asset, progress *progressTracker) error {
+	profile, err := s.profile(job.Profile)
+	if err != nil {
+		return err
+	}
+	jobLogFrom(ctx).setParams(profile)
+
+	// A retried job starts over, so earlier step results are discarded.
+	steps := make([]PipelineStep, len(job.Steps))
+	for i, st := range job.Steps {
+		st.Status, st.Error, st.OutputBytes = string(StatusPending), "", 0
+		st.StartedAt, st.CompletedAt = nil, nil
+		steps[i] = st
+	}
+
+	work := strings.TrimSuffix(a.StoragePath, filepath.Ext(a.StoragePath)) + "_work_" + job.ID
+	os.RemoveAll(work)
+	if err := os.MkdirAll(work, 0o755); err != nil {
+		return err
+	}
+	defer os.RemoveAll(work)
+
+	current := a.StoragePath
+	variants := make(map[string]string) // kind -> output
+	progress.start(s.probeDuration(ctx, current), len(steps))
+
+	for i := range steps {
+		st := &steps[i]
+		progress.pass(i)
+		st.Status = string(StatusProcessing)
+		st.StartedAt = timePtr(time.Now())
+		s.saveSteps(ctx, job, steps)
+
+		out, err := s.runStep(ctx, st, a.FileType, current, filepath.Join(work, "step"+strconv.Itoa(i+1)), profile, progress)
+		if err == nil {
+			err = ctx.Err()
+		}
+		st.CompletedAt = timePtr(time.Now())
+		if err != nil {
+			st.Status = string(StatusFailed)
+			if ctx.Err() != nil {
+				st.Status = string(StatusCancelled)
+			}
+			st.Error = err.Error()
+			s.saveSteps(ctx, job, steps)
+			return fmt.Errorf("step %d (%s): %w", i+1, st.Op, err)
+		}
+
+		if info, err := os.Stat(out); err == nil {
+			st.OutputBytes = info.Size()
+		}
+		if kind := variantKind(st); kind != "" {
+			variants[kind] = out
+		} else {
+			current = out
+		}
+		st.Status = string(StatusCompleted)
+		s.saveSteps(ctx, job, steps)
+	}
+
+	ctx, err = commit(ctx)
+	if err != nil {
+		return err
+	}
+	var stripped, compressed bool
+	for _, st := range steps {
+		stripped = stripped || st.Op == OpStripMetadata
+		compressed = compressed || st.Op == OpCompress || st.Op == OpConvert || st.Op == OpResize
+	}
+	return s.commitPipeline(ctx, a, work, current, variants, stripped, compressed)
+}
+
+// variantKind returns the kind of variant a step produces, or "" for steps
+// whose output becomes the input of the next step.
+func variantKind(st *PipelineStep) string {
+	switch {
+	case st.Op == OpThumbnail:
+		return VariantThumbnail
+	case st.Op == OpWatermark && st.Output != WatermarkReplace:
+		return VariantWatermarked
+	}
+	return ""
+}
+
+// variantSuffixes name the files variants are stored in, next to the asset.
+var variantSuffixes = map[string]string{
+	VariantThumbnail:   "_thumb",
+	VariantWatermarked: "_watermarked",
+}
+
+func (s *Service) saveSteps(ctx context.Context, job *ent.CompressionJob, steps []PipelineStep) {
+	if _, err := s.updateOwned(job).SetSteps(steps).Save(context.WithoutCancel(ctx)); err != nil {
+		log.Printf("[Job %s] Failed to save step status: %v", job.ID, err)
+	}
+}
+
+// runStep runs a single step on input and returns the file it produced.
+// Steps with nothing to do return input unchanged. out is the output path
+// without an extension.
+func (s *Service) runStep(ctx context.Context, st *PipelineStep, fileType, input, out string, profile config.Profile, progress *progressTracker) (string, error) {
+	ext := strings.ToLower(filepath.Ext(input))
+	if st.Quality > 0 {
+		profile.ImageQuality = st.Quality
+	}
+
+	switch st.Op {
+	case OpStripMetadata:
+		out += ext
+		return out, s.stripFile(ctx, input, out, progress)
+
+	case OpAutoRotate:
+		// Only JPEGs carry an orientation the other steps would not
+		// apply; ffmpeg rotates videos itself when re-encoding.
+		if ext != ".jpg" && ext != ".jpeg" {
+			return input, nil
+		}
+		data, err := os.ReadFile(input)
+		if err != nil {
+			return "", err
+		}
+		orientation := jpegOrientation(data)
+		if orientation < 2 || orientation > 8 {
+			return input, nil
+		}
+		img, _, err := image.Decode(bytes.NewReader(data))
+		if err != nil {
+			return "", permanent(fmt.Errorf("decode: %w", err))
+		}
+		out += ext
+		return out, writeImage(out, orient(img, orientation), "jpeg", profile.ImageQuality)
+
+	case OpResize:
+		profile.ImageMaxSize = st.Size
+		profile.MaxWidth, profile.MaxHeight = st.Size, st.Size
+		out += ext
+		return out, s.encode(ctx, input, out, encodeOptions{FileType: fileType, Profile: profile}, progress)
+
+	case OpConvert:
+		if fileType != "image" {
+			return "", permanent(fmt.Errorf("convert only applies to images"))
+		}
+		if "."+st.Format == ext {
+			return input, nil
+		}
+		out += "." + st.Format
+		return out, s.encode(ctx, input, out, encodeOptions{FileType: fileType, Format: st.Format, Profile: profile}, progress)
+
+	case OpCompress:
+		opts := encodeOptions{FileType: fileType, Profile: profile}
+		if fileType == "audio" {
+			codec, ok := audioCodecs[profile.AudioCodec]
+			if !ok {
+				return "", permanent(fmt.Errorf("unsupported audio codec: %s", profile.AudioCodec))
+			}
+			opts.Format = profile.AudioCodec
+			ext = codec.Extension
+		}
+		out += ext
+		return out, s.encode(ctx, input, out, opts, progress)
+
+	case OpWatermark:
+		out += ext
+		return out, s.watermark(ctx, st, fileType, input, out, profile, progress)
+
+	case OpThumbnail:
+		size := st.Size
+		if size <= 0 {
+			size = defaultThumbnailSize
+		}
+		out = filepath.Join(filepath.Dir(out), "thumbnail.jpg")
+		return out, s.thumbnail(ctx, fileType, input, out, size)
+	}
+
+	return "", permanent(fmt.Errorf("unknown op: %s", st.Op))
+}
+
+// thumbnail writes a JPEG preview of input fitting within size pixels.
+// JPEG and PNG images are handled in Go; anything else, including video
+// frames, needs ffmpeg.
+func (s *Service) thumbnail(ctx context.Context, fileType, input, output string, size int) error {
+	ext := strings.ToLower(filepath.Ext(input))
+	if fileType == "image" && (ext == ".jpg" || ext == ".jpeg" || ext == ".png") {
+		data, err := os.ReadFile(input)
+		if err != nil {
+			return err
+		}
+		img, format, err := image.Decode(bytes.NewReader(data))
+		if err != nil {
+			return permanent(fmt.Errorf("decode: %w", err))
+		}
+		if format == "jpeg" {
+			img = orient(img, jpegOrientation(data))
+		}
+		return writeImage(output, fit(img, size), "jpeg", 80)
+	}
+
+	if fileType != "image" && fileType != "video" {
+		return permanent(fmt.Errorf("thumbnails need an image or video asset"))
+	}
+	if !s.hasProcessor(ProcessorFFmpeg) {
+		return permanent(fmt.Errorf("thumbnails of %s files require ffmpeg", ext))
+	}
+	return s.execFFmpeg(ctx, []string{
+		"-i", input,
+		"-vf", fmt.Sprintf("thumbnail,scale='min(iw,%[1]d)':'min(ih,%[1]d)':force_original_aspect_ratio=decrease", size),
+		"-frames:v", "1",
+		"-y", output,
+	}, nil)
+}
+
+// commitPipeline moves a pipeline's outputs into place and records them in a
+// single transaction. If anything fails, the files are moved back so the
+// asset is left exactly as it was. Only a pipeline that compressed, converted
+// or resized the asset marks it compressed; one that only stripped or rotated
+// it can still be compressed later.
+func (s *Service) commitPipeline(ctx context.Context, a *ent.Asset, work, final string, variants map[string]string, stripped, compressed bool) error {
+	ext := filepath.Ext(a.StoragePath)
+	base := strings.TrimSuffix(a.StoragePath, ext)
+
+	var moves fileMoves
+	// Files being replaced are parked in the work directory, which is
+	// removed once the pipeline returns.
+	park := func(path string) error {
+		if _, err := os.Stat(path); err != nil {
+			return nil
+		}
+		return moves.move(path, filepath.Join(work, "replaced_"+filepath.Base(path)))
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	fail := func(err error) error {
+		tx.Rollback()
+		moves.rollback()
+		return err
+	}
+
+	if final != a.StoragePath {
+		finalExt := strings.ToLower(filepath.Ext(final))
+		primaryPath := base + finalExt
+
+		// The first processing run keeps the upload as the original; later
+		// runs replace the current file and keep the existing original.
+		backupPath := a.OriginalPath
+		if backupPath == "" {
+			backupPath = base + "_original" + ext
+			if err := moves.move(a.StoragePath, backupPath); err != nil {
+				return fail(err)
+			}
+		} else if err := park(a.StoragePath); err != nil {
+			return fail(err)
+		}
+		if err := park(primaryPath); err != nil {
+			return fail(err)
+		}
+		if err := moves.move(final, primaryPath); err != nil {
+			return fail(err)
+		}
+
+		infoOrig, err := os.Stat(backupPath)
+		if err != nil {
+			return fail(err)
+		}
+		infoFinal, err := os.Stat(primaryPath)
+		if err != nil {
+			return fail(err)
+		}
+
+		mimeType := imageFormats[strings.TrimPrefix(finalExt, ".")]
+		if mimeType == "" {
+			mimeType = mime.TypeByExtension(finalExt)
+		}
+		filename := strings.TrimSuffix(a.OriginalFilename, filepath.Ext(a.OriginalFilename)) + finalExt
+
+		update := tx.Asset.UpdateOneID(a.ID).
+			SetOriginalFilename(filename).
+			SetExtension(finalExt).
+			SetMimeType(mimeType).
+			SetStoragePath(primaryPath).
+			SetOriginalPath(backupPath).
+			SetOriginalKeptAt(time.Now()).
+			SetFileSizeBytes(infoFinal.Size()).
+			SetMetadataStripped(a.MetadataStripped || stripped)
+		if compressed {
+			update.SetIsCompressed(true).
+				SetAlreadyOptimal(false).
+				SetCompressedAt(time.Now()).
+				SetCompressionRatio(float64(infoFinal.Size()) / float64(infoOrig.Size()))
+		}
+		if err := update.Exec(ctx); err != nil {
+			return fail(err)
+		}
+	}
+
+	kinds := make([]string, 0, len(variants))
+	for kind := range variants {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		out := variants[kind]
+		variantExt := strings.ToLower(filepath.Ext(out))
+		variantPath := base + variantSuffixes[kind] + variantExt
+		if err := park(variantPath); err != nil {
+			return fail(err)
+		}
+		if err := moves.move(out, variantPath); err != nil {
+			return fail(err)
+		}
+		info, err := os.Stat(variantPath)
+		if err != nil {
+			return fail(err)
+		}
+
+		// A rerun replaces the variant of the same kind, whatever its
+		// format was.
+		replaced, err := tx.Variant.Query().
+			Where(variant.Kind(kind), variant.HasAssetWith(asset.ID(a.ID))).
+			All(ctx)
+		if err != nil {
+			return fail(err)
+		}
+		for _, v := range replaced {
+			if v.StoragePath == variantPath {
+				continue
+			}
+			if err := park(v.StoragePath); err != nil {
+				return fail(err)
+			}
+		}
+		_, err = tx.Variant.Delete().
+			Where(variant.Kind(kind), variant.HasAssetWith(asset.ID(a.ID))).
+			Exec(ctx)
+		if err != nil {
+			return fail(err)
+		}
+		mimeType := imageFormats[strings.TrimPrefix(variantExt, ".")]
+		if mimeType == "" {
+			mimeType = mime.TypeByExtension(variantExt)
+		}
+		err = tx.Variant.Create().
+			SetAssetID(a.ID).
+			SetKind(kind).
+			SetExtension(variantExt).
+			SetMimeType(mimeType).
+			SetFileSizeBytes(info.Size()).
+			SetStoragePath(variantPath).
+			Exec(ctx)
+		if err != nil {
+			return fail(err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		moves.rollback()
+		return err
+	}
+
+	log.Printf("[Asset %s] Pipeline applied", a.ID)
+	return nil
+}
@@ -0,0 +1,140 @@
+package preprocessing
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+)
+
+// Worker pools. Video encodes take orders of magnitude longer than image or
+// audio work, so they run on their own workers and cannot hold up the rest.
+const (
+	PoolImage = "image"
+	PoolVideo = "video"
+)
+
+// Job priorities. Higher runs first.
+const (
+	PriorityBulk        = 10
+	PriorityInteractive = 20
+)
+
+// poolFor returns the pool that runs jobs for assets of fileType.
+func poolFor(fileType string) string {
+	if fileType == "video" {
+		return PoolVideo
+	}
+	return PoolImage
+}
+
+type ctxKey int
+
+const (
+	submitterKey ctxKey = iota
+	priorityKey
+)
+
+// WithSubmitter tags jobs enqueued with ctx as submitted by name. Jobs of the
+// same priority are shared fairly between submitters.
+func WithSubmitter(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, submitterKey, name)
+}
+
+// WithPriority overrides the priority of jobs enqueued with ctx.
+func WithPriority(ctx context.Context, priority int) context.Context {
+	return context.WithValue(ctx, priorityKey, priority)
+}
+
+// workerPool is a resizable set of workers claiming jobs from one pool.
+type workerPool struct {
+	name string
+	wake chan struct{} // nudges idle workers when a job is enqueued
+
+	mu     sync.Mutex
+	stops  []chan struct{} // one per running worker
+	nextID int
+}
+
+func newWorkerPool(name string) *workerPool {
+	return &workerPool{name: name, wake: make(chan struct{}, 1)}
+}
+
+// resize starts or stops workers until n are running. Stopped workers
+// finish their current job first.
+func (s *Service) resize(p *workerPool, n int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for len(p.stops) < n {
+		stop := make(chan struct{})
+		p.stops = append(p.stops, stop)
+		s.wg.Add(1)
+		go s.worker(p, p.nextID, stop)
+		p.nextID++
+	}
+	for len(p.stops) > n {
+		last := len(p.stops) - 1
+		close(p.stops[last])
+		p.stops = p.stops[:last]
+	}
+}
+
+// Workers returns the number of workers in each pool.
+func (s *Service) Workers() map[string]int {
+	counts := make(map[string]int, len(s.pools))
+	for name, p := range s.pools {
+		p.mu.Lock()
+		counts[name] = len(p.stops)
+		p.mu.Unlock()
+	}
+	return counts
+}
+
+// SetWorkers changes the number of workers in a pool while the service runs.
+func (s *Service) SetWorkers(pool string, n int) error {
+	if !s.config.Enabled {
+		return fmt.Errorf("compression is disabled")
+	}
+	if !s.config.RunWorkers {
+		return fmt.Errorf("this process does not run compression workers")
+	}
+	p, ok := s.pools[pool]
+	if !ok {
+		return fmt.Errorf("unknown worker pool: %s", pool)
+	}
+	if n < 0 {
+		return fmt.Errorf("worker count cannot be negative")
+	}
+	if n > s.config.MaxWorkers {
+		return fmt.Errorf("worker count cannot exceed %d", s.config.MaxWorkers)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stopping {
+		return fmt.Errorf("compression workers are shutting down")
+	}
+	s.resize(p, n)
+	log.Printf("Worker pool %s resized to %d", pool, n)
+	return nil
+}
+
+// notify wakes one idle worker of the pool without blocking.
+func (s *Service) notify(pool string) {
+	p, ok := s.pools[pool]
+	if !ok {
+		return
+	}
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+}
+
+// notifyAll wakes an idle worker in every pool.
+func (s *Service) notifyAll() {
+	for name := range s.pools {
+		s.notify(name)
+	}
+}
@@ -0,0 +1,210 @@
+package preprocessing
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/adimail/asset-manager/ent"
+)
+
+const (
+	exifGPSPointer = 0x8825
+	xmpGPSProperty = "GPSLatitude"
+)
+
+// StripMetadata removes EXIF, XMP and GPS metadata from the file at path,
+// in place. JPEG orientation is kept.
+func (s *Service) StripMetadata(ctx context.Context, path string) error {
+	ext := filepath.Ext(path)
+	tmp := strings.TrimSuffix(path, ext) + "_stripped" + ext
+	if err := s.stripFile(ctx, path, tmp, nil); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// stripFile writes input to output without its metadata. JPEG and PNG are
+// handled in Go; other formats are remuxed by ffmpeg without re-encoding.
+func (s *Service) stripFile(ctx context.Context, input, output string, progress *progressTracker) error {
+	ext := strings.ToLower(filepath.Ext(input))
+	if ext == ".jpg" || ext == ".jpeg" || ext == ".png" {
+		return stripMetadata(input, output)
+	}
+	if !s.hasProcessor(ProcessorFFmpeg) {
+		return permanent(fmt.Errorf("stripping metadata from %s files requires ffmpeg", ext))
+	}
+	return s.execFFmpeg(ctx, []string{
+		"-i", input,
+		"-map_metadata", "-1",
+		// Video stream tags carry the rotation on older ffmpeg builds.
+		"-map_metadata:s:v", "0:s:v",
+		"-c", "copy",
+		"-y", output,
+	}, progress)
+}
+
+// stripAsset runs a strip job. The kept original is stripped too, so the
+// location does not survive in a file that can still be downloaded. Both
+// files are stripped into temporary files first and only replace the
+// asset's files past the commit point.
+func (s *Service) stripAsset(ctx context.Context, a *ent.Asset, progress *progressTracker) error {
+	progress.start(0, 1)
+	paths := []string{a.StoragePath}
+	if a.OriginalPath != "" {
+		if _, err := os.Stat(a.OriginalPath); err == nil {
+			paths = append(paths, a.OriginalPath)
+		}
+	}
+
+	var temps []string
+	cleanup := func() {
+		for _, tmp := range temps {
+			os.Remove(tmp)
+		}
+	}
+	for _, path := range paths {
+		ext := filepath.Ext(path)
+		tmp := strings.TrimSuffix(path, ext) + "_stripped" + ext
+		temps = append(temps, tmp)
+		if err := s.stripFile(ctx, path, tmp, nil); err != nil {
+			cleanup()
+			return err
+		}
+	}
+
+	ctx, err := commit(ctx)
+	if err != nil {
+		cleanup()
+		return err
+	}
+
+	info, err := os.Stat(temps[0])
+	if err != nil {
+		cleanup()
+		return err
+	}
+
+	// Park each file before moving its stripped copy in, so a failed update
+	// can put the unstripped files back.
+	var moves fileMoves
+	var parked []string
+	for i, path := range paths {
+		ext := filepath.Ext(path)
+		park := strings.TrimSuffix(path, ext) + "_unstripped" + ext
+		if err = moves.move(path, park); err != nil {
+			break
+		}
+		parked = append(parked, park)
+		if err = moves.move(temps[i], path); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = s.client.Asset.UpdateOneID(a.ID).
+			SetMetadataStripped(true).
+			SetFileSizeBytes(info.Size()).
+			Exec(ctx)
+	}
+	if err != nil {
+		moves.rollback()
+		cleanup()
+		return err
+	}
+	for _, park := range parked {
+		os.Remove(park)
+	}
+
+	log.Printf("[Asset %s] Metadata stripped", a.ID)
+	return nil
+}
+
+// refreshLocation re-checks an asset's file for location data after a job
+// may have replaced it.
+func (s *Service) refreshLocation(ctx context.Context, assetID string) {
+	a, err := s.client.Asset.Get(ctx, assetID)
+	if err != nil {
+		return
+	}
+	if has := s.HasLocation(ctx, a.StoragePath); has != a.HasLocation {
+		if err := a.Update().SetHasLocation(has).Exec(ctx); err != nil {
+			log.Printf("[Asset %s] Failed to update location flag: %v", a.ID, err)
+		}
+	}
+}
+
+// HasLocation reports whether the file at path carries GPS coordinates.
+// JPEG and PNG are parsed in Go, checking EXIF and XMP; other media are
+// checked for location tags with ffprobe when it is available.
+func (s *Service) HasLocation(ctx context.Context, path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".jpg", ".jpeg":
+		data, err := os.ReadFile(path)
+		return err == nil && jpegHasLocation(data)
+	case ".png":
+		data, err := os.ReadFile(path)
+		return err == nil && pngHasLocation(data)
+	}
+	if !s.hasProcessor(ProcessorFFmpeg) {
+		return false
+	}
+	return s.probeLocation(ctx, path)
+}
+
+func jpegHasLocation(data []byte) bool {
+	if _, ok := exifTag(jpegEXIF(data), exifGPSPointer); ok {
+		return true
+	}
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return false
+	}
+	for i := 2; i+4 <= len(data); {
+		marker := data[i+1]
+		if data[i] != 0xFF || marker == 0xDA || marker == 0xD9 {
+			return false
+		}
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+		end := i + 2 + length
+		if length < 2 || end > len(data) {
+			return false
+		}
+		if marker == 0xE1 && bytes.Contains(data[i+4:end], []byte(xmpGPSProperty)) {
+			return true
+		}
+		i = end
+	}
+	return false
+}
+
+func pngHasLocation(data []byte) bool {
+	const signature = "\x89PNG\r\n\x1a\n"
+	if !bytes.HasPrefix(data, []byte(signature)) {
+		return false
+	}
+	for i := len(signature); i+12 <= len(data); {
+		length := int64(binary.BigEndian.Uint32(data[i:]))
+		if length > int64(len(data)-i-12) {
+			return false
+		}
+		end := i + 12 + int(length)
+		chunk, payload := string(data[i+4:i+8]), data[i+8:end-4]
+		switch {
+		case chunk == "eXIf":
+			if _, ok := exifTag(payload, exifGPSPointer); ok {
+				return true
+			}
+		case pngMetadataChunks[chunk]:
+			if bytes.Contains(payload, []byte(xmpGPSProperty)) {
+				return true
+			}
+		}
+		i = end
+	}
+	return false
+}
@@ -0,0 +1,95 @@
+package preprocessing
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// probeTimeout bounds a single ffprobe run; probing reads only the headers.
+const probeTimeout = 30 * time.Second
+
+// probeDimensions returns the width and height of the first video stream.
+func (s *Service) probeDimensions(ctx context.Context, input string) (int, int, error) {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+
+	out, err := command(ctx, s.config.FFprobePath,
+		"-v", "error",
+		"-select_streams", "v:0",
+		"-show_entries", "stream=width,height",
+		"-of", "csv=p=0:s=x",
+		input,
+	).Output()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	w, h, ok := strings.Cut(strings.TrimSpace(string(out)), "x")
+	if !ok {
+		return 0, 0, fmt.Errorf("unexpected ffprobe output %q", out)
+	}
+	width, err := strconv.Atoi(w)
+	if err != nil {
+		return 0, 0, err
+	}
+	height, err := strconv.Atoi(h)
+	if err != nil {
+		return 0, 0, err
+	}
+	if width <= 0 || height <= 0 {
+		return 0, 0, fmt.Errorf("invalid dimensions %dx%d", width, height)
+	}
+	return width, height, nil
+}
+
+// probeDuration returns the media duration in seconds, or 0 when it cannot be
+// determined (still images, probe failures).
+func (s *Service) probeDuration(ctx context.Context, input string) float64 {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+
+	out, err := command(ctx, s.config.FFprobePath,
+		"-v", "error",
+		"-show_entries", "format=duration",
+		"-of", "default=noprint_wrappers=1:nokey=1",
+		input,
+	).Output()
+	if err != nil {
+		return 0
+	}
+
+	d, err := strconv.ParseFloat(strings.TrimSpace(string(out)), 64)
+	if err != nil || d < 0 {
+		return 0
+	}
+	return d
+}
+
+// probeLocation reports whether the container or its streams carry a
+// location tag, as phones write into videos (e.g. "location" or
+// "com.apple.quicktime.location.ISO6709").
+func (s *Service) probeLocation(ctx context.Context, input string) bool {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+
+	out, err := command(ctx, s.config.FFprobePath,
+		"-v", "error",
+		"-show_entries", "format_tags:stream_tags",
+		"-of", "default=noprint_wrappers=1",
+		input,
+	).Output()
+	if err != nil {
+		return false
+	}
+
+	for _, line := range strings.Split(string(out), "\n") {
+		key, _, _ := strings.Cut(strings.ToLower(line), "=")
+		if strings.Contains(key, "location") || strings.Contains(key, "gps") {
+			return true
+		}
+	}
+	return false
+}
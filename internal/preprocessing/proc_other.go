@@ -0,0 +1,9 @@
+//go:build !unix
+
+package preprocessing
+
+import "os/exec"
+
+// killProcessTree is a no-op where process groups are unavailable; the
+// context still kills the process itself.
+func killProcessTree(cmd *exec.Cmd) {}
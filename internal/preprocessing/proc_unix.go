@@ -0,0 +1,18 @@
+//go:build unix
+
+package preprocessing
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// killProcessTree makes cmd run in its own process group and, when its
+// context ends, kills the whole group, so helpers ffmpeg spawned do not
+// outlive it.
+func killProcessTree(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+}
@@ -0,0 +1,91 @@
+package preprocessing
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os/exec"
+	"strings"
+)
+
+// processor is an encoding backend. The service tries its processors in the
+// configured order and uses the first one that supports an encode.
+type processor interface {
+	name() string
+	supports(input string, opts encodeOptions) bool
+	encode(ctx context.Context, input, output string, opts encodeOptions, progress *progressTracker) error
+}
+
+const (
+	ProcessorFFmpeg = "ffmpeg"
+	ProcessorGo     = "go"
+)
+
+// newProcessors builds the processors named in the configured order, leaving
+// out those that cannot run on this machine.
+func (s *Service) newProcessors() []processor {
+	var processors []processor
+	for _, name := range s.config.Processors {
+		name = strings.TrimSpace(name)
+		switch name {
+		case ProcessorFFmpeg:
+			if _, err := exec.LookPath(s.config.FFmpegPath); err != nil {
+				log.Printf("ffmpeg processor disabled: %v", err)
+				continue
+			}
+			processors = append(processors, ffmpegProcessor{s})
+		case ProcessorGo:
+			processors = append(processors, goImageProcessor{})
+		default:
+			log.Printf("Ignoring unknown compression processor %q", name)
+		}
+	}
+	return processors
+}
+
+// encode runs a single encode on the first processor that supports it.
+func (s *Service) encode(ctx context.Context, input, output string, opts encodeOptions, progress *progressTracker) error {
+	for _, p := range s.processors {
+		if !p.supports(input, opts) {
+			continue
+		}
+		if p.name() != ProcessorFFmpeg {
+			// ffmpeg runs are logged with their command line.
+			logf(ctx, "# %s: encode %s to %s, format %q, quality %d, max size %d, crop %d",
+				p.name(), input, output, opts.Format, opts.Profile.ImageQuality, opts.Profile.ImageMaxSize, opts.Crop)
+		}
+		if err := p.encode(ctx, input, output, opts, progress); err != nil {
+			return fmt.Errorf("%s: %w", p.name(), err)
+		}
+		return nil
+	}
+
+	kind := opts.FileType
+	if opts.Format != "" {
+		kind += "/" + opts.Format
+	}
+	return permanent(fmt.Errorf("no processor available for %s", kind))
+}
+
+// hasProcessor reports whether the named processor is enabled and available.
+func (s *Service) hasProcessor(name string) bool {
+	for _, p := range s.processors {
+		if p.name() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ffmpegProcessor shells out to ffmpeg and handles every file type.
+type ffmpegProcessor struct {
+	s *Service
+}
+
+func (ffmpegProcessor) name() string { return ProcessorFFmpeg }
+
+func (ffmpegProcessor) supports(string, encodeOptions) bool { return true }
+
+func (p ffmpegProcessor) encode(ctx context.Context, input, output string, opts encodeOptions, progress *progressTracker) error {
+	return p.s.runFFmpeg(ctx, input, output, opts, progress)
+}
@@ -0,0 +1,127 @@
+package preprocessing
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/adimail/asset-manager/internal/config"
+)
+
+// fakeProcessor supports the file types it lists and records its encodes.
+type fakeProcessor struct {
+	id    string
+	types []string
+	err   error
+	calls *[]string
+}
+
+func (p fakeProcessor) name() string { return p.id }
+
+func (p fakeProcessor) supports(_ string, opts encodeOptions) bool {
+	for _, t := range p.types {
+		if t == opts.FileType {
+			return true
+		}
+	}
+	return false
+}
+
+func (p fakeProcessor) encode(context.Context, string, string, encodeOptions, *progressTracker) error {
+	*p.calls = append(*p.calls, p.id)
+	return p.err
+}
+
+func TestEncodeUsesFirstSupportingProcessor(t *testing.T) {
+	var calls []string
+	s := &Service{processors: []processor{
+		fakeProcessor{id: "video-only", types: []string{"video"}, calls: &calls},
+		fakeProcessor{id: "first", types: []string{"image"}, calls: &calls},
+		fakeProcessor{id: "second", types: []string{"image", "video"}, calls: &calls},
+	}}
+
+	tests := []struct {
+		fileType string
+		want     string
+	}{
+		{"image", "first"},
+		{"video", "video-only"},
+	}
+	for _, tt := range tests {
+		calls = nil
+		if err := s.encode(context.Background(), "in", "out", encodeOptions{FileType: tt.fileType}, nil); err != nil {
+			t.Fatalf("%s: %v", tt.fileType, err)
+		}
+		if want := []string{tt.want}; !reflect.DeepEqual(calls, want) {
+			t.Errorf("%s: ran %v, want %v", tt.fileType, calls, want)
+		}
+	}
+}
+
+func TestEncodeDoesNotFallBackOnFailure(t *testing.T) {
+	var calls []string
+	s := &Service{processors: []processor{
+		fakeProcessor{id: "first", types: []string{"image"}, err: errors.New("boom"), calls: &calls},
+		fakeProcessor{id: "second", types: []string{"image"}, calls: &calls},
+	}}
+
+	err := s.encode(context.Background(), "in", "out", encodeOptions{FileType: "image"}, nil)
+	if err == nil || err.Error() != "first: boom" {
+		t.Errorf("encode() = %v, want first: boom", err)
+	}
+	if want := []string{"first"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("ran %v, want %v", calls, want)
+	}
+}
+
+func TestEncodeWithoutSupportingProcessor(t *testing.T) {
+	var calls []string
+	s := &Service{processors: []processor{
+		fakeProcessor{id: "images", types: []string{"image"}, calls: &calls},
+	}}
+
+	err := s.encode(context.Background(), "in", "out", encodeOptions{FileType: "audio", Format: "opus"}, nil)
+	var perm *permanentError
+	if !errors.As(err, &perm) || !strings.Contains(err.Error(), "audio/opus") {
+		t.Errorf("encode() = %v, want a permanent error naming audio/opus", err)
+	}
+	if len(calls) != 0 {
+		t.Errorf("ran %v", calls)
+	}
+}
+
+func TestNewProcessorsOrder(t *testing.T) {
+	ffmpeg := filepath.Join(t.TempDir(), "ffmpeg")
+	if err := os.WriteFile(ffmpeg, []byte("#!/bin/sh\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		processors []string
+		ffmpegPath string
+		want       []string
+	}{
+		{"ffmpeg first", []string{"ffmpeg", "go"}, ffmpeg, []string{ProcessorFFmpeg, ProcessorGo}},
+		{"go first", []string{"go", " ffmpeg"}, ffmpeg, []string{ProcessorGo, ProcessorFFmpeg}},
+		{"ffmpeg missing", []string{"ffmpeg", "go"}, filepath.Join(t.TempDir(), "missing"), []string{ProcessorGo}},
+		{"unknown skipped", []string{"magick", "go"}, ffmpeg, []string{ProcessorGo}},
+		{"none", nil, ffmpeg, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{config: config.CompressionConfig{Processors: tt.processors, FFmpegPath: tt.ffmpegPath}}
+			var got []string
+			for _, p := range s.newProcessors() {
+				got = append(got, p.name())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("newProcessors() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
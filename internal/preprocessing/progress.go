@@ -0,0 +1,82 @@
+package preprocessing
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/adimail/asset-manager/ent"
+)
+
+// progressTracker turns ffmpeg's out_time reports into CompressionJob
+// progress. A job may run several ffmpeg passes over the same input (HLS
+// renditions, a conversion plus its fallback), each counting as an equal
+// share of the total. Database writes are throttled to ProgressInterval.
+type progressTracker struct {
+	s        *Service
+	job      *ent.CompressionJob
+	duration float64 // seconds; 0 disables reporting
+	passes   int
+	current  int
+	reported int
+	lastSave time.Time
+}
+
+func (s *Service) newProgressTracker(job *ent.CompressionJob) *progressTracker {
+	return &progressTracker{s: s, job: job, passes: 1}
+}
+
+// start sets the input duration and the number of ffmpeg passes to expect.
+func (p *progressTracker) start(duration float64, passes int) {
+	if p == nil {
+		return
+	}
+	if passes < 1 {
+		passes = 1
+	}
+	p.duration = duration
+	p.passes = passes
+	p.current = 0
+}
+
+// pass marks the beginning of the i-th (zero-based) ffmpeg pass.
+func (p *progressTracker) pass(i int) {
+	if p == nil {
+		return
+	}
+	p.current = i
+}
+
+// update records that the current pass has encoded up to seconds of the input.
+func (p *progressTracker) update(seconds float64) {
+	if p == nil || p.duration <= 0 {
+		return
+	}
+
+	fraction := seconds / p.duration
+	if fraction > 1 {
+		fraction = 1
+	}
+	if fraction < 0 {
+		fraction = 0
+	}
+
+	// 100 is reserved for a completed job.
+	percent := int((float64(p.current) + fraction) / float64(p.passes) * 100)
+	if percent > 99 {
+		percent = 99
+	}
+	if percent <= p.reported || time.Since(p.lastSave) < p.s.config.ProgressInterval {
+		return
+	}
+
+	_, err := p.s.updateOwned(p.job).
+		SetProgress(percent).
+		Save(context.Background())
+	if err != nil {
+		log.Printf("[Job %s] Failed to save progress: %v", p.job.ID, err)
+		return
+	}
+	p.reported = percent
+	p.lastSave = time.Now()
+}
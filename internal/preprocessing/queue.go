@@ -0,0 +1,284 @@
+package preprocessing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/adimail/asset-manager/ent"
+	"github.com/adimail/asset-manager/ent/asset"
+	"github.com/adimail/asset-manager/ent/compressionjob"
+	"github.com/adimail/asset-manager/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// The job queue lives in the compression_jobs table. A worker claims the
+// next pending job of its pool by flipping it to processing under a lease and
+// a worker ID unique to the claim, so a run that lost its lease cannot touch
+// a later claim of the same job, even from the same process. The lease is renewed while the job runs; a job whose
+// lease expires is assumed abandoned and goes back to pending. Only one job
+// of an asset runs at a time.
+
+func newWorkerID() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "worker"
+	}
+	return fmt.Sprintf("%s-%d", host, os.Getpid())
+}
+
+// claim takes ownership of the next job for pool. It returns nil when the
+// pool has nothing to run.
+func (s *Service) claim(ctx context.Context, pool string) (*ent.CompressionJob, error) {
+	for {
+		candidate, err := s.nextJob(ctx, pool)
+		if candidate == nil || err != nil {
+			return nil, err
+		}
+
+		now := time.Now()
+		// The status condition makes the claim atomic: if another worker got
+		// there first, nothing is updated and we move on to the next job.
+		n, err := s.client.CompressionJob.Update().
+			Where(
+				compressionjob.ID(candidate.ID),
+				compressionjob.StatusEQ(string(StatusPending)),
+				assetIdle(),
+			).
+			SetStatus(string(StatusProcessing)).
+			SetWorkerID(s.workerID + "/" + uuid.NewString()).
+			SetLeaseExpiresAt(now.Add(s.config.LeaseDuration)).
+			SetStartedAt(now).
+			AddAttempts(1).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			continue
+		}
+
+		// The asset is read only now that the job is ours, so it reflects
+		// every job that ran before.
+		return s.client.CompressionJob.Query().
+			Where(compressionjob.ID(candidate.ID)).
+			WithAsset().
+			Select(JobFields...).
+			Only(ctx)
+	}
+}
+
+// nextJob picks the job a worker of pool should run next: the highest
+// priority first, and within that priority the oldest job of the submitter
+// served least recently, so one large batch cannot starve everyone else.
+func (s *Service) nextJob(ctx context.Context, pool string) (*ent.CompressionJob, error) {
+	runnable := []predicate.CompressionJob{
+		compressionjob.StatusEQ(string(StatusPending)),
+		compressionjob.Or(
+			compressionjob.PoolEQ(pool),
+			compressionjob.PoolIsNil(),
+		),
+		compressionjob.Or(
+			compressionjob.NextAttemptAtIsNil(),
+			compressionjob.NextAttemptAtLTE(time.Now()),
+		),
+		assetIdle(),
+	}
+
+	top, err := s.client.CompressionJob.Query().
+		Where(runnable...).
+		Order(ent.Desc(compressionjob.FieldPriority), ent.Asc(compressionjob.FieldCreatedAt)).
+		Select(JobFields...).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	runnable = append(runnable, compressionjob.Priority(top.Priority))
+
+	submitters, err := s.client.CompressionJob.Query().
+		Where(runnable...).
+		GroupBy(compressionjob.FieldSubmitter).
+		Strings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(submitters) < 2 {
+		return top, nil
+	}
+
+	next := top.Submitter
+	var nextServed time.Time
+	for i, submitter := range submitters {
+		var served time.Time
+		last, err := s.client.CompressionJob.Query().
+			Where(
+				compressionjob.Submitter(submitter),
+				compressionjob.StartedAtNotNil(),
+			).
+			Order(ent.Desc(compressionjob.FieldStartedAt)).
+			Select(compressionjob.FieldStartedAt).
+			First(ctx)
+		if err == nil {
+			served = last.StartedAt
+		} else if !ent.IsNotFound(err) {
+			return nil, err
+		}
+		if i == 0 || served.Before(nextServed) {
+			next, nextServed = submitter, served
+		}
+	}
+
+	return s.client.CompressionJob.Query().
+		Where(append(runnable, compressionjob.Submitter(next))...).
+		Order(ent.Asc(compressionjob.FieldCreatedAt)).
+		Select(JobFields...).
+		First(ctx)
+}
+
+// assetIdle matches jobs whose asset has no job running.
+func assetIdle() predicate.CompressionJob {
+	return compressionjob.Not(compressionjob.HasAssetWith(
+		asset.HasCompressionJobsWith(compressionjob.StatusEQ(string(StatusProcessing))),
+	))
+}
+
+// updateOwned returns an update that only applies while the claim that
+// loaded job still holds its lease.
+func (s *Service) updateOwned(job *ent.CompressionJob) *ent.CompressionJobUpdate {
+	return s.client.CompressionJob.Update().
+		Where(
+			compressionjob.ID(job.ID),
+			compressionjob.WorkerID(job.WorkerID),
+			compressionjob.StatusEQ(string(StatusProcessing)),
+		)
+}
+
+// errLeaseLost is the cancellation cause of jobs whose lease expired, after
+// which another worker may have claimed them.
+var errLeaseLost = errors.New("lease lost")
+
+// heartbeat keeps the job's lease alive until the returned function is
+// called. It also watches for cancellation requests made through the database,
+// possibly by another process, and calls cancel when one arrives. When the
+// lease cannot be kept, cancel is called with errLeaseLost, so the job stops
+// before it commits over another worker's run.
+func (s *Service) heartbeat(job *ent.CompressionJob, cancel context.CancelCauseFunc) func() {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(s.config.PollInterval)
+		defer ticker.Stop()
+		lastRenewal := time.Now()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+
+			ctx := context.Background()
+			requested, err := s.client.CompressionJob.Query().
+				Where(compressionjob.ID(job.ID), compressionjob.CancelRequested(true)).
+				Exist(ctx)
+			if err == nil && requested {
+				cancel(nil)
+			}
+
+			if time.Since(lastRenewal) < s.config.LeaseDuration/3 {
+				continue
+			}
+			n, err := s.updateOwned(job).
+				SetLeaseExpiresAt(time.Now().Add(s.config.LeaseDuration)).
+				Save(ctx)
+			if err != nil {
+				log.Printf("[Job %s] Failed to renew lease: %v", job.ID, err)
+				if time.Since(lastRenewal) >= s.config.LeaseDuration {
+					cancel(errLeaseLost)
+					return
+				}
+				continue
+			}
+			if n == 0 {
+				// The reaper has taken the job back, or another worker
+				// has claimed it.
+				cancel(errLeaseLost)
+				return
+			}
+			lastRenewal = time.Now()
+		}
+	}()
+	return func() { close(done) }
+}
+
+// commit marks the point where a job starts moving its results into place.
+// A job cancelled or lost to another worker by then gets ctx's error and
+// must stop; otherwise the returned context ignores later cancellation, so
+// the files and rows are not left half updated.
+func commit(ctx context.Context) (context.Context, error) {
+	if err := ctx.Err(); err != nil {
+		return ctx, err
+	}
+	return context.WithoutCancel(ctx), nil
+}
+
+// recoverStale returns processing jobs whose lease has expired, or which
+// predate leases, to the pending state. Abandoned jobs that were asked to
+// cancel are marked cancelled instead.
+func (s *Service) recoverStale(ctx context.Context) (int, error) {
+	stale := compressionjob.And(
+		compressionjob.StatusEQ(string(StatusProcessing)),
+		compressionjob.Or(
+			compressionjob.LeaseExpiresAtLT(time.Now()),
+			compressionjob.LeaseExpiresAtIsNil(),
+		),
+	)
+
+	_, err := s.client.CompressionJob.Update().
+		Where(stale, compressionjob.CancelRequested(true)).
+		SetStatus(string(StatusCancelled)).
+		SetError("cancelled").
+		SetCompletedAt(time.Now()).
+		ClearWorkerID().
+		ClearLeaseExpiresAt().
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.client.CompressionJob.Update().
+		Where(stale).
+		SetStatus(string(StatusPending)).
+		ClearWorkerID().
+		ClearLeaseExpiresAt().
+		Save(ctx)
+}
+
+// reaper periodically requeues jobs abandoned by crashed workers.
+func (s *Service) reaper() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.config.LeaseDuration)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+		}
+
+		n, err := s.recoverStale(context.Background())
+		if err != nil {
+			log.Printf("Failed to recover stale compression jobs: %v", err)
+			continue
+		}
+		if n > 0 {
+			log.Printf("Requeued %d stale compression jobs", n)
+			s.notifyAll()
+		}
+	}
+}
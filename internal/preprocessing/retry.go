@@ -0,0 +1,95 @@
+package preprocessing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/adimail/asset-manager/ent"
+	"github.com/adimail/asset-manager/ent/asset"
+	"github.com/adimail/asset-manager/ent/compressionjob"
+)
+
+// permanentError marks a failure that retrying cannot fix, such as an
+// unsupported file type.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+func permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+// backoff returns the delay before retrying a job that has failed attempts
+// times: RetryBaseDelay doubled per attempt, capped at RetryMaxDelay.
+func (s *Service) backoff(attempts int) time.Duration {
+	delay := s.config.RetryBaseDelay
+	for i := 1; i < attempts && delay < s.config.RetryMaxDelay; i++ {
+		delay *= 2
+	}
+	if s.config.RetryMaxDelay > 0 && delay > s.config.RetryMaxDelay {
+		delay = s.config.RetryMaxDelay
+	}
+	return delay
+}
+
+// failJob records a failed attempt. The job goes back to the queue with a
+// backoff delay while it has attempts left and the error is not permanent.
+func (s *Service) failJob(ctx context.Context, job *ent.CompressionJob, err error) {
+	attemptErrors := append(job.AttemptErrors, fmt.Sprintf("attempt %d: %v", job.Attempts, err))
+	update := s.updateOwned(job).
+		SetError(err.Error()).
+		SetAttemptErrors(attemptErrors).
+		ClearWorkerID().
+		ClearLeaseExpiresAt()
+
+	var perm *permanentError
+	if job.Attempts < job.MaxAttempts && !errors.As(err, &perm) {
+		delay := s.backoff(job.Attempts)
+		log.Printf("[Job %s] Attempt %d/%d failed, retrying in %v: %v", job.ID, job.Attempts, job.MaxAttempts, delay, err)
+		update.
+			SetStatus(string(StatusPending)).
+			SetProgress(0).
+			SetNextAttemptAt(time.Now().Add(delay)).
+			Save(ctx)
+		return
+	}
+
+	log.Printf("[Job %s] Failed after %d attempts: %v", job.ID, job.Attempts, err)
+	update.
+		SetStatus(string(StatusFailed)).
+		ClearNextAttemptAt().
+		SetCompletedAt(time.Now()).
+		Save(ctx)
+}
+
+// RetryFailed puts failed jobs back on the queue with a fresh set of
+// attempts. When assetID is set only that asset's jobs are retried.
+func (s *Service) RetryFailed(ctx context.Context, assetID string) (int, error) {
+	update := s.client.CompressionJob.Update().
+		Where(compressionjob.StatusEQ(string(StatusFailed)))
+	if assetID != "" {
+		update.Where(compressionjob.HasAssetWith(asset.ID(assetID)))
+	}
+
+	n, err := update.
+		SetStatus(string(StatusPending)).
+		SetAttempts(0).
+		SetProgress(0).
+		ClearNextAttemptAt().
+		ClearCompletedAt().
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if n > 0 {
+		s.notifyAll()
+	}
+	return n, nil
+}
@@ -4,15 +4,15 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"mime"
 	"os"
-	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/adimail/asset-manager/ent"
-	"github.com/adimail/asset-manager/ent/asset"
 	"github.com/adimail/asset-manager/ent/compressionjob"
 	"github.com/adimail/asset-manager/internal/config"
 )
@@ -24,166 +24,704 @@ const (
 	StatusProcessing JobStatus = "processing"
 	StatusCompleted  JobStatus = "completed"
 	StatusFailed     JobStatus = "failed"
+	StatusCancelled  JobStatus = "cancelled"
 )
 
+// Outcomes recorded on completed compress jobs.
+const (
+	OutcomeCompressed     = "compressed"
+	OutcomeAlreadyOptimal = "already_optimal" // the output was discarded
+)
+
+// VariantFallback marks a variant kept in the source format for clients that
+// cannot decode the asset's converted primary file.
+const VariantFallback = "fallback"
+
 type Service struct {
-	client *ent.Client
-	config config.CompressionConfig
-	queue  chan string // Asset IDs
-	wg     sync.WaitGroup
+	client   *ent.Client
+	config   config.CompressionConfig
+	workerID string
+	pools    map[string]*workerPool
+	wg       sync.WaitGroup
+
+	processors []processor // available encoding backends, in preference order
+
+	mu       sync.Mutex
+	running  map[string]context.CancelCauseFunc // claim worker ID -> cancels its ffmpeg run
+	stopping bool
+	done     chan struct{} // closed on shutdown to stop the reaper
+
+	versionOnce sync.Once
+	version     string // ffmpeg -version, first line
 }
 
 func NewService(client *ent.Client, cfg config.CompressionConfig) *Service {
 	s := &Service{
-		client: client,
-		config: cfg,
-		queue:  make(chan string, 100),
+		client:   client,
+		config:   cfg,
+		workerID: newWorkerID(),
+		pools: map[string]*workerPool{
+			PoolImage: newWorkerPool(PoolImage),
+			PoolVideo: newWorkerPool(PoolVideo),
+		},
+		running: make(map[string]context.CancelCauseFunc),
+		done:    make(chan struct{}),
 	}
+	s.processors = s.newProcessors()
 
-	if cfg.Enabled {
-		for i := 0; i < cfg.WorkerCount; i++ {
-			s.wg.Add(1)
-			go s.worker(i)
+	if cfg.Enabled && cfg.RunWorkers {
+		if n, err := s.recoverStale(context.Background()); err != nil {
+			log.Printf("Failed to recover stale compression jobs: %v", err)
+		} else if n > 0 {
+			log.Printf("Requeued %d stale compression jobs", n)
 		}
+
+		s.wg.Add(1)
+		go s.reaper()
+
+		s.resize(s.pools[PoolImage], cfg.WorkerCount)
+		s.resize(s.pools[PoolVideo], cfg.VideoWorkerCount)
 	}
 
 	return s
 }
 
-func (s *Service) Enqueue(ctx context.Context, assetID string) error {
+// JobKind selects what a job does with its asset.
+type JobKind string
+
+const (
+	KindCompress JobKind = "compress"
+	KindHLS      JobKind = "hls"
+	KindPipeline JobKind = "pipeline"
+	KindStrip    JobKind = "strip_metadata"
+)
+
+// JobOptions carries per-request overrides for a compression job.
+type JobOptions struct {
+	// Kind defaults to KindCompress.
+	Kind JobKind
+	// Profile names the encoder profile. Empty uses the configured default.
+	Profile string
+	// Format converts images to the given format (webp, avif) or sets the
+	// audio codec (opus, aac, mp3). Empty uses the configured default.
+	Format string
+	// AudioBitrate overrides the configured audio bitrate, e.g. "96k".
+	AudioBitrate string
+	// Normalize applies loudness normalization to audio.
+	Normalize bool
+	// TargetBytes, when set, makes a KindCompress job of an image or video
+	// search for the best settings whose output fits within it.
+	TargetBytes int64
+	// Steps lists the operations of a KindPipeline job, in order.
+	Steps []PipelineStep
+	// Priority defaults to PriorityInteractive. A priority set on the
+	// context with WithPriority takes precedence.
+	Priority int
+}
+
+func (s *Service) Enqueue(ctx context.Context, assetID string, opts JobOptions) error {
 	if !s.config.Enabled {
 		return nil
 	}
 
-	// Create job record
-	_, err := s.client.CompressionJob.Create().
+	if opts.Kind == "" {
+		opts.Kind = KindCompress
+	}
+	switch opts.Kind {
+	case KindCompress, KindHLS, KindStrip, KindPipeline:
+	default:
+		return fmt.Errorf("unsupported job kind: %s", opts.Kind)
+	}
+	if opts.Format != "" && !IsImageFormat(opts.Format) && !IsAudioCodec(opts.Format) {
+		return fmt.Errorf("unsupported format: %s", opts.Format)
+	}
+	if opts.AudioBitrate != "" && !IsAudioBitrate(opts.AudioBitrate) {
+		return fmt.Errorf("invalid audio bitrate: %s", opts.AudioBitrate)
+	}
+	if opts.TargetBytes < 0 || (opts.TargetBytes > 0 && opts.Kind != KindCompress) {
+		return fmt.Errorf("target sizes apply to compress jobs and must be positive")
+	}
+	if opts.Profile == "" {
+		opts.Profile = s.config.DefaultProfile
+	}
+	if _, ok := s.config.Profiles[opts.Profile]; !ok {
+		return fmt.Errorf("unknown profile: %s", opts.Profile)
+	}
+
+	if p, ok := ctx.Value(priorityKey).(int); ok {
+		opts.Priority = p
+	}
+	if opts.Priority == 0 {
+		opts.Priority = PriorityInteractive
+	}
+	submitter, _ := ctx.Value(submitterKey).(string)
+
+	a, err := s.client.Asset.Get(ctx, assetID)
+	if err != nil {
+		return err
+	}
+	if opts.TargetBytes > 0 && a.FileType != "image" && a.FileType != "video" {
+		return fmt.Errorf("target sizes apply to images and videos")
+	}
+	if opts.Kind == KindPipeline {
+		if err := ValidateSteps(a.FileType, opts.Steps); err != nil {
+			return err
+		}
+	}
+	active, err := a.QueryCompressionJobs().
+		Where(compressionjob.StatusIn(string(StatusPending), string(StatusProcessing))).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if active {
+		return ErrJobActive
+	}
+	pool := poolFor(a.FileType)
+
+	// The job row is the queue entry; workers claim it from the database.
+	_, err = s.client.CompressionJob.Create().
 		SetAssetID(assetID).
+		SetKind(string(opts.Kind)).
+		SetPriority(opts.Priority).
+		SetSubmitter(submitter).
+		SetPool(pool).
+		SetProfile(opts.Profile).
 		SetStatus(string(StatusPending)).
+		SetTargetFormat(opts.Format).
+		SetAudioBitrate(opts.AudioBitrate).
+		SetNormalize(opts.Normalize).
+		SetSteps(opts.Steps).
+		SetTargetBytes(opts.TargetBytes).
+		SetMaxAttempts(max(s.config.MaxAttempts, 1)).
 		Save(ctx)
 	if err != nil {
 		return err
 	}
 
-	select {
-	case s.queue <- assetID:
-		return nil
-	default:
-		return fmt.Errorf("compression queue full")
-	}
+	s.notify(pool)
+	return nil
 }
 
-func (s *Service) worker(id int) {
+// worker runs jobs from its pool until stop is closed.
+func (s *Service) worker(p *workerPool, id int, stop <-chan struct{}) {
 	defer s.wg.Done()
-	log.Printf("Compression worker %d started", id)
+	log.Printf("Compression worker %s/%d started", p.name, id)
+	defer log.Printf("Compression worker %s/%d stopped", p.name, id)
+
+	ctx := context.Background()
+	ticker := time.NewTicker(s.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		default:
+		}
+
+		job, err := s.claim(ctx, p.name)
+		if err != nil {
+			log.Printf("Compression worker %s/%d failed to claim a job: %v", p.name, id, err)
+		}
+		if job == nil {
+			select {
+			case <-stop:
+				return
+			case <-p.wake:
+			case <-ticker.C:
+			}
+			continue
+		}
 
-	for assetID := range s.queue {
-		s.processJob(assetID)
+		// Let another idle worker look for the next job.
+		s.notify(p.name)
+		s.processJob(job)
 	}
 }
 
-func (s *Service) processJob(assetID string) {
-	ctx := context.Background()
-	log.Printf("[Job %s] Starting compression...", assetID)
+func (s *Service) processJob(job *ent.CompressionJob) {
 	startTime := time.Now()
+	jobID := job.ID
+	a := job.Edges.Asset
+	log.Printf("[Job %s] Starting %s of asset %s...", jobID, job.Kind, a.ID)
+
+	jl := &jobLog{}
+	ctx, cancel := context.WithCancelCause(withJobLog(context.Background(), jl))
+	s.mu.Lock()
+	s.running[job.WorkerID] = cancel
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.running, job.WorkerID)
+		s.mu.Unlock()
+		cancel(nil)
+	}()
+
+	stopHeartbeat := s.heartbeat(job, cancel)
+	defer stopHeartbeat()
+
+	progress := s.newProgressTracker(job)
+
+	// The timeout only bounds the work itself; a timed-out job fails rather
+	// than being reported as cancelled.
+	timeout := s.timeout(ctx, job, a)
+	runCtx, stop := context.WithTimeout(ctx, timeout)
+	defer stop()
+
+	var err error
+	switch JobKind(job.Kind) {
+	case KindHLS:
+		err = s.packageHLS(runCtx, a, progress)
+	case KindPipeline:
+		err = s.runPipeline(runCtx, job, a, progress)
+	case KindStrip:
+		err = s.stripAsset(runCtx, a, progress)
+	default:
+		err = s.compress(runCtx, job, a, progress)
+	}
+	// ctx may be cancelled by now; the final status must still be written.
+	dbCtx := context.Background()
+	s.saveLog(dbCtx, job, jl)
 
-	// Fetch asset and job
-	a, err := s.client.Asset.Query().Where(asset.ID(assetID)).Only(ctx)
+	if err != nil && ctx.Err() == nil && runCtx.Err() == context.DeadlineExceeded {
+		log.Printf("[Job %s] Timed out after %v", jobID, timeout)
+		// Running it again would hang the same way.
+		err = permanent(fmt.Errorf("timed out after %v: %w", timeout, err))
+	}
+	if context.Cause(ctx) == errLeaseLost {
+		// The job belongs to whichever worker holds it now; this run
+		// must not touch its row.
+		log.Printf("[Job %s] Lease lost; abandoned the run", jobID)
+		return
+	}
+	if err != nil && context.Cause(ctx) == errShutdown {
+		s.requeue(dbCtx, job)
+		return
+	}
+	if err != nil && ctx.Err() != nil {
+		log.Printf("[Job %s] Cancelled", jobID)
+		s.updateOwned(job).
+			SetStatus(string(StatusCancelled)).
+			SetError("cancelled").
+			SetCompletedAt(time.Now()).
+			ClearWorkerID().
+			ClearLeaseExpiresAt().
+			Save(dbCtx)
+		return
+	}
 	if err != nil {
-		log.Printf("[Job %s] Worker failed to fetch asset: %v", assetID, err)
+		s.failJob(dbCtx, job, err)
 		return
 	}
 
-	// Find the pending job
-	job, err := a.QueryCompressionJobs().
-		Where(compressionjob.StatusEQ(string(StatusPending))).
-		Order(ent.Desc(compressionjob.FieldStartedAt)).
-		First(ctx)
+	s.refreshLocation(dbCtx, a.ID)
+
+	s.updateOwned(job).
+		SetStatus(string(StatusCompleted)).
+		SetProgress(100).
+		SetCompletedAt(time.Now()).
+		ClearWorkerID().
+		ClearLeaseExpiresAt().
+		Save(dbCtx)
+
+	log.Printf("[Job %s] Completed in %v", jobID, time.Since(startTime))
+}
 
+// timeout returns how long a job may run: a base per file type, plus time
+// proportional to the media duration for every encoding pass the job makes.
+// Images have no duration, so a target-size search gets the base once per
+// encode it may run.
+func (s *Service) timeout(ctx context.Context, job *ent.CompressionJob, a *ent.Asset) time.Duration {
+	base := s.baseTimeout(a.FileType)
+	if a.FileType == "image" {
+		if JobKind(job.Kind) == KindCompress && job.TargetBytes > 0 {
+			return base * (maxTargetTries + 2)
+		}
+		return base
+	}
+	if !s.hasProcessor(ProcessorFFmpeg) {
+		return base
+	}
+
+	passes := 3 // encode, fallback encode, quality check
+	switch JobKind(job.Kind) {
+	case KindCompress:
+		if job.TargetBytes > 0 {
+			passes = maxTargetTries + 2
+		}
+	case KindHLS:
+		passes = len(hlsLadder)
+	case KindPipeline:
+		passes = len(job.Steps)
+	}
+	if a.FileType == "audio" {
+		passes = 1
+	}
+
+	duration := s.probeDuration(ctx, a.StoragePath)
+	return base + time.Duration(duration*s.config.TimeoutFactor*float64(passes)*float64(time.Second))
+}
+
+// baseTimeout returns the configured time allowed for a job on fileType.
+func (s *Service) baseTimeout(fileType string) time.Duration {
+	switch fileType {
+	case "video":
+		return s.config.VideoTimeout
+	case "audio":
+		return s.config.AudioTimeout
+	}
+	return s.config.ImageTimeout
+}
+
+func (s *Service) compress(ctx context.Context, job *ent.CompressionJob, a *ent.Asset, progress *progressTracker) error {
+	// A retried job must not compress the output of an earlier run and
+	// overwrite the kept original.
+	if a.IsCompressed {
+		return permanent(fmt.Errorf("asset is already compressed"))
+	}
+
+	profile, err := s.profile(job.Profile)
 	if err != nil {
-		// Create a new job if missing (recovery)
-		job, _ = s.client.CompressionJob.Create().
-			SetAssetID(assetID).
-			SetStatus(string(StatusProcessing)).
-			SetStartedAt(startTime).
-			Save(ctx)
-	} else {
-		job.Update().
-			SetStatus(string(StatusProcessing)).
-			SetStartedAt(startTime).
-			Save(ctx)
+		return err
+	}
+
+	if a.FileType == "audio" {
+		return s.compressAudio(ctx, job, a, profile, progress)
 	}
+	jobLogFrom(ctx).setParams(profile)
 
 	// Determine paths
 	originalPath := a.StoragePath
 	ext := filepath.Ext(originalPath)
-	tempOutput := strings.TrimSuffix(originalPath, ext) + "_compressed" + ext
+	base := strings.TrimSuffix(originalPath, ext)
+	tempOutput := base + "_compressed" + ext
+
+	format := job.TargetFormat
+	if format == "" && a.FileType == "image" {
+		format = profile.ImageFormat
+	}
+	if "."+format == strings.ToLower(ext) {
+		format = ""
+	}
+
+	output := tempOutput
+	if format != "" {
+		output = base + "_compressed." + format
+	}
+
+	encodes := 1
+	if job.TargetBytes > 0 {
+		encodes = maxTargetTries
+	}
+	passes := encodes
+	if format != "" {
+		passes++
+	}
+	measure := len(s.qualityMetrics(profile)) > 0
+	if measure {
+		passes++
+	}
+	progress.start(s.probeDuration(ctx, originalPath), passes)
 
-	// Run FFmpeg
-	err = s.runFFmpeg(originalPath, tempOutput, a.FileType)
+	// Encode
+	if job.TargetBytes > 0 {
+		// The search runs on the output that is delivered; a fallback is
+		// then encoded with the settings it chose.
+		var params TargetParams
+		profile, params, err = s.encodeToTarget(ctx, a.FileType, originalPath, output, format, profile, job.TargetBytes, progress)
+		if err == nil {
+			jobLogFrom(ctx).setParams(profile)
+			if uerr := updateJob(job).SetTargetParams(params).Exec(ctx); uerr != nil {
+				log.Printf("[Job %s] Failed to save target parameters: %v", job.ID, uerr)
+			}
+			log.Printf("[Job %s] Target of %d bytes reached with %d bytes after %d tries", job.ID, job.TargetBytes, params.OutputBytes, params.Tries)
+		}
+		if err == nil && format != "" {
+			progress.pass(encodes)
+			err = s.encode(ctx, originalPath, tempOutput, encodeOptions{FileType: a.FileType, Profile: profile}, progress)
+		}
+	} else {
+		err = s.encode(ctx, originalPath, tempOutput, encodeOptions{FileType: a.FileType, Profile: profile}, progress)
+		if err == nil && format != "" {
+			// The source-format output is kept as a fallback for clients
+			// that cannot decode the converted image.
+			progress.pass(1)
+			err = s.encode(ctx, originalPath, output, encodeOptions{FileType: a.FileType, Format: format, Profile: profile}, progress)
+		}
+	}
+	below := ""
+	if err == nil && measure {
+		progress.pass(passes - 1)
+		below, err = s.checkQuality(ctx, job, a.FileType, originalPath, output, profile, progress)
+	}
+	if err == nil {
+		ctx, err = commit(ctx)
+	}
 	if err != nil {
-		errMsg := err.Error()
-		log.Printf("[Job %s] FFmpeg failed: %v", assetID, err)
-		job.Update().
-			SetStatus(string(StatusFailed)).
-			SetError(errMsg).
-			Save(ctx)
-		return
+		os.Remove(tempOutput)
+		os.Remove(output)
+		return err
+	}
+
+	if below != "" && job.TargetBytes > 0 {
+		os.Remove(tempOutput)
+		os.Remove(output)
+		return permanent(fmt.Errorf("the target of %d bytes is only reachable below the quality floor: %s", job.TargetBytes, below))
+	}
+	if below != "" {
+		os.Remove(tempOutput)
+		os.Remove(output)
+		updateJob(job).SetOutcome(OutcomeBelowQualityFloor).Save(ctx)
+		log.Printf("[Asset %s] Output rejected, %s; kept the original", a.ID, below)
+		return nil
 	}
 
 	// Calculate stats
 	infoOrig, _ := os.Stat(originalPath)
-	infoComp, _ := os.Stat(tempOutput)
+	infoComp, _ := os.Stat(output)
 	ratio := float64(infoComp.Size()) / float64(infoOrig.Size())
+	// An original already within the target is kept unless the output
+	// saves enough; a larger one must be replaced whatever the savings.
+	if (job.TargetBytes == 0 || infoOrig.Size() <= job.TargetBytes) && !s.saves(infoOrig.Size(), infoComp.Size()) {
+		return s.keepOriginal(ctx, job, a, ratio, tempOutput, output)
+	}
 
-	// Move files
-	backupPath := strings.TrimSuffix(originalPath, ext) + "_original" + ext
-	os.Rename(originalPath, backupPath)
-	os.Rename(tempOutput, originalPath)
+	if format != "" {
+		updateJob(job).SetTargetFormat(format).SetOutcome(OutcomeCompressed).Save(ctx)
+		return s.finishConversion(ctx, a, format)
+	}
+	updateJob(job).SetOutcome(OutcomeCompressed).Save(ctx)
 
-	// Update DB
-	s.client.Asset.UpdateOneID(assetID).
-		SetIsCompressed(true).
-		SetOriginalPath(backupPath).
-		SetCompressionRatio(ratio).
-		SetFileSizeBytes(infoComp.Size()).
-		Save(ctx)
+	// Move files, then update the row. A failed update puts the files
+	// back, so a retry starts again from the original.
+	var moves fileMoves
+	backupPath, replaced, err := backupOriginal(a, &moves)
+	if err == nil {
+		err = moves.move(tempOutput, originalPath)
+	}
+	if err == nil {
+		err = s.client.Asset.UpdateOneID(a.ID).
+			SetIsCompressed(true).
+			SetAlreadyOptimal(false).
+			SetCompressedAt(time.Now()).
+			SetOriginalPath(backupPath).
+			SetOriginalKeptAt(time.Now()).
+			SetCompressionRatio(ratio).
+			SetFileSizeBytes(infoComp.Size()).
+			Exec(ctx)
+	}
+	if err != nil {
+		moves.rollback()
+		os.Remove(tempOutput)
+		return err
+	}
+	os.Remove(replaced)
 
-	job.Update().
-		SetStatus(string(StatusCompleted)).
-		SetProgress(100).
-		SetCompletedAt(time.Now()).
-		Save(ctx)
+	log.Printf("[Asset %s] Compressed, ratio: %.2f", a.ID, ratio)
+	return nil
+}
+
+// saves reports whether an output of newSize is enough smaller than the
+// original to be worth keeping, per the configured minimum savings.
+func (s *Service) saves(origSize, newSize int64) bool {
+	return float64(newSize) <= float64(origSize)*(1-s.config.MinSavings)
+}
+
+// fileMoves records the renames of a commit, so a commit whose database
+// update fails can put every file back and leave the asset as it was.
+type fileMoves [][2]string
+
+func (m *fileMoves) move(src, dst string) error {
+	if err := os.Rename(src, dst); err != nil {
+		return err
+	}
+	*m = append(*m, [2]string{src, dst})
+	return nil
+}
+
+// rollback undoes the moves, latest first.
+func (m *fileMoves) rollback() {
+	for i := len(*m) - 1; i >= 0; i-- {
+		os.Rename((*m)[i][1], (*m)[i][0])
+	}
+	*m = nil
+}
 
-	log.Printf("[Job %s] Compression completed in %v. Ratio: %.2f", assetID, time.Since(startTime), ratio)
+// backupOriginal moves the asset's file aside as its kept original and
+// returns the original's path. An asset that already keeps one, from a
+// pipeline that did not compress it, keeps that; the current file is then
+// moved to replaced, for the caller to remove once the commit succeeds.
+func backupOriginal(a *ent.Asset, moves *fileMoves) (backupPath, replaced string, err error) {
+	ext := filepath.Ext(a.StoragePath)
+	base := strings.TrimSuffix(a.StoragePath, ext)
+	if a.OriginalPath != "" {
+		replaced = base + "_replaced" + ext
+		return a.OriginalPath, replaced, moves.move(a.StoragePath, replaced)
+	}
+	backupPath = base + "_original" + ext
+	return backupPath, "", moves.move(a.StoragePath, backupPath)
 }
 
-func (s *Service) runFFmpeg(input, output, fileType string) error {
-	var args []string
+// keepOriginal discards the outputs of an encode that did not save enough and
+// marks the asset as already optimal, so bulk compression skips it.
+func (s *Service) keepOriginal(ctx context.Context, job *ent.CompressionJob, a *ent.Asset, ratio float64, outputs ...string) error {
+	for _, path := range outputs {
+		os.Remove(path)
+	}
+
+	updateJob(job).SetOutcome(OutcomeAlreadyOptimal).Save(ctx)
+	if _, err := s.client.Asset.UpdateOneID(a.ID).SetAlreadyOptimal(true).Save(ctx); err != nil {
+		return err
+	}
+
+	log.Printf("[Asset %s] Already optimal, ratio: %.2f; kept the original", a.ID, ratio)
+	return nil
+}
 
-	if fileType == "video" {
-		// H.264, CRF 23, AAC Audio
-		args = []string{
-			"-i", input,
-			"-c:v", "libx264",
-			"-crf", "23",
-			"-preset", "medium",
-			"-c:a", "aac",
-			"-b:a", "128k",
-			"-movflags", "+faststart",
-			"-y", output,
+// profile looks up a job's encoder profile. Jobs queued before profiles
+// existed have none recorded and use the default.
+func (s *Service) profile(name string) (config.Profile, error) {
+	if name == "" {
+		name = s.config.DefaultProfile
+	}
+	p, ok := s.config.Profiles[name]
+	if !ok {
+		return config.Profile{}, permanent(fmt.Errorf("unknown profile: %s", name))
+	}
+	return p, nil
+}
+
+// Profiles returns the configured encoder profiles sorted by name.
+func (s *Service) Profiles() []config.Profile {
+	profiles := make([]config.Profile, 0, len(s.config.Profiles))
+	for _, p := range s.config.Profiles {
+		profiles = append(profiles, p)
+	}
+	sort.Slice(profiles, func(i, j int) bool { return profiles[i].Name < profiles[j].Name })
+	return profiles
+}
+
+// ValidateProfiles checks the encoder settings of profiles, which may come
+// from a file, against the formats and codecs jobs support.
+func ValidateProfiles(profiles map[string]config.Profile) error {
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		p := profiles[name]
+		maxCRF, ok := videoCodecs[p.VideoCodec]
+		if !ok {
+			return fmt.Errorf("profile %s: unsupported video_codec: %s", name, p.VideoCodec)
 		}
-	} else if fileType == "image" {
-		// Scale down if too large, optimize
-		args = []string{
-			"-i", input,
-			"-vf", "scale='min(4096,iw)':-1",
-			"-q:v", fmt.Sprintf("%d", s.config.ImageQuality),
-			"-y", output,
+		if p.CRF < 0 || p.CRF > maxCRF {
+			return fmt.Errorf("profile %s: crf %d is outside 0-%d for %s", name, p.CRF, maxCRF, p.VideoCodec)
 		}
-	} else {
-		return fmt.Errorf("unsupported file type for compression")
+		if p.ImageQuality < 1 || p.ImageQuality > 100 {
+			return fmt.Errorf("profile %s: image_quality %d is outside 1-100", name, p.ImageQuality)
+		}
+		if p.ImageFormat != "" && !IsImageFormat(p.ImageFormat) {
+			return fmt.Errorf("profile %s: unsupported image_format: %s", name, p.ImageFormat)
+		}
+		if !IsAudioCodec(p.AudioCodec) {
+			return fmt.Errorf("profile %s: unsupported audio_codec: %s", name, p.AudioCodec)
+		}
+		if !IsAudioBitrate(p.AudioBitrate) {
+			return fmt.Errorf("profile %s: invalid audio_bitrate: %s", name, p.AudioBitrate)
+		}
+	}
+	return nil
+}
+
+// finishConversion moves the outputs of a format conversion into place. The
+// converted file becomes the asset's primary file, the source-format output is
+// registered as a fallback variant and the untouched upload is kept as the
+// original.
+func (s *Service) finishConversion(ctx context.Context, a *ent.Asset, format string) error {
+	originalPath := a.StoragePath
+	ext := filepath.Ext(originalPath)
+	base := strings.TrimSuffix(originalPath, ext)
+
+	primaryPath := base + "." + format
+	fallbackPath := base + "_fallback" + ext
+
+	infoOrig, err := os.Stat(originalPath)
+	if err != nil {
+		return err
+	}
+	infoPrimary, err := os.Stat(base + "_compressed." + format)
+	if err != nil {
+		return err
+	}
+	infoFallback, err := os.Stat(base + "_compressed" + ext)
+	if err != nil {
+		return err
+	}
+	ratio := float64(infoPrimary.Size()) / float64(infoOrig.Size())
+
+	// The files are moved before the rows are written in one transaction;
+	// if that fails they are moved back, so a retry starts again from the
+	// original.
+	var moves fileMoves
+	fail := func(err error) error {
+		moves.rollback()
+		os.Remove(base + "_compressed." + format)
+		os.Remove(base + "_compressed" + ext)
+		return err
+	}
+	backupPath, replaced, err := backupOriginal(a, &moves)
+	if err == nil {
+		err = moves.move(base+"_compressed."+format, primaryPath)
+	}
+	if err == nil {
+		err = moves.move(base+"_compressed"+ext, fallbackPath)
+	}
+	if err != nil {
+		return fail(err)
+	}
+
+	filename := strings.TrimSuffix(a.OriginalFilename, filepath.Ext(a.OriginalFilename)) + "." + format
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fail(err)
+	}
+	err = tx.Asset.UpdateOneID(a.ID).
+		SetOriginalFilename(filename).
+		SetExtension("." + format).
+		SetMimeType(imageFormats[format]).
+		SetStoragePath(primaryPath).
+		SetIsCompressed(true).
+		SetAlreadyOptimal(false).
+		SetCompressedAt(time.Now()).
+		SetOriginalPath(backupPath).
+		SetOriginalKeptAt(time.Now()).
+		SetCompressionRatio(ratio).
+		SetFileSizeBytes(infoPrimary.Size()).
+		Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		return fail(err)
+	}
+	err = tx.Variant.Create().
+		SetAssetID(a.ID).
+		SetKind(VariantFallback).
+		SetExtension(strings.ToLower(ext)).
+		SetMimeType(mime.TypeByExtension(ext)).
+		SetFileSizeBytes(infoFallback.Size()).
+		SetStoragePath(fallbackPath).
+		Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		return fail(fmt.Errorf("failed to record fallback variant: %w", err))
+	}
+	if err := tx.Commit(); err != nil {
+		return fail(err)
 	}
+	os.Remove(replaced)
 
-	cmd := exec.Command(s.config.FFmpegPath, args...)
-	return cmd.Run()
+	log.Printf("[Asset %s] Converted to %s, ratio: %.2f", a.ID, format, ratio)
+	return nil
 }
@@ -0,0 +1,75 @@
+package preprocessing
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/adimail/asset-manager/ent"
+)
+
+// errShutdown is the cancellation cause of jobs interrupted by Shutdown.
+var errShutdown = errors.New("worker shutting down")
+
+// Shutdown stops the workers. Running jobs may finish until ctx is done;
+// jobs still running then are interrupted, their partial outputs removed
+// and the jobs put back on the queue for the next worker to pick up. Jobs
+// enqueued after Shutdown stay pending in the database.
+func (s *Service) Shutdown(ctx context.Context) error {
+	s.mu.Lock()
+	if s.stopping {
+		s.mu.Unlock()
+		return nil
+	}
+	s.stopping = true
+	s.mu.Unlock()
+
+	close(s.done)
+	for _, p := range s.pools {
+		s.resize(p, 0)
+	}
+
+	drained := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		log.Printf("Compression workers stopped")
+		return nil
+	case <-ctx.Done():
+	}
+
+	s.mu.Lock()
+	n := len(s.running)
+	for _, cancel := range s.running {
+		cancel(errShutdown)
+	}
+	s.mu.Unlock()
+	log.Printf("Interrupting %d running compression jobs", n)
+
+	// Interrupted jobs clean up and release their rows promptly; ffmpeg is
+	// killed outright.
+	<-drained
+	log.Printf("Compression workers stopped")
+	return ctx.Err()
+}
+
+// requeue returns a job interrupted by Shutdown to the queue. The attempt it
+// was making does not count against its retries.
+func (s *Service) requeue(ctx context.Context, job *ent.CompressionJob) {
+	_, err := s.updateOwned(job).
+		SetStatus(string(StatusPending)).
+		SetProgress(0).
+		AddAttempts(-1).
+		ClearWorkerID().
+		ClearLeaseExpiresAt().
+		Save(ctx)
+	if err != nil {
+		log.Printf("[Job %s] Failed to requeue: %v", job.ID, err)
+		return
+	}
+	log.Printf("[Job %s] Interrupted by shutdown; requeued", job.ID)
+}
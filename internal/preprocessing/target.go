@@ -0,0 +1,169 @@
+package preprocessing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/adimail/asset-manager/ent/schema"
+	"github.com/adimail/asset-manager/internal/config"
+)
+
+// TargetParams records the settings a target-size compression chose.
+type TargetParams = schema.TargetParams
+
+// maxTargetTries bounds the encodes a target-size search may run.
+const maxTargetTries = 12
+
+// errTargetTries stops a target-size search that used up its tries.
+var errTargetTries = errors.New("target-size search ran out of tries")
+
+// Limits of the target-size search. Past the worst quality the search
+// reduces resolution instead, down to the smallest size.
+const (
+	minTargetQuality = 10  // image quality
+	maxTargetCRF     = 51  // video
+	minTargetEdge    = 64  // image longest edge
+	minTargetHeight  = 144 // video
+)
+
+// encodeToTarget encodes input to output at the best settings that keep the
+// output within target bytes. At each resolution it tries the profile's own
+// quality, then the worst, and bisects between them; when even the worst
+// quality is too large it scales the resolution down by the overshoot and
+// searches again. It returns the profile it settled on.
+func (s *Service) encodeToTarget(ctx context.Context, fileType, input, output, format string, profile config.Profile, target int64, progress *progressTracker) (config.Profile, TargetParams, error) {
+	var params TargetParams
+
+	// The knob runs from the profile's setting (step 0) to the worst
+	// quality (step n), and the resolution is the longest edge for images
+	// and the height for videos.
+	var best, dir, n, res, minRes int
+	switch fileType {
+	case "image":
+		w, h, err := s.imageSize(ctx, input)
+		if err != nil {
+			return profile, params, permanent(fmt.Errorf("read dimensions: %w", err))
+		}
+		best, dir = profile.ImageQuality, -1
+		n = max(best-minTargetQuality, 0)
+		res, minRes = max(w, h), minTargetEdge
+		if profile.ImageMaxSize > 0 {
+			res = min(res, profile.ImageMaxSize)
+		}
+	case "video":
+		w, h, err := s.probeDimensions(ctx, input)
+		if err != nil {
+			return profile, params, permanent(fmt.Errorf("read dimensions: %w", err))
+		}
+		best, dir = profile.CRF, 1
+		n = max(maxTargetCRF-best, 0)
+		res, minRes = h, minTargetHeight
+		if profile.MaxWidth > 0 && w > profile.MaxWidth {
+			res = h * profile.MaxWidth / w
+		}
+		if profile.MaxHeight > 0 {
+			res = min(res, profile.MaxHeight)
+		}
+	default:
+		return profile, params, permanent(fmt.Errorf("target sizes apply to images and videos"))
+	}
+
+	ext := filepath.Ext(output)
+	try := strings.TrimSuffix(output, ext) + "_try" + ext
+	defer os.Remove(try)
+
+	settings := func(step int) config.Profile {
+		p := profile
+		if fileType == "image" {
+			p.ImageQuality = best + dir*step
+			p.ImageMaxSize = res
+		} else {
+			p.CRF = best + dir*step
+			p.MaxHeight = res
+		}
+		return p
+	}
+
+	var chosen config.Profile
+	smallest := int64(math.MaxInt64)
+	// encodeStep encodes at the given step and keeps the output when it
+	// fits. Steps that fit only get better as the search goes on, so the
+	// last output kept is the best one.
+	encodeStep := func(step int) (bool, error) {
+		if params.Tries == maxTargetTries {
+			return false, errTargetTries
+		}
+		progress.pass(params.Tries)
+		params.Tries++
+		p := settings(step)
+		if err := s.encode(ctx, input, try, encodeOptions{FileType: fileType, Format: format, Profile: p}, progress); err != nil {
+			return false, err
+		}
+		info, err := os.Stat(try)
+		if err != nil {
+			return false, err
+		}
+		smallest = min(smallest, info.Size())
+		if info.Size() > target {
+			return false, nil
+		}
+		if err := os.Rename(try, output); err != nil {
+			return false, err
+		}
+		chosen, params.OutputBytes = p, info.Size()
+		return true, nil
+	}
+
+	for {
+		fits, err := encodeStep(0)
+		if err == nil && !fits && n > 0 {
+			fits, err = encodeStep(n)
+			for lo, hi := 0, n; err == nil && fits && hi-lo > 1; {
+				mid := (lo + hi) / 2
+				var ok bool
+				if ok, err = encodeStep(mid); ok {
+					hi = mid
+				} else {
+					lo = mid
+				}
+			}
+			if err == errTargetTries && params.OutputBytes > 0 {
+				err = nil
+			}
+		}
+		if err == errTargetTries {
+			break
+		}
+		if err != nil {
+			return profile, params, err
+		}
+		if params.OutputBytes > 0 {
+			break
+		}
+
+		// Even the worst quality is too large. Pixels drive the size,
+		// so scale both sides by the square root of the overshoot, with
+		// a margin as the fit is not exact.
+		if res <= minRes {
+			break
+		}
+		res = max(int(float64(res)*math.Sqrt(float64(target)/float64(smallest))*0.95), minRes)
+	}
+
+	if params.OutputBytes == 0 {
+		return profile, params, permanent(fmt.Errorf("cannot fit within %d bytes: the smallest output was %d bytes after %d tries",
+			target, smallest, params.Tries))
+	}
+
+	if fileType == "image" {
+		params.ImageQuality, params.ImageMaxSize = chosen.ImageQuality, chosen.ImageMaxSize
+	} else {
+		params.CRF, params.MaxHeight = chosen.CRF, chosen.MaxHeight
+	}
+	return chosen, params, nil
+}
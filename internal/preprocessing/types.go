@@ -0,0 +1,106 @@
+package preprocessing
+
+import (
+	"time"
+
+	"github.com/adimail/asset-manager/ent"
+)
+
+// Job is the API representation of a CompressionJob.
+type Job struct {
+	ID              string         `json:"id"`
+	AssetID         string         `json:"asset_id,omitempty"`
+	Kind            JobKind        `json:"kind"`
+	Profile         string         `json:"profile,omitempty"`
+	Priority        int            `json:"priority"`
+	Submitter       string         `json:"submitter,omitempty"`
+	Pool            string         `json:"pool,omitempty"`
+	Status          JobStatus      `json:"status"`
+	Progress        int            `json:"progress"`
+	Error           string         `json:"error,omitempty"`
+	TargetFormat    string         `json:"target_format,omitempty"`
+	AudioBitrate    string         `json:"audio_bitrate,omitempty"`
+	Normalize       bool           `json:"normalize,omitempty"`
+	Outcome         string         `json:"outcome,omitempty"`
+	TargetBytes     int64          `json:"target_bytes,omitempty"`
+	TargetParams    *TargetParams  `json:"target_params,omitempty"`
+	EncoderParams   *EncoderParams `json:"encoder_params,omitempty"`
+	FFmpegVersion   string         `json:"ffmpeg_version,omitempty"`
+	SSIM            *float64       `json:"ssim,omitempty"`
+	PSNR            *float64       `json:"psnr,omitempty"`
+	VMAF            *float64       `json:"vmaf,omitempty"`
+	Steps           []PipelineStep `json:"steps,omitempty"`
+	CancelRequested bool           `json:"cancel_requested,omitempty"`
+	Attempts        int            `json:"attempts"`
+	MaxAttempts     int            `json:"max_attempts"`
+	NextAttemptAt   *time.Time     `json:"next_attempt_at,omitempty"`
+	AttemptErrors   []string       `json:"attempt_errors,omitempty"`
+	CreatedAt       *time.Time     `json:"created_at,omitempty"`
+	StartedAt       *time.Time     `json:"started_at,omitempty"`
+	CompletedAt     *time.Time     `json:"completed_at,omitempty"`
+}
+
+type JobFilter struct {
+	Status  JobStatus
+	AssetID string
+	Page    int
+	Limit   int
+}
+
+type JobListResponse struct {
+	Jobs       []*Job `json:"jobs"`
+	TotalCount int    `json:"total_count"`
+	Page       int    `json:"page"`
+	Limit      int    `json:"limit"`
+}
+
+// MapJob converts a CompressionJob row. The asset ID is taken from the
+// loaded asset edge when present.
+func MapJob(e *ent.CompressionJob) *Job {
+	j := &Job{
+		ID:              e.ID,
+		Kind:            JobKind(e.Kind),
+		Profile:         e.Profile,
+		Priority:        e.Priority,
+		Submitter:       e.Submitter,
+		Pool:            e.Pool,
+		Status:          JobStatus(e.Status),
+		Progress:        e.Progress,
+		Error:           e.Error,
+		TargetFormat:    e.TargetFormat,
+		AudioBitrate:    e.AudioBitrate,
+		Normalize:       e.Normalize,
+		Outcome:         e.Outcome,
+		TargetBytes:     e.TargetBytes,
+		FFmpegVersion:   e.FfmpegVersion,
+		SSIM:            e.Ssim,
+		PSNR:            e.Psnr,
+		VMAF:            e.Vmaf,
+		Steps:           e.Steps,
+		CancelRequested: e.CancelRequested,
+		Attempts:        e.Attempts,
+		MaxAttempts:     e.MaxAttempts,
+		NextAttemptAt:   timePtr(e.NextAttemptAt),
+		AttemptErrors:   e.AttemptErrors,
+		CreatedAt:       timePtr(e.CreatedAt),
+		StartedAt:       timePtr(e.StartedAt),
+		CompletedAt:     timePtr(e.CompletedAt),
+	}
+	if e.EncoderParams.Profile != "" {
+		j.EncoderParams = &e.EncoderParams
+	}
+	if e.TargetParams.Tries > 0 {
+		j.TargetParams = &e.TargetParams
+	}
+	if e.Edges.Asset != nil {
+		j.AssetID = e.Edges.Asset.ID
+	}
+	return j
+}
+
+func timePtr(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
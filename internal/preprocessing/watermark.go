@@ -0,0 +1,127 @@
+package preprocessing
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/adimail/asset-manager/ent"
+	"github.com/adimail/asset-manager/internal/config"
+)
+
+// VariantWatermarked marks the stamped copy a watermark step produces when it
+// does not replace the asset.
+const VariantWatermarked = "watermarked"
+
+// Watermark outputs.
+const (
+	WatermarkVariant = "variant"
+	WatermarkReplace = "replace"
+)
+
+const (
+	defaultWatermarkOpacity = 0.5
+	defaultWatermarkScale   = 0.2
+	defaultWatermarkPos     = "bottom-right"
+)
+
+// watermarkPositions maps a position to the overlay filter's x and y for a
+// margin of m pixels.
+var watermarkPositions = map[string]func(m int) (string, string){
+	"top-left":     func(m int) (string, string) { return strconv.Itoa(m), strconv.Itoa(m) },
+	"top-right":    func(m int) (string, string) { return fmt.Sprintf("W-w-%d", m), strconv.Itoa(m) },
+	"bottom-left":  func(m int) (string, string) { return strconv.Itoa(m), fmt.Sprintf("H-h-%d", m) },
+	"bottom-right": func(m int) (string, string) { return fmt.Sprintf("W-w-%d", m), fmt.Sprintf("H-h-%d", m) },
+	"center":       func(int) (string, string) { return "(W-w)/2", "(H-h)/2" },
+}
+
+func validateWatermark(st PipelineStep) error {
+	if st.Overlay == "" {
+		return fmt.Errorf("watermark needs an overlay asset")
+	}
+	if _, ok := watermarkPositions[st.Position]; !ok && st.Position != "" {
+		return fmt.Errorf("unknown watermark position: %s", st.Position)
+	}
+	if st.Opacity < 0 || st.Opacity > 1 {
+		return fmt.Errorf("watermark opacity must be between 0 and 1")
+	}
+	if st.Scale < 0 || st.Scale > 1 {
+		return fmt.Errorf("watermark scale must be between 0 and 1")
+	}
+	if st.Output != "" && st.Output != WatermarkVariant && st.Output != WatermarkReplace {
+		return fmt.Errorf("watermark output must be %s or %s", WatermarkVariant, WatermarkReplace)
+	}
+	return nil
+}
+
+// watermark draws the step's overlay asset onto input. Videos are
+// re-encoded with the profile's codec; images keep their format.
+func (s *Service) watermark(ctx context.Context, st *PipelineStep, fileType, input, output string, profile config.Profile, progress *progressTracker) error {
+	if fileType != "image" && fileType != "video" {
+		return permanent(fmt.Errorf("watermarks apply to images and videos"))
+	}
+	if !s.hasProcessor(ProcessorFFmpeg) {
+		return permanent(fmt.Errorf("watermarking requires ffmpeg"))
+	}
+
+	overlay, err := s.client.Asset.Get(ctx, st.Overlay)
+	if ent.IsNotFound(err) {
+		return permanent(fmt.Errorf("overlay asset not found: %s", st.Overlay))
+	}
+	if err != nil {
+		return err
+	}
+	if overlay.FileType != "image" {
+		return permanent(fmt.Errorf("overlay must be an image"))
+	}
+
+	// The overlay is sized against the frame, so it looks the same on a
+	// thumbnail-sized preview and a 4K original.
+	var width int
+	if fileType == "image" {
+		width, _, err = s.imageSize(ctx, input)
+	} else {
+		width, _, err = s.probeDimensions(ctx, input)
+	}
+	if err != nil {
+		return permanent(fmt.Errorf("read dimensions: %w", err))
+	}
+
+	opacity, scale, position := st.Opacity, st.Scale, st.Position
+	if opacity == 0 {
+		opacity = defaultWatermarkOpacity
+	}
+	if scale == 0 {
+		scale = defaultWatermarkScale
+	}
+	if position == "" {
+		position = defaultWatermarkPos
+	}
+	x, y := watermarkPositions[position](width / 50)
+
+	filter := fmt.Sprintf("[1:v]scale=%d:-1,format=rgba,colorchannelmixer=aa=%.3f[wm];[0:v][wm]overlay=%s:%s[out]",
+		max(int(float64(width)*scale), 1), opacity, x, y)
+	args := []string{
+		"-i", input,
+		"-i", overlay.StoragePath,
+		"-filter_complex", filter,
+		"-map", "[out]",
+	}
+	if fileType == "video" {
+		args = append(args, "-map", "0:a?")
+		args = append(args, videoCodecArgs(profile)...)
+		args = append(args, "-c:a", "copy", "-movflags", "+faststart")
+	} else {
+		format := strings.TrimPrefix(strings.ToLower(filepath.Ext(output)), ".")
+		if !IsImageFormat(format) {
+			format = ""
+		}
+		args = append(args, "-frames:v", "1")
+		args = append(args, imageCodecArgs(format, profile.ImageQuality)...)
+	}
+	args = append(args, "-y", output)
+
+	return s.execFFmpeg(ctx, args, progress)
+}
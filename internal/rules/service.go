@@ -0,0 +1,204 @@
+package rules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/adimail/asset-manager/ent"
+	"github.com/adimail/asset-manager/ent/rule"
+	"github.com/adimail/asset-manager/internal/assets"
+	"github.com/adimail/asset-manager/internal/config"
+	"github.com/adimail/asset-manager/internal/preprocessing"
+)
+
+const (
+	KindCompress = "compress"
+	KindHLS      = "hls"
+)
+
+// ErrInvalidRule wraps the reason a rule request was rejected.
+var ErrInvalidRule = errors.New("invalid rule")
+
+// AssetProcessor queues processing for an asset.
+type AssetProcessor interface {
+	CompressAsset(ctx context.Context, id string, opts assets.CompressOptions) error
+	PackageHLS(ctx context.Context, id string) error
+}
+
+type Service struct {
+	client    *ent.Client
+	processor AssetProcessor
+	profiles  map[string]config.Profile
+}
+
+// NewService creates the rule service. profiles are the encoder profiles a
+// compress rule may name.
+func NewService(client *ent.Client, processor AssetProcessor, profiles map[string]config.Profile) *Service {
+	return &Service{
+		client:    client,
+		processor: processor,
+		profiles:  profiles,
+	}
+}
+
+// RuleRequest is the body for creating or replacing a rule.
+type RuleRequest struct {
+	Name         string `json:"name"`
+	Enabled      *bool  `json:"enabled"` // defaults to true
+	FileType     string `json:"file_type"`
+	Extension    string `json:"extension"`
+	MinSizeBytes int64  `json:"min_size_bytes"`
+	TagID        string `json:"tag_id"`
+	Kind         string `json:"kind"` // defaults to compress
+	Profile      string `json:"profile"`
+}
+
+func (r *RuleRequest) normalize(profiles map[string]config.Profile) error {
+	if strings.TrimSpace(r.Name) == "" {
+		return fmt.Errorf("rule name cannot be empty")
+	}
+	if r.Enabled == nil {
+		enabled := true
+		r.Enabled = &enabled
+	}
+	if r.Extension != "" && !strings.HasPrefix(r.Extension, ".") {
+		r.Extension = "." + r.Extension
+	}
+	r.Extension = strings.ToLower(r.Extension)
+	if r.MinSizeBytes < 0 {
+		return fmt.Errorf("min_size_bytes cannot be negative")
+	}
+
+	switch r.Kind {
+	case "":
+		r.Kind = KindCompress
+	case KindCompress:
+	case KindHLS:
+		if r.Profile != "" {
+			return fmt.Errorf("profile only applies to compress rules")
+		}
+	default:
+		return fmt.Errorf("unsupported rule kind: %s", r.Kind)
+	}
+	// An empty profile uses the default one when the rule fires.
+	if _, ok := profiles[r.Profile]; r.Profile != "" && !ok {
+		return fmt.Errorf("unknown profile: %s", r.Profile)
+	}
+	return nil
+}
+
+func (s *Service) Create(ctx context.Context, req RuleRequest) (*ent.Rule, error) {
+	if err := req.normalize(s.profiles); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRule, err)
+	}
+	return s.client.Rule.Create().
+		SetName(req.Name).
+		SetEnabled(*req.Enabled).
+		SetFileType(req.FileType).
+		SetExtension(req.Extension).
+		SetMinSizeBytes(req.MinSizeBytes).
+		SetTagID(req.TagID).
+		SetKind(req.Kind).
+		SetProfile(req.Profile).
+		Save(ctx)
+}
+
+func (s *Service) List(ctx context.Context) ([]*ent.Rule, error) {
+	return s.client.Rule.Query().Order(ent.Asc(rule.FieldCreatedAt)).All(ctx)
+}
+
+func (s *Service) Update(ctx context.Context, id string, req RuleRequest) (*ent.Rule, error) {
+	if err := req.normalize(s.profiles); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRule, err)
+	}
+	return s.client.Rule.UpdateOneID(id).
+		SetName(req.Name).
+		SetEnabled(*req.Enabled).
+		SetFileType(req.FileType).
+		SetExtension(req.Extension).
+		SetMinSizeBytes(req.MinSizeBytes).
+		SetTagID(req.TagID).
+		SetKind(req.Kind).
+		SetProfile(req.Profile).
+		Save(ctx)
+}
+
+func (s *Service) Delete(ctx context.Context, id string) error {
+	return s.client.Rule.DeleteOneID(id).Exec(ctx)
+}
+
+// AssetUploaded runs the enabled rules against a new asset. Rules are checked
+// in creation order and the first match of each kind fires, so an asset is
+// never queued for two conflicting compressions.
+func (s *Service) AssetUploaded(ctx context.Context, a *assets.Asset) {
+	rules, err := s.client.Rule.Query().
+		Where(rule.Enabled(true)).
+		Order(ent.Asc(rule.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		log.Printf("Failed to load rules for asset %s: %v", a.ID, err)
+		return
+	}
+
+	fired := make(map[string]bool)
+	for _, r := range rules {
+		if fired[r.Kind] || !matches(r, a) {
+			continue
+		}
+		fired[r.Kind] = true
+		s.fire(ctx, r, a)
+	}
+}
+
+func (s *Service) fire(ctx context.Context, r *ent.Rule, a *assets.Asset) {
+	// Automatic work must not delay jobs people are waiting on.
+	ctx = preprocessing.WithPriority(ctx, preprocessing.PriorityBulk)
+	ctx = preprocessing.WithSubmitter(ctx, "rule:"+r.Name)
+
+	var err error
+	switch r.Kind {
+	case KindHLS:
+		err = s.processor.PackageHLS(ctx, a.ID)
+	default:
+		err = s.processor.CompressAsset(ctx, a.ID, assets.CompressOptions{Profile: r.Profile})
+	}
+	if err != nil {
+		log.Printf("[Rule %s] Failed to queue %s for asset %s: %v", r.Name, r.Kind, a.ID, err)
+		return
+	}
+
+	if err := r.Update().AddFireCount(1).SetLastFiredAt(time.Now()).Exec(ctx); err != nil {
+		log.Printf("[Rule %s] Failed to record firing: %v", r.Name, err)
+	}
+
+	if r.Profile != "" {
+		log.Printf("[Rule %s] Fired for asset %s: queued %s with profile %s", r.Name, a.ID, r.Kind, r.Profile)
+	} else {
+		log.Printf("[Rule %s] Fired for asset %s: queued %s", r.Name, a.ID, r.Kind)
+	}
+}
+
+func matches(r *ent.Rule, a *assets.Asset) bool {
+	if r.FileType != "" && r.FileType != string(a.FileType) {
+		return false
+	}
+	if r.Extension != "" && r.Extension != a.Extension {
+		return false
+	}
+	if a.FileSizeBytes < r.MinSizeBytes {
+		return false
+	}
+	if r.TagID != "" {
+		for _, t := range a.Tags {
+			if t.ID == r.TagID {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}